@@ -0,0 +1,458 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// mockConditionalRequests records the requests of the mock conditional server.
+type mockConditionalRequests struct {
+	reads  int
+	writes []map[string]interface{}
+}
+
+// startMockConditionalServer starts a server returning the versions of the KP-1 issue, of its comment 10001 and of
+// the property "settings" of the project KP, a version per read. The last version is returned once they're all read,
+// and an empty version is returned as a missing resource.
+func startMockConditionalServer(versions []string, requests *mockConditionalRequests) *httptest.Server {
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Method == http.MethodPut {
+
+			payload := make(map[string]interface{})
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			requests.writes = append(requests.writes, payload)
+
+			if r.URL.Path == "/rest/api/2/issue/KP-1/comment/10001" {
+				_ = json.NewEncoder(w).Encode(&models.IssueCommentSchemeV2{ID: "10001", Updated: "2022-01-07T10:09:09.000+0000"})
+				return
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		version := versions[len(versions)-1]
+		if requests.reads < len(versions) {
+			version = versions[requests.reads]
+		}
+
+		requests.reads++
+
+		if version == "" {
+			http.Error(w, `{"errorMessages":["The property was not found."]}`, http.StatusNotFound)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/rest/api/2/issue/KP-1":
+			_, _ = fmt.Fprintf(w, `{"id":"10000","key":"KP-1","fields":{"summary":"Summary","updated":"%v"}}`, version)
+
+		case "/rest/api/2/issue/KP-1/comment/10001":
+			_ = json.NewEncoder(w).Encode(&models.IssueCommentSchemeV2{ID: "10001", Updated: version})
+
+		case "/rest/api/2/project/KP/properties/settings":
+			_, _ = fmt.Fprintf(w, `{"key":"settings","value":%v}`, version)
+
+		default:
+			http.Error(w, fmt.Sprintf("Request: %v %v", r.Method, r.URL), http.StatusNotFound)
+		}
+	}))
+}
+
+func TestIssueService_UpdateIfUnchanged(t *testing.T) {
+
+	snapshot := &models.IssueSchemeV2{Key: "KP-1", Fields: &models.IssueFieldsSchemeV2{Updated: "2022-01-07T10:09:09.000+0000"}}
+
+	payload := &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{Summary: "New summary"}}
+
+	merge := func(current *models.IssueSchemeV2) (*models.IssueSchemeV2, *models.CustomFields, *models.UpdateOperations, error) {
+		return &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{Summary: current.Fields.Summary + " merged"}}, nil, nil, nil
+	}
+
+	testCases := []struct {
+		name         string
+		issueKeyOrID string
+		snapshot     *models.IssueSchemeV2
+		merge        IssueMerge
+		options      *models.ConditionalUpdateOptionsScheme
+		versions     []string
+		wantReads    int
+		wantSummary  string
+		wantConflict bool
+		wantErr      bool
+	}{
+		{
+			name:         "UpdateIfUnchangedWhenTheIssueIsNotModified",
+			issueKeyOrID: "KP-1",
+			snapshot:     snapshot,
+			versions:     []string{"2022-01-07T10:09:09.000+0000"},
+			wantReads:    1,
+			wantSummary:  "New summary",
+			wantErr:      false,
+		},
+
+		{
+			name:         "UpdateIfUnchangedWhenTheIssueIsModified",
+			issueKeyOrID: "KP-1",
+			snapshot:     snapshot,
+			versions:     []string{"2022-01-07T11:00:00.000+0000"},
+			wantReads:    1,
+			wantConflict: true,
+			wantErr:      true,
+		},
+
+		{
+			name:         "UpdateIfUnchangedWhenTheIssueIsMerged",
+			issueKeyOrID: "KP-1",
+			snapshot:     snapshot,
+			merge:        merge,
+			versions:     []string{"2022-01-07T11:00:00.000+0000"},
+			wantReads:    2,
+			wantSummary:  "Summary merged",
+			wantErr:      false,
+		},
+
+		{
+			name:         "UpdateIfUnchangedWhenTheMergesAreExhausted",
+			issueKeyOrID: "KP-1",
+			snapshot:     snapshot,
+			merge:        merge,
+			options:      &models.ConditionalUpdateOptionsScheme{Merges: 1},
+			versions:     []string{"2022-01-07T11:00:00.000+0000", "2022-01-07T12:00:00.000+0000"},
+			wantReads:    2,
+			wantConflict: true,
+			wantErr:      true,
+		},
+
+		{
+			name:         "UpdateIfUnchangedWhenTheSnapshotHasNoUpdatedTimestamp",
+			issueKeyOrID: "KP-1",
+			snapshot:     &models.IssueSchemeV2{Key: "KP-1"},
+			versions:     []string{"2022-01-07T10:09:09.000+0000"},
+			wantErr:      true,
+		},
+
+		{
+			name:         "UpdateIfUnchangedWhenTheTimestampHasAnotherOffset",
+			issueKeyOrID: "KP-1",
+			snapshot:     snapshot,
+			versions:     []string{"2022-01-07T11:09:09.000+0100"},
+			wantReads:    1,
+			wantSummary:  "New summary",
+			wantErr:      false,
+		},
+
+		{
+			name:         "UpdateIfUnchangedWhenTheSnapshotTimestampIsInvalid",
+			issueKeyOrID: "KP-1",
+			snapshot:     &models.IssueSchemeV2{Key: "KP-1", Fields: &models.IssueFieldsSchemeV2{Updated: "yesterday"}},
+			versions:     []string{"2022-01-07T10:09:09.000+0000"},
+			wantErr:      true,
+		},
+
+		{
+			name:         "UpdateIfUnchangedWhenTheIssueKeyIsNotProvided",
+			issueKeyOrID: "",
+			snapshot:     snapshot,
+			versions:     []string{"2022-01-07T10:09:09.000+0000"},
+			wantErr:      true,
+		},
+
+		{
+			name:         "UpdateIfUnchangedWhenTheIssueIsNotFound",
+			issueKeyOrID: "KP-1",
+			snapshot:     snapshot,
+			versions:     []string{""},
+			wantReads:    1,
+			wantErr:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			requests := &mockConditionalRequests{}
+
+			mockServer := startMockConditionalServer(testCase.versions, requests)
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotResponse, err := mockClient.Issue.UpdateIfUnchanged(context.Background(), testCase.issueKeyOrID,
+				testCase.snapshot, true, payload, nil, nil, testCase.merge, testCase.options)
+
+			assert.Equal(t, testCase.wantReads, requests.reads)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				assert.Empty(t, requests.writes)
+
+				_, isConflict := err.(*models.UpdateConflictError)
+				assert.Equal(t, testCase.wantConflict, isConflict)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, gotResponse, nil)
+			assert.Equal(t, 1, len(requests.writes))
+			assert.Equal(t, testCase.wantSummary, requests.writes[0]["fields"].(map[string]interface{})["summary"])
+		})
+	}
+}
+
+func TestCommentService_UpdateIfUnchanged(t *testing.T) {
+
+	snapshot := &models.IssueCommentSchemeV2{ID: "10001", Updated: "2022-01-07T10:09:09.000+0000"}
+	payload := &models.CommentPayloadSchemeV2{Visibility: &models.CommentVisibilityScheme{Type: "role", Value: "Users"}}
+
+	merge := func(current *models.IssueCommentSchemeV2) (*models.CommentPayloadSchemeV2, error) {
+		return &models.CommentPayloadSchemeV2{Visibility: &models.CommentVisibilityScheme{Type: "role", Value: "Administrators"}}, nil
+	}
+
+	testCases := []struct {
+		name           string
+		commentID      string
+		snapshot       *models.IssueCommentSchemeV2
+		merge          CommentMerge
+		versions       []string
+		wantReads      int
+		wantVisibility string
+		wantConflict   bool
+		wantErr        bool
+	}{
+		{
+			name:           "UpdateIfUnchangedWhenTheCommentIsNotModified",
+			commentID:      "10001",
+			snapshot:       snapshot,
+			versions:       []string{"2022-01-07T10:09:09.000+0000"},
+			wantReads:      1,
+			wantVisibility: "Users",
+			wantErr:        false,
+		},
+
+		{
+			name:         "UpdateIfUnchangedWhenTheCommentIsModified",
+			commentID:    "10001",
+			snapshot:     snapshot,
+			versions:     []string{"2022-01-07T11:00:00.000+0000"},
+			wantReads:    1,
+			wantConflict: true,
+			wantErr:      true,
+		},
+
+		{
+			name:           "UpdateIfUnchangedWhenTheCommentIsMerged",
+			commentID:      "10001",
+			snapshot:       snapshot,
+			merge:          merge,
+			versions:       []string{"2022-01-07T11:00:00.000+0000"},
+			wantReads:      2,
+			wantVisibility: "Administrators",
+			wantErr:        false,
+		},
+
+		{
+			name:      "UpdateIfUnchangedWhenTheSnapshotHasNoUpdatedTimestamp",
+			commentID: "10001",
+			snapshot:  &models.IssueCommentSchemeV2{ID: "10001"},
+			versions:  []string{"2022-01-07T10:09:09.000+0000"},
+			wantErr:   true,
+		},
+
+		{
+			name:      "UpdateIfUnchangedWhenTheCommentIDIsNotProvided",
+			commentID: "",
+			snapshot:  snapshot,
+			versions:  []string{"2022-01-07T10:09:09.000+0000"},
+			wantErr:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			requests := &mockConditionalRequests{}
+
+			mockServer := startMockConditionalServer(testCase.versions, requests)
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotResult, gotResponse, err := mockClient.Issue.Comment.UpdateIfUnchanged(context.Background(), "KP-1",
+				testCase.commentID, testCase.snapshot, false, payload, testCase.merge, nil)
+
+			assert.Equal(t, testCase.wantReads, requests.reads)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				assert.Empty(t, requests.writes)
+
+				_, isConflict := err.(*models.UpdateConflictError)
+				assert.Equal(t, testCase.wantConflict, isConflict)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, gotResponse, nil)
+			assert.Equal(t, "10001", gotResult.ID)
+			assert.Equal(t, 1, len(requests.writes))
+			assert.Equal(t, testCase.wantVisibility, requests.writes[0]["visibility"].(map[string]interface{})["value"])
+		})
+	}
+}
+
+func TestProjectPropertyService_SetIfUnchanged(t *testing.T) {
+
+	snapshot := &models.EntityPropertyScheme{Key: "settings", Value: map[string]interface{}{"enabled": true, "limit": 10}}
+
+	merge := func(current *models.EntityPropertyScheme) (interface{}, error) {
+
+		if current == nil {
+			return map[string]interface{}{"limit": 20}, nil
+		}
+
+		value := current.Value.(map[string]interface{})
+		value["limit"] = 20
+
+		return value, nil
+	}
+
+	testCases := []struct {
+		name         string
+		propertyKey  string
+		snapshot     *models.EntityPropertyScheme
+		merge        ProjectPropertyMerge
+		versions     []string
+		wantReads    int
+		wantValue    map[string]interface{}
+		wantConflict bool
+		wantErr      bool
+	}{
+		{
+			name:        "SetIfUnchangedWhenThePropertyIsNotModified",
+			propertyKey: "settings",
+			snapshot:    snapshot,
+			versions:    []string{`{"limit":10,"enabled":true}`},
+			wantReads:   1,
+			wantValue:   map[string]interface{}{"limit": float64(15)},
+			wantErr:     false,
+		},
+
+		{
+			name:        "SetIfUnchangedWhenThePropertyDoesNotExist",
+			propertyKey: "settings",
+			snapshot:    nil,
+			versions:    []string{""},
+			wantReads:   1,
+			wantValue:   map[string]interface{}{"limit": float64(15)},
+			wantErr:     false,
+		},
+
+		{
+			name:         "SetIfUnchangedWhenThePropertyIsCreated",
+			propertyKey:  "settings",
+			snapshot:     nil,
+			versions:     []string{`{"limit":10}`},
+			wantReads:    1,
+			wantConflict: true,
+			wantErr:      true,
+		},
+
+		{
+			name:         "SetIfUnchangedWhenThePropertyIsModified",
+			propertyKey:  "settings",
+			snapshot:     snapshot,
+			versions:     []string{`{"limit":10,"enabled":false}`},
+			wantReads:    1,
+			wantConflict: true,
+			wantErr:      true,
+		},
+
+		{
+			name:        "SetIfUnchangedWhenThePropertyIsMerged",
+			propertyKey: "settings",
+			snapshot:    snapshot,
+			merge:       merge,
+			versions:    []string{`{"limit":10,"enabled":false}`},
+			wantReads:   2,
+			wantValue:   map[string]interface{}{"limit": float64(20), "enabled": false},
+			wantErr:     false,
+		},
+
+		{
+			name:        "SetIfUnchangedWhenThePropertyKeyIsNotProvided",
+			propertyKey: "",
+			snapshot:    snapshot,
+			versions:    []string{`{"limit":10,"enabled":true}`},
+			wantErr:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			requests := &mockConditionalRequests{}
+
+			mockServer := startMockConditionalServer(testCase.versions, requests)
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotResponse, err := mockClient.Project.Property.SetIfUnchanged(context.Background(), "KP", testCase.propertyKey,
+				testCase.snapshot, map[string]interface{}{"limit": 15}, testCase.merge, nil)
+
+			assert.Equal(t, testCase.wantReads, requests.reads)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				assert.Empty(t, requests.writes)
+
+				_, isConflict := err.(*models.UpdateConflictError)
+				assert.Equal(t, testCase.wantConflict, isConflict)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, gotResponse, nil)
+			assert.Equal(t, []map[string]interface{}{testCase.wantValue}, requests.writes)
+		})
+	}
+}
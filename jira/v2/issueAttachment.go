@@ -8,6 +8,9 @@ import (
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 type AttachmentService struct{ client *Client }
@@ -112,6 +115,35 @@ func (a *AttachmentService) Human(ctx context.Context, attachmentID string) (res
 	return
 }
 
+// Raw returns the metadata for the contents of an attachment, if it is an archive.
+// For example, if the attachment is a ZIP archive, then information about the files in the archive is returned.
+// Currently, only the ZIP archive format is supported.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-attachments/#api-rest-api-2-attachment-id-expand-raw-get
+// NOTE: Experimental Endpoint
+func (a *AttachmentService) Raw(ctx context.Context, attachmentID string) (result *models.AttachmentRawMetadataScheme,
+	response *ResponseScheme, err error) {
+
+	if len(attachmentID) == 0 {
+		return nil, nil, models.ErrNoAttachmentIDError
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/2/attachment/%v/expand/raw", attachmentID)
+	request, err := a.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = a.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
 // Add adds one attachment to an issue. Attachments are posted as multipart/form-data (RFC 1867).
 // Docs: https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#add-attachment
 // Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-attachments/#api-rest-api-2-issue-issueidorkey-attachments-post
@@ -162,3 +194,78 @@ func (a *AttachmentService) Add(ctx context.Context, issueKeyOrID, fileName stri
 
 	return
 }
+
+// Download streams the content of an attachment to the writer and returns the number of bytes written.
+// The options select a byte range with a Range request, e.g. to resume a download, the response headers contain the
+// Content-Range returned by Jira. The redirects to the media servers are followed even when the HTTP client doesn't.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-attachments/#api-rest-api-2-attachment-content-id-get
+func (a *AttachmentService) Download(ctx context.Context, attachmentID string, options *models.AttachmentContentOptionsScheme,
+	writer io.Writer) (written int64, response *ResponseScheme, err error) {
+
+	if len(attachmentID) == 0 {
+		return 0, nil, models.ErrNoAttachmentIDError
+	}
+
+	if writer == nil {
+		return 0, nil, models.ErrNoWriterError
+	}
+
+	if options == nil {
+		options = &models.AttachmentContentOptionsScheme{}
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/2/attachment/content/%v", attachmentID)
+	request, err := a.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	return a.client.download(request, writer, options.Offset, options.Length)
+}
+
+// Thumbnail streams the thumbnail of an attachment to the writer and returns the number of bytes written.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-attachments/#api-rest-api-2-attachment-thumbnail-id-get
+func (a *AttachmentService) Thumbnail(ctx context.Context, attachmentID string, options *models.AttachmentThumbnailOptionsScheme,
+	writer io.Writer) (written int64, response *ResponseScheme, err error) {
+
+	if len(attachmentID) == 0 {
+		return 0, nil, models.ErrNoAttachmentIDError
+	}
+
+	if writer == nil {
+		return 0, nil, models.ErrNoWriterError
+	}
+
+	params := url.Values{}
+
+	if options != nil {
+
+		if options.Width > 0 {
+			params.Add("width", strconv.Itoa(options.Width))
+		}
+
+		if options.Height > 0 {
+			params.Add("height", strconv.Itoa(options.Height))
+		}
+
+		if options.FallbackToDefault {
+			params.Add("fallbackToDefault", "true")
+		}
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/2/attachment/thumbnail/%v", attachmentID))
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := a.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return
+	}
+
+	return a.client.download(request, writer, 0, 0)
+}
@@ -0,0 +1,239 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// Upload adds several attachments to an issue, the multipart body is streamed instead of buffered.
+// The files are checked against the attachment settings of the site before they're sent, and the files with an
+// unknown size are checked while they're sent. The files are sent in batches of BatchSize files per request,
+// the attachments of the batches uploaded are returned with the error of the failed batch. An interrupted upload
+// can be resumed with the SkipExisting option, the files already attached to the issue aren't uploaded again.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-attachments/#api-rest-api-2-issue-issueidorkey-attachments-post
+func (a *AttachmentService) Upload(ctx context.Context, issueKeyOrID string, files []*models.AttachmentUploadFileScheme,
+	options *models.AttachmentUploadOptionsScheme) (result []*models.AttachmentScheme, response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if len(files) == 0 {
+		return nil, nil, models.ErrNoAttachmentFilesError
+	}
+
+	for _, file := range files {
+
+		if file == nil || len(file.Name) == 0 {
+			return nil, nil, models.ErrNoAttachmentNameError
+		}
+
+		if file.Reader == nil {
+			return nil, nil, models.ErrNoReaderError
+		}
+	}
+
+	if options == nil {
+		options = &models.AttachmentUploadOptionsScheme{}
+	}
+
+	progress := &attachmentUploadProgress{callback: options.Progress}
+
+	if !options.SkipSettingsCheck {
+
+		settings, response, err := a.Settings(ctx)
+		if err != nil {
+			return nil, response, err
+		}
+
+		if !settings.Enabled {
+			return nil, response, models.ErrAttachmentsDisabledError
+		}
+
+		progress.limit = int64(settings.UploadLimit)
+
+		for _, file := range files {
+
+			if progress.limit > 0 && file.Size > progress.limit {
+				return nil, response, fmt.Errorf("%w: the file %v has %v bytes, the limit is %v bytes",
+					models.ErrAttachmentTooLargeError, file.Name, file.Size, progress.limit)
+			}
+		}
+	}
+
+	if options.SkipExisting {
+
+		issue, response, err := a.client.Issue.Get(ctx, issueKeyOrID, []string{"attachment"}, nil)
+		if err != nil {
+			return nil, response, err
+		}
+
+		existing := make(map[string]bool)
+		if issue.Fields != nil {
+
+			for _, attachment := range issue.Fields.Attachment {
+				existing[fmt.Sprintf("%v/%v", attachment.Filename, attachment.Size)] = true
+			}
+		}
+
+		var pending []*models.AttachmentUploadFileScheme
+		for _, file := range files {
+
+			if !existing[fmt.Sprintf("%v/%v", file.Name, file.Size)] {
+				pending = append(pending, file)
+			}
+		}
+
+		if files = pending; len(files) == 0 {
+			return nil, response, nil
+		}
+	}
+
+	for _, file := range files {
+
+		// The total size is unknown when the size of a file is unknown
+		if file.Size <= 0 {
+			progress.size = 0
+			break
+		}
+
+		progress.size += file.Size
+	}
+
+	batchSize := options.BatchSize
+	if batchSize <= 0 {
+		batchSize = len(files)
+	}
+
+	for start := 0; start < len(files); start += batchSize {
+
+		end := start + batchSize
+		if end > len(files) {
+			end = len(files)
+		}
+
+		var attachments []*models.AttachmentScheme
+
+		attachments, response, err = a.upload(ctx, issueKeyOrID, files[start:end], start, progress)
+		result = append(result, attachments...)
+
+		if err != nil {
+			return result, response, err
+		}
+	}
+
+	return result, response, nil
+}
+
+// upload sends the files on a single request, the multipart body is written on a pipe read by the request.
+func (a *AttachmentService) upload(ctx context.Context, issueKeyOrID string, files []*models.AttachmentUploadFileScheme,
+	firstIndex int, progress *attachmentUploadProgress) (result []*models.AttachmentScheme, response *ResponseScheme, err error) {
+
+	var (
+		endpoint               = fmt.Sprintf("rest/api/2/issue/%v/attachments", issueKeyOrID)
+		pipeReader, pipeWriter = io.Pipe()
+		attachmentWriter       = multipart.NewWriter(pipeWriter)
+	)
+
+	// The writer stops when the pipe is closed, e.g. when the request fails, the progress isn't reported after
+	// the upload returns
+	done := make(chan struct{})
+	defer func() {
+		pipeReader.Close()
+		<-done
+	}()
+
+	go func() {
+
+		defer close(done)
+
+		for index, file := range files {
+
+			part, err := attachmentWriter.CreateFormFile("file", file.Name)
+			if err != nil {
+				pipeWriter.CloseWithError(err)
+				return
+			}
+
+			reader := &attachmentUploadReader{reader: file.Reader, file: file, index: firstIndex + index, progress: progress}
+
+			if _, err = io.Copy(part, reader); err != nil {
+				pipeWriter.CloseWithError(err)
+				return
+			}
+		}
+
+		pipeWriter.CloseWithError(attachmentWriter.Close())
+	}()
+
+	request, err := a.client.newRequest(ctx, http.MethodPost, endpoint, pipeReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Add("Content-Type", attachmentWriter.FormDataContentType())
+	request.Header.Add("Accept", "application/json")
+	request.Header.Set("X-Atlassian-Token", "no-check")
+
+	// The errors of the body, like the upload limit errors, are returned by the HTTP client
+	httpResponse, err := a.client.HTTP.Do(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response, err = transformTheHTTPResponse(httpResponse, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// attachmentUploadProgress tracks the bytes sent by an upload.
+type attachmentUploadProgress struct {
+	callback func(progress *models.AttachmentUploadProgressScheme)
+	limit    int64
+	written  int64
+	size     int64
+}
+
+// attachmentUploadReader reads a file, reporting the progress and checking the upload limit.
+type attachmentUploadReader struct {
+	reader   io.Reader
+	file     *models.AttachmentUploadFileScheme
+	index    int
+	written  int64
+	progress *attachmentUploadProgress
+}
+
+func (r *attachmentUploadReader) Read(p []byte) (int, error) {
+
+	n, err := r.reader.Read(p)
+
+	r.written += int64(n)
+	r.progress.written += int64(n)
+
+	if r.progress.limit > 0 && r.written > r.progress.limit {
+		return n, fmt.Errorf("%w: the file %v has more than %v bytes", models.ErrAttachmentTooLargeError,
+			r.file.Name, r.progress.limit)
+	}
+
+	if n > 0 && r.progress.callback != nil {
+
+		r.progress.callback(&models.AttachmentUploadProgressScheme{
+			FileName:    r.file.Name,
+			FileIndex:   r.index,
+			FileWritten: r.written,
+			FileSize:    r.file.Size,
+			Written:     r.progress.written,
+			Size:        r.progress.size,
+		})
+	}
+
+	return n, err
+}
@@ -1,14 +1,18 @@
 package v2
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/stretchr/testify/assert"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -800,3 +804,530 @@ func TestAttachmentService_Add_V2(t *testing.T) {
 		})
 	}
 }
+
+func TestAttachmentService_Raw_V2(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		attachmentID       string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetRawAttachmentWhenTheParametersAreCorrect",
+			attachmentID:       "10006",
+			mockFile:           "../v3/mocks/get-attachment-raw-view.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/10006/expand/raw",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetRawAttachmentWhenTheAttachmentIDIsNotSet",
+			attachmentID:       "",
+			mockFile:           "../v3/mocks/get-attachment-raw-view.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/10006/expand/raw",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRawAttachmentWhenTheContextIsNotProvided",
+			attachmentID:       "10006",
+			mockFile:           "../v3/mocks/get-attachment-raw-view.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/10006/expand/raw",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRawAttachmentWhenTheRequestMethodIsIncorrect",
+			attachmentID:       "10006",
+			mockFile:           "../v3/mocks/get-attachment-raw-view.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/attachment/10006/expand/raw",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRawAttachmentWhenTheStatusCodeIsIncorrect",
+			attachmentID:       "10006",
+			mockFile:           "../v3/mocks/get-attachment-raw-view.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/10006/expand/raw",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRawAttachmentWhenTheResponseBodyIsEmpty",
+			attachmentID:       "10006",
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/10006/expand/raw",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &AttachmentService{client: mockClient}
+			gotResult, gotResponse, err := service.Raw(testCase.context, testCase.attachmentID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestAttachmentService_Download_V2(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		attachmentID       string
+		options            *models.AttachmentContentOptionsScheme
+		writer             io.Writer
+		mockFile           string
+		headers            map[string]string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantContent        string
+		wantErr            bool
+	}{
+		{
+			name:               "DownloadAttachmentWhenTheParametersAreCorrect",
+			attachmentID:       "10006",
+			writer:             &bytes.Buffer{},
+			mockFile:           "../v3/mocks/attachment-content.txt",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/content/10006",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantContent:        "0123456789abcdef",
+			wantErr:            false,
+		},
+
+		{
+			name:               "DownloadAttachmentWhenARangeIsRequested",
+			attachmentID:       "10006",
+			options:            &models.AttachmentContentOptionsScheme{Offset: 4, Length: 6},
+			writer:             &bytes.Buffer{},
+			mockFile:           "../v3/mocks/attachment-content-range.txt",
+			headers:            map[string]string{"Content-Range": "bytes 4-9/16"},
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/content/10006",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusPartialContent,
+			wantContent:        "456789",
+			wantErr:            false,
+		},
+
+		{
+			name:               "DownloadAttachmentWhenTheRangeIsIgnored",
+			attachmentID:       "10006",
+			options:            &models.AttachmentContentOptionsScheme{Offset: 4, Length: 6},
+			writer:             &bytes.Buffer{},
+			mockFile:           "../v3/mocks/attachment-content.txt",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/content/10006",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantContent:        "456789",
+			wantErr:            false,
+		},
+
+		{
+			name:               "DownloadAttachmentWhenTheAttachmentIDIsNotSet",
+			attachmentID:       "",
+			writer:             &bytes.Buffer{},
+			mockFile:           "../v3/mocks/attachment-content.txt",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/content/10006",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DownloadAttachmentWhenTheWriterIsNotSet",
+			attachmentID:       "10006",
+			writer:             nil,
+			mockFile:           "../v3/mocks/attachment-content.txt",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/content/10006",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DownloadAttachmentWhenTheContextIsNotProvided",
+			attachmentID:       "10006",
+			writer:             &bytes.Buffer{},
+			mockFile:           "../v3/mocks/attachment-content.txt",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/content/10006",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DownloadAttachmentWhenTheRequestMethodIsIncorrect",
+			attachmentID:       "10006",
+			writer:             &bytes.Buffer{},
+			mockFile:           "../v3/mocks/attachment-content.txt",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/attachment/content/10006",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DownloadAttachmentWhenTheStatusCodeIsIncorrect",
+			attachmentID:       "10006",
+			writer:             &bytes.Buffer{},
+			mockFile:           "../v3/mocks/attachment-content.txt",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/content/10006",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusRequestedRangeNotSatisfiable,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				Headers:            testCase.headers,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &AttachmentService{client: mockClient}
+			gotWritten, gotResponse, err := service.Download(testCase.context, testCase.attachmentID, testCase.options, testCase.writer)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.wantContent, testCase.writer.(*bytes.Buffer).String())
+				assert.Equal(t, int64(len(testCase.wantContent)), gotWritten)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, apiEndpoint.Path)
+				assert.Equal(t, testCase.endpoint, apiEndpoint.Path)
+			}
+		})
+
+	}
+
+}
+
+func TestAttachmentService_DownloadWhenTheContentIsRedirected_V2(t *testing.T) {
+
+	mediaServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Header.Get("Authorization") != "" {
+			http.Error(w, "the credentials were sent to the media server", http.StatusBadRequest)
+			return
+		}
+
+		if r.Header.Get("Range") != "bytes=4-" {
+			http.Error(w, fmt.Sprintf("Range: %v, want bytes=4-", r.Header.Get("Range")), http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(http.StatusPartialContent)
+		_, _ = w.Write([]byte("456789abcdef"))
+	}))
+
+	defer mediaServer.Close()
+
+	// The media server runs on another port, the host of the redirect is different
+	mediaURL := strings.Replace(mediaServer.URL, "127.0.0.1", "localhost", 1) + "/file/10006"
+
+	mockServer, err := startMockServer(&mockServerOptions{
+		Endpoint:           "/rest/api/2/attachment/content/10006",
+		MethodAccepted:     http.MethodGet,
+		Headers:            map[string]string{"Location": mediaURL},
+		ResponseCodeWanted: http.StatusSeeOther,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer mockServer.Close()
+
+	// The HTTP client doesn't follow the redirects
+	httpClient := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+
+	mockClient, err := New(httpClient, mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockClient.Auth.SetBasicAuth("example@example.com", "token")
+
+	var buffer bytes.Buffer
+	service := &AttachmentService{client: mockClient}
+
+	gotWritten, gotResponse, err := service.Download(context.Background(), "10006", &models.AttachmentContentOptionsScheme{Offset: 4}, &buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusPartialContent, gotResponse.Code)
+	assert.Equal(t, mediaURL, gotResponse.Endpoint)
+	assert.Equal(t, "456789abcdef", buffer.String())
+	assert.Equal(t, int64(12), gotWritten)
+}
+
+func TestAttachmentService_Thumbnail_V2(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		attachmentID       string
+		options            *models.AttachmentThumbnailOptionsScheme
+		writer             io.Writer
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "DownloadAttachmentThumbnailWhenTheParametersAreCorrect",
+			attachmentID:       "10006",
+			options:            &models.AttachmentThumbnailOptionsScheme{Width: 200, Height: 200, FallbackToDefault: true},
+			writer:             &bytes.Buffer{},
+			mockFile:           "../v3/mocks/attachment-content.txt",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/thumbnail/10006?fallbackToDefault=true&height=200&width=200",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DownloadAttachmentThumbnailWhenTheOptionsAreNotProvided",
+			attachmentID:       "10006",
+			options:            nil,
+			writer:             &bytes.Buffer{},
+			mockFile:           "../v3/mocks/attachment-content.txt",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/thumbnail/10006",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DownloadAttachmentThumbnailWhenTheAttachmentIDIsNotSet",
+			attachmentID:       "",
+			writer:             &bytes.Buffer{},
+			mockFile:           "../v3/mocks/attachment-content.txt",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/thumbnail/10006",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DownloadAttachmentThumbnailWhenTheWriterIsNotSet",
+			attachmentID:       "10006",
+			writer:             nil,
+			mockFile:           "../v3/mocks/attachment-content.txt",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/thumbnail/10006",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DownloadAttachmentThumbnailWhenTheContextIsNotProvided",
+			attachmentID:       "10006",
+			writer:             &bytes.Buffer{},
+			mockFile:           "../v3/mocks/attachment-content.txt",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/thumbnail/10006",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DownloadAttachmentThumbnailWhenTheStatusCodeIsIncorrect",
+			attachmentID:       "10006",
+			writer:             &bytes.Buffer{},
+			mockFile:           "../v3/mocks/attachment-content.txt",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/attachment/thumbnail/10006",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNotFound,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &AttachmentService{client: mockClient}
+			gotWritten, gotResponse, err := service.Thumbnail(testCase.context, testCase.attachmentID, testCase.options, testCase.writer)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, int64(0), gotWritten)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
@@ -0,0 +1,414 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// startMockBulkServer starts a server searching the KP-1, KP-2 and KP-3 issues.
+// The first update of KP-2 fails with a server error, and the updates of KP-3 fail with a bad request.
+func startMockBulkServer(t *testing.T, payloads map[string][]map[string]interface{}, mutex *sync.Mutex) *httptest.Server {
+
+	keys := []string{"KP-1", "KP-2", "KP-3"}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/2/search":
+
+			search := struct {
+				StartAt    int `json:"startAt"`
+				MaxResults int `json:"maxResults"`
+			}{}
+
+			if err := json.NewDecoder(r.Body).Decode(&search); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			page := &models.IssueSearchSchemeV2{StartAt: search.StartAt, MaxResults: search.MaxResults, Total: len(keys)}
+			for index := search.StartAt; index < len(keys) && index < search.StartAt+search.MaxResults; index++ {
+
+				page.Issues = append(page.Issues, &models.IssueSchemeV2{ID: fmt.Sprint(10000 + index), Key: keys[index],
+					Fields: &models.IssueFieldsSchemeV2{Summary: "Summary of " + keys[index], Status: &models.StatusScheme{Name: "Open"}}})
+			}
+
+			_ = json.NewEncoder(w).Encode(page)
+
+		case strings.HasPrefix(r.URL.Path, "/rest/api/2/issue/KP-"):
+
+			key := strings.Split(r.URL.Path, "/")[5]
+
+			payload := make(map[string]interface{})
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+
+			mutex.Lock()
+			payloads[key] = append(payloads[key], payload)
+			attempts := len(payloads[key])
+			mutex.Unlock()
+
+			switch {
+			case key == "KP-2" && attempts == 1:
+				http.Error(w, "the server is busy", http.StatusServiceUnavailable)
+			case key == "KP-3":
+				http.Error(w, `{"errorMessages":["the issue can't be edited"]}`, http.StatusBadRequest)
+			default:
+				w.WriteHeader(http.StatusNoContent)
+			}
+
+		default:
+			http.Error(w, fmt.Sprintf("Request: %v %v", r.Method, r.URL), http.StatusNotFound)
+		}
+	}))
+}
+
+func TestIssueService_BulkApply(t *testing.T) {
+
+	checkpointDirectory, err := ioutil.TempDir("", "bulk")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(checkpointDirectory)
+
+	resumedCheckpoint := filepath.Join(checkpointDirectory, "resumed.txt")
+	if err = ioutil.WriteFile(resumedCheckpoint, []byte("KP-1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		name           string
+		jql            string
+		operation      func(service *IssueService) IssueBulkOperation
+		options        *models.IssueBulkOperationOptionsScheme
+		context        context.Context
+		wantResults    map[string]string
+		wantAttempts   map[string]int
+		wantCheckpoint string
+		wantPayload    map[string]interface{}
+		wantErr        bool
+	}{
+		{
+			name: "BulkApplyWhenTheParametersAreCorrect",
+			jql:  "project = KP",
+			operation: func(service *IssueService) IssueBulkOperation {
+				return service.AssignOperation("5b10ac8d82e05b22cc7d4ef5")
+			},
+			options: &models.IssueBulkOperationOptionsScheme{
+				Concurrency:    2,
+				Retries:        1,
+				RetryDelay:     time.Millisecond,
+				PageSize:       2,
+				CheckpointFile: filepath.Join(checkpointDirectory, "assign.txt"),
+			},
+			context: context.Background(),
+			wantResults: map[string]string{
+				"KP-1": models.IssueBulkOperationSucceeded,
+				"KP-2": models.IssueBulkOperationSucceeded,
+				"KP-3": models.IssueBulkOperationFailed,
+			},
+			wantAttempts:   map[string]int{"KP-1": 1, "KP-2": 2, "KP-3": 1},
+			wantCheckpoint: "KP-1\nKP-2\n",
+			wantPayload:    map[string]interface{}{"accountId": "5b10ac8d82e05b22cc7d4ef5"},
+			wantErr:        false,
+		},
+
+		{
+			name: "BulkApplyWhenTheCheckpointFileContainsIssues",
+			jql:  "project = KP",
+			operation: func(service *IssueService) IssueBulkOperation {
+				return service.AssignOperation("5b10ac8d82e05b22cc7d4ef5")
+			},
+			options: &models.IssueBulkOperationOptionsScheme{
+				Retries:        1,
+				RetryDelay:     time.Millisecond,
+				CheckpointFile: resumedCheckpoint,
+			},
+			context: context.Background(),
+			wantResults: map[string]string{
+				"KP-1": models.IssueBulkOperationSkipped,
+				"KP-2": models.IssueBulkOperationSucceeded,
+				"KP-3": models.IssueBulkOperationFailed,
+			},
+			wantAttempts:   map[string]int{"KP-1": 0, "KP-2": 2, "KP-3": 1},
+			wantCheckpoint: "KP-1\nKP-2\n",
+			wantErr:        false,
+		},
+
+		{
+			name: "BulkApplyWhenTheRetriesAreDisabled",
+			jql:  "project = KP",
+			operation: func(service *IssueService) IssueBulkOperation {
+				return service.AssignOperation("5b10ac8d82e05b22cc7d4ef5")
+			},
+			options: nil,
+			context: context.Background(),
+			wantResults: map[string]string{
+				"KP-1": models.IssueBulkOperationSucceeded,
+				"KP-2": models.IssueBulkOperationFailed,
+				"KP-3": models.IssueBulkOperationFailed,
+			},
+			wantAttempts: map[string]int{"KP-1": 1, "KP-2": 1, "KP-3": 1},
+			wantErr:      false,
+		},
+
+		{
+			name:      "BulkApplyWhenTheLabelsAreUpdated",
+			jql:       "project = KP",
+			operation: func(service *IssueService) IssueBulkOperation { return service.LabelsOperation([]string{"stale"}, nil) },
+			options:   &models.IssueBulkOperationOptionsScheme{Retries: 1, RetryDelay: time.Millisecond},
+			context:   context.Background(),
+			wantResults: map[string]string{
+				"KP-1": models.IssueBulkOperationSucceeded,
+				"KP-2": models.IssueBulkOperationSucceeded,
+				"KP-3": models.IssueBulkOperationFailed,
+			},
+			wantPayload: map[string]interface{}{"update": map[string]interface{}{
+				"labels": []interface{}{map[string]interface{}{"add": "stale"}}}},
+			wantErr: false,
+		},
+
+		{
+			name: "BulkApplyWhenItIsADryRun",
+			jql:  "project = KP",
+			operation: func(service *IssueService) IssueBulkOperation {
+				return service.AssignOperation("5b10ac8d82e05b22cc7d4ef5")
+			},
+			options: &models.IssueBulkOperationOptionsScheme{
+				DryRun:         true,
+				CheckpointFile: filepath.Join(checkpointDirectory, "dry-run.txt"),
+			},
+			context: context.Background(),
+			wantResults: map[string]string{
+				"KP-1": models.IssueBulkOperationPreviewed,
+				"KP-2": models.IssueBulkOperationPreviewed,
+				"KP-3": models.IssueBulkOperationPreviewed,
+			},
+			wantAttempts: map[string]int{"KP-1": 0, "KP-2": 0, "KP-3": 0},
+			wantErr:      false,
+		},
+
+		{
+			name: "BulkApplyWhenTheJQLIsNotProvided",
+			jql:  "",
+			operation: func(service *IssueService) IssueBulkOperation {
+				return service.AssignOperation("5b10ac8d82e05b22cc7d4ef5")
+			},
+			context: context.Background(),
+			wantErr: true,
+		},
+
+		{
+			name:      "BulkApplyWhenTheOperationIsNotProvided",
+			jql:       "project = KP",
+			operation: func(service *IssueService) IssueBulkOperation { return nil },
+			context:   context.Background(),
+			wantErr:   true,
+		},
+
+		{
+			name: "BulkApplyWhenTheContextIsNotProvided",
+			jql:  "project = KP",
+			operation: func(service *IssueService) IssueBulkOperation {
+				return service.AssignOperation("5b10ac8d82e05b22cc7d4ef5")
+			},
+			context: nil,
+			wantErr: true,
+		},
+
+		{
+			name: "BulkApplyWhenTheContextIsCanceled",
+			jql:  "project = KP",
+			operation: func(service *IssueService) IssueBulkOperation {
+				return service.AssignOperation("5b10ac8d82e05b22cc7d4ef5")
+			},
+			context: canceledCtx,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var (
+				mutex    sync.Mutex
+				payloads = make(map[string][]map[string]interface{})
+			)
+
+			mockServer := startMockBulkServer(t, payloads, &mutex)
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var progress int
+			if testCase.options != nil {
+				testCase.options.Progress = func(*models.IssueBulkOperationResultScheme) { progress++ }
+			}
+
+			gotReport, err := mockClient.Issue.BulkApply(testCase.context, testCase.jql, testCase.operation(mockClient.Issue),
+				testCase.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, len(testCase.wantResults), len(gotReport.Results))
+
+			gotResults := make(map[string]string)
+			for index, result := range gotReport.Results {
+
+				assert.Equal(t, fmt.Sprintf("KP-%v", index+1), result.IssueKey)
+				assert.Equal(t, "Summary of "+result.IssueKey, result.Summary)
+				gotResults[result.IssueKey] = result.Result
+
+				if testCase.wantAttempts != nil {
+					assert.Equal(t, testCase.wantAttempts[result.IssueKey], result.Attempts, result.IssueKey)
+					assert.Equal(t, testCase.wantAttempts[result.IssueKey], len(payloads[result.IssueKey]), result.IssueKey)
+				}
+			}
+
+			assert.Equal(t, testCase.wantResults, gotResults)
+
+			if testCase.options != nil {
+				assert.Equal(t, len(gotReport.Results), progress)
+			}
+
+			assert.Equal(t, len(gotReport.Results), gotReport.Succeeded+gotReport.Failed+gotReport.Skipped+gotReport.Previewed)
+
+			if testCase.wantCheckpoint != "" {
+
+				checkpoint, err := ioutil.ReadFile(testCase.options.CheckpointFile)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				// The issues are updated concurrently, the order of the keys isn't known
+				assert.ElementsMatch(t, strings.Fields(testCase.wantCheckpoint), strings.Fields(string(checkpoint)))
+			}
+
+			if testCase.options != nil && testCase.options.DryRun {
+				_, err := os.Stat(testCase.options.CheckpointFile)
+				assert.True(t, os.IsNotExist(err))
+			}
+
+			if testCase.wantPayload != nil {
+				assert.Equal(t, testCase.wantPayload, payloads["KP-1"][0])
+			}
+		})
+
+	}
+
+}
+
+// mockTimeoutError is a network error reporting a timeout.
+type mockTimeoutError struct{}
+
+func (mockTimeoutError) Error() string   { return "i/o timeout" }
+func (mockTimeoutError) Timeout() bool   { return true }
+func (mockTimeoutError) Temporary() bool { return true }
+
+func TestIsIssueBulkRetryable(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		response *ResponseScheme
+		err      error
+		want     bool
+	}{
+		{
+			name:     "IsIssueBulkRetryableWhenTheRequestIsRateLimited",
+			response: &ResponseScheme{Code: http.StatusTooManyRequests},
+			err:      errors.New("request failed"),
+			want:     true,
+		},
+
+		{
+			name:     "IsIssueBulkRetryableWhenTheServerFails",
+			response: &ResponseScheme{Code: http.StatusServiceUnavailable},
+			err:      errors.New("request failed"),
+			want:     true,
+		},
+
+		{
+			name:     "IsIssueBulkRetryableWhenTheRequestIsRejected",
+			response: &ResponseScheme{Code: http.StatusBadRequest},
+			err:      errors.New("request failed"),
+			want:     false,
+		},
+
+		{
+			name: "IsIssueBulkRetryableWhenTheRequestTimesOut",
+			err:  &url.Error{Op: "Post", URL: "https://ctreminiom.atlassian.net", Err: mockTimeoutError{}},
+			want: true,
+		},
+
+		{
+			name: "IsIssueBulkRetryableWhenTheConnectionIsReset",
+			err: &url.Error{Op: "Post", URL: "https://ctreminiom.atlassian.net",
+				Err: &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}},
+			want: true,
+		},
+
+		{
+			name: "IsIssueBulkRetryableWhenTheContextIsCanceled",
+			err:  &url.Error{Op: "Post", URL: "https://ctreminiom.atlassian.net", Err: context.Canceled},
+			want: false,
+		},
+
+		{
+			name: "IsIssueBulkRetryableWhenTheContextDeadlineIsExceeded",
+			err:  fmt.Errorf("jira: %w", context.DeadlineExceeded),
+			want: false,
+		},
+
+		{
+			name: "IsIssueBulkRetryableWhenTheTransitionFieldsAreMissing",
+			err:  &models.IssueTransitionFieldsError{},
+			want: false,
+		},
+
+		{
+			name: "IsIssueBulkRetryableWhenThePayloadIsInvalid",
+			err:  errors.New("json: unsupported type"),
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, isIssueBulkRetryable(testCase.response, testCase.err))
+		})
+	}
+}
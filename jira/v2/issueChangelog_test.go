@@ -0,0 +1,754 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestIssueChangelogService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		startAt            int
+		maxResults         int
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetIssueChangelogsWhenTheParametersAreCorrect",
+			issueKeyOrID:       "TT-1",
+			startAt:            0,
+			maxResults:         100,
+			mockFile:           "../v3/mocks/get-issue-changelogs.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog?maxResults=100&startAt=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetIssueChangelogsWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID:       "",
+			startAt:            0,
+			maxResults:         100,
+			mockFile:           "../v3/mocks/get-issue-changelogs.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog?maxResults=100&startAt=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssueChangelogsWhenTheContextIsNotProvided",
+			issueKeyOrID:       "TT-1",
+			startAt:            0,
+			maxResults:         100,
+			mockFile:           "../v3/mocks/get-issue-changelogs.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog?maxResults=100&startAt=0",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssueChangelogsWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID:       "TT-1",
+			startAt:            0,
+			maxResults:         100,
+			mockFile:           "../v3/mocks/get-issue-changelogs.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog?maxResults=100&startAt=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssueChangelogsWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID:       "TT-1",
+			startAt:            0,
+			maxResults:         100,
+			mockFile:           "../v3/mocks/get-issue-changelogs.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog?maxResults=100&startAt=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssueChangelogsWhenTheResponseBodyIsEmpty",
+			issueKeyOrID:       "TT-1",
+			startAt:            0,
+			maxResults:         100,
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog?maxResults=100&startAt=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueChangelogService{client: mockClient}
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.issueKeyOrID, testCase.startAt, testCase.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssueChangelogService_All(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetAllIssueChangelogsWhenTheParametersAreCorrect",
+			issueKeyOrID:       "TT-1",
+			mockFile:           "../v3/mocks/get-issue-changelogs.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog?maxResults=100&startAt=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetAllIssueChangelogsWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID:       "",
+			mockFile:           "../v3/mocks/get-issue-changelogs.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog?maxResults=100&startAt=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetAllIssueChangelogsWhenTheContextIsNotProvided",
+			issueKeyOrID:       "TT-1",
+			mockFile:           "../v3/mocks/get-issue-changelogs.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog?maxResults=100&startAt=0",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetAllIssueChangelogsWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID:       "TT-1",
+			mockFile:           "../v3/mocks/get-issue-changelogs.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog?maxResults=100&startAt=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetAllIssueChangelogsWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID:       "TT-1",
+			mockFile:           "../v3/mocks/get-issue-changelogs.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog?maxResults=100&startAt=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetAllIssueChangelogsWhenTheResponseBodyIsEmpty",
+			issueKeyOrID:       "TT-1",
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog?maxResults=100&startAt=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueChangelogService{client: mockClient}
+			gotResult, gotResponse, err := service.All(testCase.context, testCase.issueKeyOrID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssueChangelogService_List(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		changelogIDs       []int
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetIssueChangelogsByIDsWhenTheParametersAreCorrect",
+			issueKeyOrID:       "TT-1",
+			changelogIDs:       []int{10001, 10002},
+			mockFile:           "../v3/mocks/get-issue-changelogs-by-ids.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog/list",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetIssueChangelogsByIDsWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID:       "",
+			changelogIDs:       []int{10001, 10002},
+			mockFile:           "../v3/mocks/get-issue-changelogs-by-ids.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog/list",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssueChangelogsByIDsWhenTheChangelogIDsAreNotProvided",
+			issueKeyOrID:       "TT-1",
+			changelogIDs:       nil,
+			mockFile:           "../v3/mocks/get-issue-changelogs-by-ids.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog/list",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssueChangelogsByIDsWhenTheContextIsNotProvided",
+			issueKeyOrID:       "TT-1",
+			changelogIDs:       []int{10001, 10002},
+			mockFile:           "../v3/mocks/get-issue-changelogs-by-ids.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog/list",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssueChangelogsByIDsWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID:       "TT-1",
+			changelogIDs:       []int{10001, 10002},
+			mockFile:           "../v3/mocks/get-issue-changelogs-by-ids.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog/list",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssueChangelogsByIDsWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID:       "TT-1",
+			changelogIDs:       []int{10001, 10002},
+			mockFile:           "../v3/mocks/get-issue-changelogs-by-ids.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog/list",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssueChangelogsByIDsWhenTheResponseBodyIsEmpty",
+			issueKeyOrID:       "TT-1",
+			changelogIDs:       []int{10001, 10002},
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/TT-1/changelog/list",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueChangelogService{client: mockClient}
+			gotResult, gotResponse, err := service.List(testCase.context, testCase.issueKeyOrID, testCase.changelogIDs)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssueChangelogService_Bulk(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		options            *models.IssueChangelogBulkOptionsScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetBulkChangelogsWhenTheParametersAreCorrect",
+			options:            &models.IssueChangelogBulkOptionsScheme{IssueIDsOrKeys: []string{"10100", "10101"}, FieldIDs: []string{"status", "assignee"}},
+			mockFile:           "../v3/mocks/get-bulk-changelogs.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/changelog/bulkfetch",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetBulkChangelogsWhenTheIssuesAreNotProvided",
+			options:            nil,
+			mockFile:           "../v3/mocks/get-bulk-changelogs.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/changelog/bulkfetch",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetBulkChangelogsWhenTheContextIsNotProvided",
+			options:            &models.IssueChangelogBulkOptionsScheme{IssueIDsOrKeys: []string{"10100", "10101"}, FieldIDs: []string{"status", "assignee"}},
+			mockFile:           "../v3/mocks/get-bulk-changelogs.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/changelog/bulkfetch",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetBulkChangelogsWhenTheRequestMethodIsIncorrect",
+			options:            &models.IssueChangelogBulkOptionsScheme{IssueIDsOrKeys: []string{"10100", "10101"}, FieldIDs: []string{"status", "assignee"}},
+			mockFile:           "../v3/mocks/get-bulk-changelogs.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/changelog/bulkfetch",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetBulkChangelogsWhenTheStatusCodeIsIncorrect",
+			options:            &models.IssueChangelogBulkOptionsScheme{IssueIDsOrKeys: []string{"10100", "10101"}, FieldIDs: []string{"status", "assignee"}},
+			mockFile:           "../v3/mocks/get-bulk-changelogs.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/changelog/bulkfetch",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetBulkChangelogsWhenTheResponseBodyIsEmpty",
+			options:            &models.IssueChangelogBulkOptionsScheme{IssueIDsOrKeys: []string{"10100", "10101"}, FieldIDs: []string{"status", "assignee"}},
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/changelog/bulkfetch",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueChangelogService{client: mockClient}
+			gotResult, gotResponse, err := service.Bulk(testCase.context, testCase.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssueChangelogService_BulkAll(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		options            *models.IssueChangelogBulkOptionsScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetAllBulkChangelogsWhenTheParametersAreCorrect",
+			options:            &models.IssueChangelogBulkOptionsScheme{IssueIDsOrKeys: []string{"10100", "10101"}, FieldIDs: []string{"status", "assignee"}},
+			mockFile:           "../v3/mocks/get-bulk-changelogs.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/changelog/bulkfetch",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetAllBulkChangelogsWhenTheIssuesAreNotProvided",
+			options:            nil,
+			mockFile:           "../v3/mocks/get-bulk-changelogs.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/changelog/bulkfetch",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetAllBulkChangelogsWhenTheContextIsNotProvided",
+			options:            &models.IssueChangelogBulkOptionsScheme{IssueIDsOrKeys: []string{"10100", "10101"}, FieldIDs: []string{"status", "assignee"}},
+			mockFile:           "../v3/mocks/get-bulk-changelogs.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/changelog/bulkfetch",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetAllBulkChangelogsWhenTheRequestMethodIsIncorrect",
+			options:            &models.IssueChangelogBulkOptionsScheme{IssueIDsOrKeys: []string{"10100", "10101"}, FieldIDs: []string{"status", "assignee"}},
+			mockFile:           "../v3/mocks/get-bulk-changelogs.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/changelog/bulkfetch",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetAllBulkChangelogsWhenTheStatusCodeIsIncorrect",
+			options:            &models.IssueChangelogBulkOptionsScheme{IssueIDsOrKeys: []string{"10100", "10101"}, FieldIDs: []string{"status", "assignee"}},
+			mockFile:           "../v3/mocks/get-bulk-changelogs.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/changelog/bulkfetch",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetAllBulkChangelogsWhenTheResponseBodyIsEmpty",
+			options:            &models.IssueChangelogBulkOptionsScheme{IssueIDsOrKeys: []string{"10100", "10101"}, FieldIDs: []string{"status", "assignee"}},
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/changelog/bulkfetch",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueChangelogService{client: mockClient}
+			gotResult, gotResponse, err := service.BulkAll(testCase.context, testCase.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
@@ -10,7 +10,10 @@ import (
 	"strings"
 )
 
-type CommentService struct{ client *Client }
+type CommentService struct {
+	client   *Client
+	Property *CommentPropertyService
+}
 
 // Gets returns all comments for an issue.
 // Docs: https://docs.go-atlassian.io/jira-software-cloud/issues/comments#get-comments
@@ -151,3 +154,95 @@ func (c *CommentService) Add(ctx context.Context, issueKeyOrID string, payload *
 
 	return
 }
+
+// Update updates a comment, the body and the visibility of the comment are replaced by the payload ones.
+// The comment keeps its visibility when the payload doesn't have one, set ClearVisibility to remove it.
+// The users watching the issue are notified when notify is set.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comments/#api-rest-api-2-issue-issueidorkey-comment-id-put
+func (c *CommentService) Update(ctx context.Context, issueKeyOrID, commentID string, notify bool,
+	payload *models.CommentPayloadSchemeV2, expand []string) (result *models.IssueCommentSchemeV2, response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if len(commentID) == 0 {
+		return nil, nil, models.ErrNoCommentIDError
+	}
+
+	params := url.Values{}
+	params.Add("notifyUsers", strconv.FormatBool(notify))
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/2/issue/%v/comment/%v?%v", issueKeyOrID, commentID, params.Encode())
+
+	payloadAsReader, err := transformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := c.client.newRequest(ctx, http.MethodPut, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = c.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// List returns the comments by their IDs, the comments of the issues the user can't see are not returned.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comments/#api-rest-api-2-comment-list-post
+func (c *CommentService) List(ctx context.Context, commentIDs []int, expand []string) (result *models.IssueCommentListSchemeV2,
+	response *ResponseScheme, err error) {
+
+	if len(commentIDs) == 0 {
+		return nil, nil, models.ErrNoCommentIDsError
+	}
+
+	payload := struct {
+		IDs []int `json:"ids"`
+	}{
+		IDs: commentIDs,
+	}
+
+	payloadAsReader, _ := transformStructToReader(&payload)
+
+	params := url.Values{}
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString("rest/api/2/comment/list")
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := c.client.newRequest(ctx, http.MethodPost, endpoint.String(), payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = c.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,134 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"net/http"
+)
+
+type CommentPropertyService struct{ client *Client }
+
+// Gets returns the keys of all the properties of a comment.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comment-properties/#api-rest-api-2-comment-commentid-properties-get
+func (c *CommentPropertyService) Gets(ctx context.Context, commentID string) (result *models.PropertyPageScheme,
+	response *ResponseScheme, err error) {
+
+	if len(commentID) == 0 {
+		return nil, nil, models.ErrNoCommentIDError
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/2/comment/%v/properties", commentID)
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Get returns the value of a property of a comment.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comment-properties/#api-rest-api-2-comment-commentid-properties-propertykey-get
+func (c *CommentPropertyService) Get(ctx context.Context, commentID, propertyKey string) (result *models.EntityPropertyScheme,
+	response *ResponseScheme, err error) {
+
+	if len(commentID) == 0 {
+		return nil, nil, models.ErrNoCommentIDError
+	}
+
+	if len(propertyKey) == 0 {
+		return nil, nil, models.ErrNoPropertyKeyError
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/2/comment/%v/properties/%v", commentID, propertyKey)
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Set sets the value of a property of a comment, the property is created when it doesn't exist.
+// The value must be a valid, non-empty JSON blob. The maximum length is 32768 characters.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comment-properties/#api-rest-api-2-comment-commentid-properties-propertykey-put
+func (c *CommentPropertyService) Set(ctx context.Context, commentID, propertyKey string, payload interface{}) (
+	response *ResponseScheme, err error) {
+
+	if len(commentID) == 0 {
+		return nil, models.ErrNoCommentIDError
+	}
+
+	if len(propertyKey) == 0 {
+		return nil, models.ErrNoPropertyKeyError
+	}
+
+	payloadAsReader, err := transformStructToReader(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/2/comment/%v/properties/%v", commentID, propertyKey)
+
+	request, err := c.client.newRequest(ctx, http.MethodPut, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = c.client.call(request, nil)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Delete deletes a property of a comment.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comment-properties/#api-rest-api-2-comment-commentid-properties-propertykey-delete
+func (c *CommentPropertyService) Delete(ctx context.Context, commentID, propertyKey string) (response *ResponseScheme, err error) {
+
+	if len(commentID) == 0 {
+		return nil, models.ErrNoCommentIDError
+	}
+
+	if len(propertyKey) == 0 {
+		return nil, models.ErrNoPropertyKeyError
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/2/comment/%v/properties/%v", commentID, propertyKey)
+
+	request, err := c.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	response, err = c.client.call(request, nil)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -762,3 +762,403 @@ func TestCommentService_Add(t *testing.T) {
 	}
 
 }
+
+func TestCommentService_Update(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		commentID          string
+		notify             bool
+		payload            *models2.CommentPayloadSchemeV2
+		expand             []string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:         "UpdateIssueCommentWhenTheParametersAreCorrect",
+			issueKeyOrID: "DUMMY-4",
+			commentID:    "10009",
+			notify:       false,
+			payload: &models2.CommentPayloadSchemeV2{
+				Visibility: &models2.CommentVisibilityScheme{Type: "role", Value: "Administrators"},
+				Body:       "The deployment finished successfully",
+			},
+			expand:             []string{"renderedBody"},
+			mockFile:           "../v3/mocks/get-issue-comment-by-id-v2.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/comment/10009?expand=renderedBody&notifyUsers=false",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:         "UpdateIssueCommentWhenTheUsersAreNotified",
+			issueKeyOrID: "DUMMY-4",
+			commentID:    "10009",
+			notify:       true,
+			payload: &models2.CommentPayloadSchemeV2{
+				Visibility: &models2.CommentVisibilityScheme{Type: "role", Value: "Administrators"},
+				Body:       "The deployment finished successfully",
+			},
+			expand:             nil,
+			mockFile:           "../v3/mocks/get-issue-comment-by-id-v2.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/comment/10009?notifyUsers=true",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:         "UpdateIssueCommentWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID: "",
+			commentID:    "10009",
+			notify:       false,
+			payload: &models2.CommentPayloadSchemeV2{
+				Visibility: &models2.CommentVisibilityScheme{Type: "role", Value: "Administrators"},
+				Body:       "The deployment finished successfully",
+			},
+			expand:             []string{"renderedBody"},
+			mockFile:           "../v3/mocks/get-issue-comment-by-id-v2.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/comment/10009?expand=renderedBody&notifyUsers=false",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:         "UpdateIssueCommentWhenTheCommentIDIsNotProvided",
+			issueKeyOrID: "DUMMY-4",
+			commentID:    "",
+			notify:       false,
+			payload: &models2.CommentPayloadSchemeV2{
+				Visibility: &models2.CommentVisibilityScheme{Type: "role", Value: "Administrators"},
+				Body:       "The deployment finished successfully",
+			},
+			expand:             []string{"renderedBody"},
+			mockFile:           "../v3/mocks/get-issue-comment-by-id-v2.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/comment/10009?expand=renderedBody&notifyUsers=false",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateIssueCommentWhenThePayloadIsNotProvided",
+			issueKeyOrID:       "DUMMY-4",
+			commentID:          "10009",
+			notify:             false,
+			payload:            nil,
+			expand:             []string{"renderedBody"},
+			mockFile:           "../v3/mocks/get-issue-comment-by-id-v2.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/comment/10009?expand=renderedBody&notifyUsers=false",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:         "UpdateIssueCommentWhenTheContextIsNotProvided",
+			issueKeyOrID: "DUMMY-4",
+			commentID:    "10009",
+			notify:       false,
+			payload: &models2.CommentPayloadSchemeV2{
+				Visibility: &models2.CommentVisibilityScheme{Type: "role", Value: "Administrators"},
+				Body:       "The deployment finished successfully",
+			},
+			expand:             []string{"renderedBody"},
+			mockFile:           "../v3/mocks/get-issue-comment-by-id-v2.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/comment/10009?expand=renderedBody&notifyUsers=false",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:         "UpdateIssueCommentWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID: "DUMMY-4",
+			commentID:    "10009",
+			notify:       false,
+			payload: &models2.CommentPayloadSchemeV2{
+				Visibility: &models2.CommentVisibilityScheme{Type: "role", Value: "Administrators"},
+				Body:       "The deployment finished successfully",
+			},
+			expand:             []string{"renderedBody"},
+			mockFile:           "../v3/mocks/get-issue-comment-by-id-v2.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/comment/10009?expand=renderedBody&notifyUsers=false",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:         "UpdateIssueCommentWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID: "DUMMY-4",
+			commentID:    "10009",
+			notify:       false,
+			payload: &models2.CommentPayloadSchemeV2{
+				Visibility: &models2.CommentVisibilityScheme{Type: "role", Value: "Administrators"},
+				Body:       "The deployment finished successfully",
+			},
+			expand:             []string{"renderedBody"},
+			mockFile:           "../v3/mocks/get-issue-comment-by-id-v2.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/comment/10009?expand=renderedBody&notifyUsers=false",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:         "UpdateIssueCommentWhenTheResponseBodyIsEmpty",
+			issueKeyOrID: "DUMMY-4",
+			commentID:    "10009",
+			notify:       false,
+			payload: &models2.CommentPayloadSchemeV2{
+				Visibility: &models2.CommentVisibilityScheme{Type: "role", Value: "Administrators"},
+				Body:       "The deployment finished successfully",
+			},
+			expand:             []string{"renderedBody"},
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/comment/10009?expand=renderedBody&notifyUsers=false",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &CommentService{client: mockClient}
+			gotResult, gotResponse, err := service.Update(testCase.context, testCase.issueKeyOrID, testCase.commentID, testCase.notify, testCase.payload, testCase.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestCommentService_List(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		commentIDs         []int
+		expand             []string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetIssueCommentsByIDsWhenTheParametersAreCorrect",
+			commentIDs:         []int{10009, 10010},
+			expand:             []string{"renderedBody", "properties"},
+			mockFile:           "../v3/mocks/get-comments-by-ids-v2.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/comment/list?expand=renderedBody%2Cproperties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetIssueCommentsByIDsWhenTheExpandIsNotProvided",
+			commentIDs:         []int{10009, 10010},
+			expand:             nil,
+			mockFile:           "../v3/mocks/get-comments-by-ids-v2.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/comment/list",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetIssueCommentsByIDsWhenTheCommentIDsAreNotProvided",
+			commentIDs:         nil,
+			expand:             []string{"renderedBody", "properties"},
+			mockFile:           "../v3/mocks/get-comments-by-ids-v2.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/comment/list?expand=renderedBody%2Cproperties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssueCommentsByIDsWhenTheContextIsNotProvided",
+			commentIDs:         []int{10009, 10010},
+			expand:             []string{"renderedBody", "properties"},
+			mockFile:           "../v3/mocks/get-comments-by-ids-v2.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/comment/list?expand=renderedBody%2Cproperties",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssueCommentsByIDsWhenTheRequestMethodIsIncorrect",
+			commentIDs:         []int{10009, 10010},
+			expand:             []string{"renderedBody", "properties"},
+			mockFile:           "../v3/mocks/get-comments-by-ids-v2.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/comment/list?expand=renderedBody%2Cproperties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssueCommentsByIDsWhenTheStatusCodeIsIncorrect",
+			commentIDs:         []int{10009, 10010},
+			expand:             []string{"renderedBody", "properties"},
+			mockFile:           "../v3/mocks/get-comments-by-ids-v2.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/comment/list?expand=renderedBody%2Cproperties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssueCommentsByIDsWhenTheResponseBodyIsEmpty",
+			commentIDs:         []int{10009, 10010},
+			expand:             []string{"renderedBody", "properties"},
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/comment/list?expand=renderedBody%2Cproperties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &CommentService{client: mockClient}
+			gotResult, gotResponse, err := service.List(testCase.context, testCase.commentIDs, testCase.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
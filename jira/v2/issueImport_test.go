@@ -0,0 +1,317 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestIssueService_Import(t *testing.T) {
+
+	var file = `ID,Summary,Description,Labels,Story Points,Due Date,Parent
+1,Create the importer,"Read the rows
+and create the issues","csv, import",3,31/12/2021,
+2,Map the fields,,,5,,1
+3,Report the errors,,,three,,
+4,Link the issues,,,,,KP-1
+`
+
+	var mapping = &models.IssueImportMappingScheme{
+		Columns: []*models.IssueImportColumnScheme{
+			{Column: "Summary", Field: "summary"},
+			{Column: "Description", Field: "description"},
+			{Column: "Labels", Field: "labels"},
+			{Column: "Story Points", Field: "customfield_10016", Hint: models.IssueFieldHintNumber},
+			{Column: "Due Date", Field: "duedate", Layout: "02/01/2006"},
+		},
+		ProjectKey:   "KP",
+		IssueType:    "Task",
+		IDColumn:     "ID",
+		ParentColumn: "Parent",
+	}
+
+	testCases := []struct {
+		name               string
+		reader             io.Reader
+		mapping            *models.IssueImportMappingScheme
+		wantCreated        int
+		wantFailed         int
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "ImportIssuesWhenTheParametersAreCorrect",
+			reader:             strings.NewReader(file),
+			mapping:            mapping,
+			wantCreated:        3,
+			wantFailed:         1,
+			mockFile:           "../v3/mocks/create-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/bulk",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ImportIssuesWhenJiraReturnsTheIssueErrors",
+			reader:             strings.NewReader(file),
+			mapping:            mapping,
+			wantCreated:        0,
+			wantFailed:         4,
+			mockFile:           "../v3/mocks/create-issues-failed.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/bulk",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ImportIssuesWhenTheReaderIsNotProvided",
+			reader:             nil,
+			mapping:            mapping,
+			mockFile:           "../v3/mocks/create-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/bulk",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ImportIssuesWhenTheMappingIsNotProvided",
+			reader:             strings.NewReader(file),
+			mapping:            nil,
+			mockFile:           "../v3/mocks/create-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/bulk",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ImportIssuesWhenTheFileIsEmpty",
+			reader:             strings.NewReader(""),
+			mapping:            mapping,
+			mockFile:           "../v3/mocks/create-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/bulk",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ImportIssuesWhenTheContextIsNotProvided",
+			reader:             strings.NewReader(file),
+			mapping:            mapping,
+			wantCreated:        0,
+			wantFailed:         4,
+			mockFile:           "../v3/mocks/create-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/bulk",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ImportIssuesWhenTheStatusCodeIsIncorrect",
+			reader:             strings.NewReader(file),
+			mapping:            mapping,
+			wantCreated:        0,
+			wantFailed:         4,
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/bulk",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            false,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueService{client: mockClient}
+			gotReport, err := service.Import(testCase.context, testCase.reader, testCase.mapping)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotReport, nil)
+
+				assert.Equal(t, testCase.wantCreated, gotReport.Created)
+				assert.Equal(t, testCase.wantFailed, gotReport.Failed)
+				assert.Equal(t, 4, len(gotReport.Rows))
+
+				for _, row := range gotReport.Rows {
+					t.Logf("row %v (%v): %v %v", row.Row, row.ID, row.IssueKey, row.Errors)
+
+					if len(row.Errors) == 0 {
+						assert.NotEqual(t, "", row.IssueKey)
+					}
+				}
+			}
+		})
+
+	}
+
+}
+
+// startMockImportServer starts a server with the create screen of the KP Task issue type, the customfield_10030
+// field has the Team A and Team B options. The issues are created as KP-10, KP-11...
+func startMockImportServer(t *testing.T, payloads *[]map[string]interface{}) *httptest.Server {
+
+	var created int
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /rest/api/2/issue/createmeta/KP/issuetypes":
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":50,"total":1,"issueTypes":[{"id":"10001","name":"Task"}]}`))
+
+		case "GET /rest/api/2/issue/createmeta/KP/issuetypes/10001":
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":50,"total":2,"fields":[{"fieldId":"summary"},
+				{"fieldId":"customfield_10030","allowedValues":[{"id":"1","value":"Team A"},{"id":"2","value":"Team B"}]}]}`))
+
+		case "POST /rest/api/2/issue/bulk":
+
+			body := struct {
+				IssueUpdates []map[string]interface{} `json:"issueUpdates"`
+			}{}
+
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			var issues []string
+			for _, issue := range body.IssueUpdates {
+
+				*payloads = append(*payloads, issue["fields"].(map[string]interface{}))
+				created++
+				issues = append(issues, fmt.Sprintf(`{"id":"%v","key":"KP-%v"}`, 10008+created, 9+created))
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintf(w, `{"issues":[%v],"errors":[]}`, strings.Join(issues, ","))
+
+		default:
+			t.Errorf("unexpected request %v %v", r.Method, r.URL.Path)
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+}
+
+func TestIssueService_ImportLookups(t *testing.T) {
+
+	var mapping = &models.IssueImportMappingScheme{
+		Columns: []*models.IssueImportColumnScheme{
+			{Column: "Summary", Field: "summary"},
+			{Column: "Team", Field: "customfield_10030", Hint: models.IssueFieldHintSelect},
+		},
+		ProjectKey:   "KP",
+		IssueType:    "Task",
+		IDColumn:     "ID",
+		ParentColumn: "Parent",
+	}
+
+	testCases := []struct {
+		name         string
+		file         string
+		wantErrors   map[int][]string
+		wantPayloads []map[string]interface{}
+	}{
+		{
+			name: "ImportIssuesWhenTheOptionsAreLookedUp",
+			file: "ID,Summary,Team,Parent\n1,Create the importer,team a,\n2,Map the fields,Team Z,\n3,Report the errors,,\n",
+			wantErrors: map[int][]string{
+				2: {`column "Team": the option "Team Z" isn't allowed on the field customfield_10030 of the issue type Task`},
+			},
+			wantPayloads: []map[string]interface{}{
+				{"summary": "Create the importer", "customfield_10030": map[string]interface{}{"value": "Team A"},
+					"project": map[string]interface{}{"key": "KP"}, "issuetype": map[string]interface{}{"name": "Task"}},
+				{"summary": "Report the errors",
+					"project": map[string]interface{}{"key": "KP"}, "issuetype": map[string]interface{}{"name": "Task"}},
+			},
+		},
+
+		{
+			name: "ImportIssuesWhenTheParentsFormACycle",
+			file: "ID,Summary,Team,Parent\n1,Create the importer,,2\n2,Map the fields,,1\n3,Report the errors,,1\n",
+			wantErrors: map[int][]string{
+				1: {"the parent chain forms a cycle: rows 1 -> 2 -> 1"},
+				2: {"the parent chain forms a cycle: rows 2 -> 1 -> 2"},
+				3: {"the parent chain forms a cycle: rows 3 -> 1 -> 2 -> 1"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var payloads []map[string]interface{}
+
+			mockServer := startMockImportServer(t, &payloads)
+			defer mockServer.Close()
+
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotReport, err := mockClient.Issue.Import(context.Background(), strings.NewReader(testCase.file), mapping)
+			assert.NoError(t, err)
+
+			for _, row := range gotReport.Rows {
+				t.Logf("row %v (%v): %v %v", row.Row, row.ID, row.IssueKey, row.Errors)
+				assert.Equal(t, testCase.wantErrors[row.Row], row.Errors)
+			}
+
+			assert.Equal(t, testCase.wantPayloads, payloads)
+		})
+	}
+}
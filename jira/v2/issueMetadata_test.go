@@ -7,6 +7,7 @@ import (
 	"github.com/tidwall/gjson"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -367,3 +368,649 @@ func Test_IssueMetadataService_Create_Failed(t *testing.T) {
 		})
 	}
 }
+
+func Test_IssueMetadataService_GetEditMetadata_Success(t *testing.T) {
+
+	testCases := []struct {
+		name                   string
+		overrideScreenSecurity bool
+		overrideEditableFlag   bool
+		issueKeyOrID           string
+		wantHTTPMethod         string
+		mockFile               string
+		endpoint               string
+		context                context.Context
+		wantHTTPCodeReturn     int
+	}{
+		{
+			name:                   "when_the_parameters_are_correct",
+			overrideScreenSecurity: true,
+			overrideEditableFlag:   true,
+			issueKeyOrID:           "KP-19",
+			wantHTTPMethod:         http.MethodGet,
+			mockFile:               "../v3/mocks/get-issue-metadata.json",
+			endpoint:               "/rest/api/2/issue/KP-19/editmeta?overrideEditableFlag=true&overrideScreenSecurity=true",
+			context:                context.Background(),
+			wantHTTPCodeReturn:     http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueMetadataService{client: mockClient}
+
+			gotResult, gotResponse, err := service.GetEditMetadata(
+				testCase.context,
+				testCase.issueKeyOrID,
+				testCase.overrideScreenSecurity,
+				testCase.overrideEditableFlag,
+			)
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, gotResponse, nil)
+			assert.Equal(t, 37, len(gotResult.Fields))
+			assert.Equal(t, true, gotResult.Fields["summary"].Required)
+			assert.Equal(t, "option", gotResult.Fields["customfield_10044"].Schema.Type)
+			assert.Equal(t, 4, len(gotResult.Fields["customfield_10044"].AllowedValues))
+
+			endpointToAssert, err := extractEndpotintToAssert(gotResponse.Endpoint)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, testCase.endpoint, endpointToAssert)
+		})
+	}
+}
+
+func Test_IssueMetadataService_GetEditMetadata_Failed(t *testing.T) {
+
+	testCases := []struct {
+		name                 string
+		issueKeyOrID         string
+		wantHTTPMethod       string
+		mockFile             string
+		endpoint             string
+		context              context.Context
+		wantHTTPCodeReturn   int
+		expectedErrorMessage string
+	}{
+		{
+			name:                 "when_the_http_request_method_is_incorrect",
+			issueKeyOrID:         "KP-19",
+			wantHTTPMethod:       http.MethodPost,
+			mockFile:             "../v3/mocks/get-issue-metadata.json",
+			endpoint:             "/rest/api/2/issue/KP-19/editmeta",
+			context:              context.Background(),
+			wantHTTPCodeReturn:   http.StatusOK,
+			expectedErrorMessage: "request failed. Please analyze the request body for more details. Status Code: 405",
+		},
+
+		{
+			name:                 "when_the_context_provided_is_nil",
+			issueKeyOrID:         "KP-19",
+			wantHTTPMethod:       http.MethodGet,
+			mockFile:             "../v3/mocks/get-issue-metadata.json",
+			endpoint:             "/rest/api/2/issue/KP-19/editmeta",
+			context:              nil,
+			wantHTTPCodeReturn:   http.StatusOK,
+			expectedErrorMessage: "request creation failed: net/http: nil Context",
+		},
+
+		{
+			name:                 "when_the_issue_key_or_id_is_not_provided",
+			issueKeyOrID:         "",
+			wantHTTPMethod:       http.MethodGet,
+			mockFile:             "../v3/mocks/get-issue-metadata.json",
+			endpoint:             "/rest/api/2/issue/KP-19/editmeta",
+			context:              context.Background(),
+			wantHTTPCodeReturn:   http.StatusOK,
+			expectedErrorMessage: "jira: no issue key/id set",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueMetadataService{client: mockClient}
+
+			_, _, err = service.GetEditMetadata(testCase.context, testCase.issueKeyOrID, false, false)
+
+			assert.EqualError(t, err, testCase.expectedErrorMessage)
+		})
+	}
+}
+
+func Test_IssueMetadataService_GetCreateMetadata_Success(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		opts               *models.IssueMetadataCreateOptions
+		wantHTTPMethod     string
+		mockFile           string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+	}{
+		{
+			name: "when_the_parameters_are_correct",
+			opts: &models.IssueMetadataCreateOptions{
+				ProjectKeys: []string{"K2"},
+				Expand:      "projects.issuetypes.fields",
+			},
+			wantHTTPMethod:     http.MethodGet,
+			mockFile:           "../v3/mocks/get-issue-create-metadata.json",
+			endpoint:           "/rest/api/2/issue/createmeta?expand=projects.issuetypes.fields&projectKeys=K2",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+		},
+
+		{
+			name:               "when_the_options_are_not_provided",
+			opts:               nil,
+			wantHTTPMethod:     http.MethodGet,
+			mockFile:           "../v3/mocks/get-issue-create-metadata.json",
+			endpoint:           "/rest/api/2/issue/createmeta",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueMetadataService{client: mockClient}
+
+			gotResult, gotResponse, err := service.GetCreateMetadata(testCase.context, testCase.opts)
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, gotResponse, nil)
+
+			issueType := gotResult.IssueType("K2", "Sub-task")
+			assert.NotNil(t, issueType)
+			assert.Equal(t, "10003", issueType.ID)
+			assert.Equal(t, true, issueType.Fields["parent"].Required)
+			assert.Equal(t, issueType, gotResult.IssueType("10003", "10003"))
+			assert.Nil(t, gotResult.IssueType("KP", "Sub-task"))
+
+			endpointToAssert, err := extractEndpotintToAssert(gotResponse.Endpoint)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, testCase.endpoint, endpointToAssert)
+		})
+	}
+}
+
+// startMockCreateMetadataServer starts a server returning the issue types of the K2 project and the fields of the
+// create screen of its Task issue type.
+func startMockCreateMetadataServer(t *testing.T) *httptest.Server {
+
+	issueTypes, err := ioutil.ReadFile("../v3/mocks/get-issue-create-metadata-issue-types.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fields, err := ioutil.ReadFile("../v3/mocks/get-issue-create-metadata-fields.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/rest/api/2/issue/createmeta/K2/issuetypes":
+			_, _ = w.Write(issueTypes)
+
+		case "/rest/api/2/issue/createmeta/K2/issuetypes/10002":
+			_, _ = w.Write(fields)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages":["Issue Does Not Exist"],"errors":{}}`))
+		}
+	}))
+}
+
+func Test_IssueMetadataService_GetCreateIssueTypes(t *testing.T) {
+
+	testCases := []struct {
+		name                 string
+		projectKeyOrID       string
+		context              context.Context
+		wantIssueTypes       int
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when_the_parameters_are_correct",
+			projectKeyOrID: "K2",
+			context:        context.Background(),
+			wantIssueTypes: 5,
+		},
+
+		{
+			name:                 "when_the_project_is_not_provided",
+			projectKeyOrID:       "",
+			context:              context.Background(),
+			expectedErrorMessage: "jira: no project id set",
+		},
+
+		{
+			name:                 "when_the_project_is_not_found",
+			projectKeyOrID:       "KP",
+			context:              context.Background(),
+			expectedErrorMessage: "request failed. Please analyze the request body for more details. Status Code: 404",
+		},
+
+		{
+			name:                 "when_the_context_provided_is_nil",
+			projectKeyOrID:       "K2",
+			context:              nil,
+			expectedErrorMessage: "request creation failed: net/http: nil Context",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			mockServer := startMockCreateMetadataServer(t)
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueMetadataService{client: mockClient}
+
+			gotResult, gotResponse, err := service.GetCreateIssueTypes(testCase.context, testCase.projectKeyOrID, 0, 50)
+
+			if testCase.expectedErrorMessage != "" {
+				assert.EqualError(t, err, testCase.expectedErrorMessage)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, gotResponse, nil)
+			assert.Equal(t, testCase.wantIssueTypes, len(gotResult.IssueTypes))
+			assert.Equal(t, "Task", gotResult.IssueTypes[0].Name)
+
+			endpointToAssert, err := extractEndpotintToAssert(gotResponse.Endpoint)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, "/rest/api/2/issue/createmeta/K2/issuetypes?maxResults=50&startAt=0", endpointToAssert)
+		})
+	}
+}
+
+func Test_IssueMetadataService_GetCreateFields(t *testing.T) {
+
+	testCases := []struct {
+		name                 string
+		projectKeyOrID       string
+		issueTypeID          string
+		context              context.Context
+		expectedErrorMessage string
+	}{
+		{
+			name:           "when_the_parameters_are_correct",
+			projectKeyOrID: "K2",
+			issueTypeID:    "10002",
+			context:        context.Background(),
+		},
+
+		{
+			name:                 "when_the_project_is_not_provided",
+			projectKeyOrID:       "",
+			issueTypeID:          "10002",
+			context:              context.Background(),
+			expectedErrorMessage: "jira: no project id set",
+		},
+
+		{
+			name:                 "when_the_issue_type_is_not_provided",
+			projectKeyOrID:       "K2",
+			issueTypeID:          "",
+			context:              context.Background(),
+			expectedErrorMessage: "jira: no issue type id set",
+		},
+
+		{
+			name:                 "when_the_issue_type_is_not_found",
+			projectKeyOrID:       "K2",
+			issueTypeID:          "99999",
+			context:              context.Background(),
+			expectedErrorMessage: "request failed. Please analyze the request body for more details. Status Code: 404",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			mockServer := startMockCreateMetadataServer(t)
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueMetadataService{client: mockClient}
+
+			gotResult, gotResponse, err := service.GetCreateFields(testCase.context, testCase.projectKeyOrID,
+				testCase.issueTypeID, 0, 50)
+
+			if testCase.expectedErrorMessage != "" {
+				assert.EqualError(t, err, testCase.expectedErrorMessage)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, gotResponse, nil)
+			assert.Equal(t, 12, len(gotResult.Fields))
+			assert.Equal(t, "summary", gotResult.Fields[0].FieldID)
+			assert.Equal(t, true, gotResult.Fields[0].Required)
+
+			endpointToAssert, err := extractEndpotintToAssert(gotResponse.Endpoint)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, "/rest/api/2/issue/createmeta/K2/issuetypes/10002?maxResults=50&startAt=0", endpointToAssert)
+		})
+	}
+}
+
+func Test_IssueMetadataService_ValidateCreate(t *testing.T) {
+
+	customFields := &models.CustomFields{}
+	_ = customFields.Text("customfield_10049", "The field isn't on the create screen")
+	_ = customFields.Number("labels", 2)
+
+	testCases := []struct {
+		name                 string
+		payload              *models.IssueSchemeV2
+		customFields         *models.CustomFields
+		context              context.Context
+		wantProblems         []string
+		expectedErrorMessage string
+	}{
+		{
+			name: "when_the_payload_is_valid",
+			payload: &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{
+				Summary:   "Migrate the billing service",
+				Project:   &models.ProjectScheme{Key: "K2"},
+				IssueType: &models.IssueTypeScheme{ID: "10002"},
+				Priority:  &models.PriorityScheme{Name: "High"},
+				Labels:    []string{"backend"},
+			}},
+			context: context.Background(),
+		},
+
+		{
+			name: "when_the_payload_has_several_problems",
+			payload: &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{
+				Project:   &models.ProjectScheme{Key: "K2"},
+				IssueType: &models.IssueTypeScheme{Name: "Task"},
+				Priority:  &models.PriorityScheme{ID: "99"},
+			}},
+			customFields: customFields,
+			context:      context.Background(),
+			wantProblems: []string{
+				"customfield_10049/not-on-screen",
+				"labels/type",
+				"priority/not-allowed",
+				"summary/required",
+			},
+		},
+
+		{
+			name: "when_the_issue_type_is_not_on_the_metadata",
+			payload: &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{
+				Project:   &models.ProjectScheme{Key: "K2"},
+				IssueType: &models.IssueTypeScheme{Name: "Incident"},
+			}},
+			context:              context.Background(),
+			expectedErrorMessage: "jira: the project K2 doesn't have the issue type Incident on the create metadata",
+		},
+
+		{
+			name: "when_the_issue_type_id_is_not_on_the_project",
+			payload: &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{
+				Project:   &models.ProjectScheme{Key: "K2"},
+				IssueType: &models.IssueTypeScheme{ID: "99999"},
+			}},
+			context:              context.Background(),
+			expectedErrorMessage: "request failed. Please analyze the request body for more details. Status Code: 404",
+		},
+
+		{
+			name:                 "when_the_project_is_not_provided",
+			payload:              &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{Summary: "Migrate the billing service"}},
+			context:              context.Background(),
+			expectedErrorMessage: "jira: no project or issue type set on the payload",
+		},
+
+		{
+			name: "when_the_context_provided_is_nil",
+			payload: &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{
+				Project:   &models.ProjectScheme{Key: "K2"},
+				IssueType: &models.IssueTypeScheme{ID: "10002"},
+			}},
+			context:              nil,
+			expectedErrorMessage: "request creation failed: net/http: nil Context",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			mockServer := startMockCreateMetadataServer(t)
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueMetadataService{client: mockClient}
+
+			_, err = service.ValidateCreate(testCase.context, testCase.payload, testCase.customFields)
+
+			switch {
+			case testCase.expectedErrorMessage != "":
+				assert.EqualError(t, err, testCase.expectedErrorMessage)
+
+			case testCase.wantProblems != nil:
+
+				validationError, ok := err.(*models.IssueFieldValidationError)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				var gotProblems []string
+				for _, problem := range validationError.Problems {
+					gotProblems = append(gotProblems, problem.FieldID+"/"+problem.Problem)
+				}
+
+				assert.Equal(t, testCase.wantProblems, gotProblems)
+
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_IssueMetadataService_ValidateEdit(t *testing.T) {
+
+	customFields := &models.CustomFields{}
+	_ = customFields.RadioButton("customfield_10044", "Option 9")
+	_ = customFields.Text("customfield_10043", "five")
+	_ = customFields.MultiSelect("customfield_10046", []string{"Option 1", "Option 2"})
+	_ = customFields.Text("customfield_99999", "The field doesn't exist")
+
+	testCases := []struct {
+		name                 string
+		issueKeyOrID         string
+		payload              *models.IssueSchemeV2
+		customFields         *models.CustomFields
+		context              context.Context
+		wantProblems         []string
+		expectedErrorMessage string
+	}{
+		{
+			name:         "when_the_payload_is_valid",
+			issueKeyOrID: "KP-19",
+			payload: &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{
+				Description: "The description of the issue",
+				FixVersions: []*models.VersionScheme{{ID: "10000"}},
+			}},
+			context: context.Background(),
+		},
+
+		{
+			name:         "when_the_payload_has_several_problems",
+			issueKeyOrID: "KP-19",
+			payload:      &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{Summary: "The summary isn't required"}},
+			customFields: customFields,
+			context:      context.Background(),
+			wantProblems: []string{
+				"customfield_10043/type",
+				"customfield_10044/not-allowed",
+				"customfield_99999/not-on-screen",
+			},
+		},
+
+		{
+			name:                 "when_the_issue_key_or_id_is_not_provided",
+			issueKeyOrID:         "",
+			context:              context.Background(),
+			expectedErrorMessage: "jira: no issue key/id set",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           "/rest/api/2/issue/KP-19/editmeta",
+				MockFilePath:       "../v3/mocks/get-issue-metadata.json",
+				MethodAccepted:     http.MethodGet,
+				ResponseCodeWanted: http.StatusOK,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueMetadataService{client: mockClient}
+
+			_, err = service.ValidateEdit(testCase.context, testCase.issueKeyOrID, testCase.payload, testCase.customFields)
+
+			switch {
+			case testCase.expectedErrorMessage != "":
+				assert.EqualError(t, err, testCase.expectedErrorMessage)
+
+			case testCase.wantProblems != nil:
+
+				validationError, ok := err.(*models.IssueFieldValidationError)
+				if !ok {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				var gotProblems []string
+				for _, problem := range validationError.Problems {
+					gotProblems = append(gotProblems, problem.FieldID+"/"+problem.Problem)
+				}
+
+				assert.Equal(t, testCase.wantProblems, gotProblems)
+
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,1011 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestIssuePropertyService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetIssuePropertyKeysWhenTheParametersAreCorrect",
+			issueKeyOrID:       "DUMMY-4",
+			mockFile:           "../v3/mocks/get-issue-property-keys.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetIssuePropertyKeysWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID:       "",
+			mockFile:           "../v3/mocks/get-issue-property-keys.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssuePropertyKeysWhenTheContextIsNotProvided",
+			issueKeyOrID:       "DUMMY-4",
+			mockFile:           "../v3/mocks/get-issue-property-keys.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssuePropertyKeysWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID:       "DUMMY-4",
+			mockFile:           "../v3/mocks/get-issue-property-keys.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssuePropertyKeysWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID:       "DUMMY-4",
+			mockFile:           "../v3/mocks/get-issue-property-keys.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssuePropertyKeysWhenTheResponseBodyIsEmpty",
+			issueKeyOrID:       "DUMMY-4",
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssuePropertyService{client: mockClient}
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.issueKeyOrID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssuePropertyService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		propertyKey        string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetIssuePropertyWhenTheParametersAreCorrect",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "issue.support",
+			mockFile:           "../v3/mocks/get-issue-properties.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetIssuePropertyWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID:       "",
+			propertyKey:        "issue.support",
+			mockFile:           "../v3/mocks/get-issue-properties.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssuePropertyWhenThePropertyKeyIsNotProvided",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "",
+			mockFile:           "../v3/mocks/get-issue-properties.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssuePropertyWhenTheContextIsNotProvided",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "issue.support",
+			mockFile:           "../v3/mocks/get-issue-properties.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssuePropertyWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "issue.support",
+			mockFile:           "../v3/mocks/get-issue-properties.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssuePropertyWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "issue.support",
+			mockFile:           "../v3/mocks/get-issue-properties.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetIssuePropertyWhenTheResponseBodyIsEmpty",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "issue.support",
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssuePropertyService{client: mockClient}
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.issueKeyOrID, testCase.propertyKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssuePropertyService_Set(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		propertyKey        string
+		payload            interface{}
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "SetIssuePropertyWhenTheParametersAreCorrect",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "issue.support",
+			payload:            map[string]interface{}{"system.support.time": "1m"},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "SetIssuePropertyWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID:       "",
+			propertyKey:        "issue.support",
+			payload:            map[string]interface{}{"system.support.time": "1m"},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SetIssuePropertyWhenThePropertyKeyIsNotProvided",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "",
+			payload:            map[string]interface{}{"system.support.time": "1m"},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SetIssuePropertyWhenThePayloadIsNotProvided",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "issue.support",
+			payload:            nil,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SetIssuePropertyWhenTheContextIsNotProvided",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "issue.support",
+			payload:            map[string]interface{}{"system.support.time": "1m"},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SetIssuePropertyWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "issue.support",
+			payload:            map[string]interface{}{"system.support.time": "1m"},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SetIssuePropertyWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "issue.support",
+			payload:            map[string]interface{}{"system.support.time": "1m"},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssuePropertyService{client: mockClient}
+			gotResponse, err := service.Set(testCase.context, testCase.issueKeyOrID, testCase.propertyKey, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssuePropertyService_Delete(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		propertyKey        string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "DeleteIssuePropertyWhenTheParametersAreCorrect",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "issue.support",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeleteIssuePropertyWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID:       "",
+			propertyKey:        "issue.support",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteIssuePropertyWhenThePropertyKeyIsNotProvided",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteIssuePropertyWhenTheContextIsNotProvided",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "issue.support",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteIssuePropertyWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "issue.support",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteIssuePropertyWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID:       "DUMMY-4",
+			propertyKey:        "issue.support",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/DUMMY-4/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssuePropertyService{client: mockClient}
+			gotResponse, err := service.Delete(testCase.context, testCase.issueKeyOrID, testCase.propertyKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssuePropertyService_BulkSet(t *testing.T) {
+
+	hasProperty := false
+
+	testCases := []struct {
+		name               string
+		propertyKey        string
+		payload            *models.IssuePropertyBulkSetScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		followRedirects    bool
+		wantErr            bool
+	}{
+		{
+			name:        "BulkSetIssuePropertyWhenTheParametersAreCorrect",
+			propertyKey: "issue.support",
+			payload: &models.IssuePropertyBulkSetScheme{
+				Value:  map[string]interface{}{"owner": "support"},
+				Filter: &models.IssuePropertyBulkSetFilterScheme{EntityIDs: []int{10001, 10002}, HasProperty: &hasProperty},
+			},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusSeeOther,
+			wantErr:            false,
+		},
+
+		{
+			name:        "BulkSetIssuePropertyWhenTheRedirectIsFollowed",
+			propertyKey: "issue.support",
+			payload: &models.IssuePropertyBulkSetScheme{
+				Value:  map[string]interface{}{"owner": "support"},
+				Filter: &models.IssuePropertyBulkSetFilterScheme{EntityIDs: []int{10001, 10002}, HasProperty: &hasProperty},
+			},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusSeeOther,
+			followRedirects:    true,
+			wantErr:            false,
+		},
+
+		{
+			name:        "BulkSetIssuePropertyWhenThePropertyKeyIsNotProvided",
+			propertyKey: "",
+			payload: &models.IssuePropertyBulkSetScheme{
+				Value:  map[string]interface{}{"owner": "support"},
+				Filter: &models.IssuePropertyBulkSetFilterScheme{EntityIDs: []int{10001, 10002}, HasProperty: &hasProperty},
+			},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusSeeOther,
+			wantErr:            true,
+		},
+
+		{
+			name:        "BulkSetIssuePropertyWhenTheContextIsNotProvided",
+			propertyKey: "issue.support",
+			payload: &models.IssuePropertyBulkSetScheme{
+				Value:  map[string]interface{}{"owner": "support"},
+				Filter: &models.IssuePropertyBulkSetFilterScheme{EntityIDs: []int{10001, 10002}, HasProperty: &hasProperty},
+			},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusSeeOther,
+			wantErr:            true,
+		},
+
+		{
+			name:        "BulkSetIssuePropertyWhenTheRequestMethodIsIncorrect",
+			propertyKey: "issue.support",
+			payload: &models.IssuePropertyBulkSetScheme{
+				Value:  map[string]interface{}{"owner": "support"},
+				Filter: &models.IssuePropertyBulkSetFilterScheme{EntityIDs: []int{10001, 10002}, HasProperty: &hasProperty},
+			},
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusSeeOther,
+			wantErr:            true,
+		},
+
+		{
+			name:        "BulkSetIssuePropertyWhenTheStatusCodeIsIncorrect",
+			propertyKey: "issue.support",
+			payload: &models.IssuePropertyBulkSetScheme{
+				Value:  map[string]interface{}{"owner": "support"},
+				Filter: &models.IssuePropertyBulkSetFilterScheme{EntityIDs: []int{10001, 10002}, HasProperty: &hasProperty},
+			},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:        "BulkSetIssuePropertyWhenTheResponseBodyIsEmpty",
+			propertyKey: "issue.support",
+			payload: &models.IssuePropertyBulkSetScheme{
+				Value:  map[string]interface{}{"owner": "support"},
+				Filter: &models.IssuePropertyBulkSetFilterScheme{EntityIDs: []int{10001, 10002}, HasProperty: &hasProperty},
+			},
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer := startMockTaskServer(&mockOptions)
+			defer mockServer.Close()
+
+			// The HTTP client returns the redirects, unless the test follows them
+			httpClient := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+			if testCase.followRedirects {
+				httpClient = nil
+			}
+
+			//Init the library instance
+			mockClient, err := New(httpClient, mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssuePropertyService{client: mockClient}
+			gotResult, gotResponse, err := service.BulkSet(testCase.context, testCase.propertyKey, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				// The task is read from the Location of the redirect
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", "/rest/api/2/task/1", endpointToAssert)
+				assert.Equal(t, "/rest/api/2/task/1", endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssuePropertyService_BulkDelete(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		propertyKey        string
+		filter             *models.IssuePropertyBulkDeleteScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		followRedirects    bool
+		wantErr            bool
+	}{
+		{
+			name:               "BulkDeleteIssuePropertyWhenTheParametersAreCorrect",
+			propertyKey:        "issue.support",
+			filter:             &models.IssuePropertyBulkDeleteScheme{EntityIDs: []int{10001, 10002}, CurrentValue: map[string]interface{}{"owner": "support"}},
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusSeeOther,
+			wantErr:            false,
+		},
+
+		{
+			name:               "BulkDeleteIssuePropertyWhenTheFilterIsNotProvided",
+			propertyKey:        "issue.support",
+			filter:             nil,
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusSeeOther,
+			wantErr:            false,
+		},
+
+		{
+			name:               "BulkDeleteIssuePropertyWhenTheRedirectIsFollowed",
+			propertyKey:        "issue.support",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusSeeOther,
+			followRedirects:    true,
+			wantErr:            false,
+		},
+
+		{
+			name:               "BulkDeleteIssuePropertyWhenThePropertyKeyIsNotProvided",
+			propertyKey:        "",
+			filter:             &models.IssuePropertyBulkDeleteScheme{EntityIDs: []int{10001, 10002}, CurrentValue: map[string]interface{}{"owner": "support"}},
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusSeeOther,
+			wantErr:            true,
+		},
+
+		{
+			name:               "BulkDeleteIssuePropertyWhenTheContextIsNotProvided",
+			propertyKey:        "issue.support",
+			filter:             &models.IssuePropertyBulkDeleteScheme{EntityIDs: []int{10001, 10002}, CurrentValue: map[string]interface{}{"owner": "support"}},
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusSeeOther,
+			wantErr:            true,
+		},
+
+		{
+			name:               "BulkDeleteIssuePropertyWhenTheRequestMethodIsIncorrect",
+			propertyKey:        "issue.support",
+			filter:             &models.IssuePropertyBulkDeleteScheme{EntityIDs: []int{10001, 10002}, CurrentValue: map[string]interface{}{"owner": "support"}},
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusSeeOther,
+			wantErr:            true,
+		},
+
+		{
+			name:               "BulkDeleteIssuePropertyWhenTheStatusCodeIsIncorrect",
+			propertyKey:        "issue.support",
+			filter:             &models.IssuePropertyBulkDeleteScheme{EntityIDs: []int{10001, 10002}, CurrentValue: map[string]interface{}{"owner": "support"}},
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "BulkDeleteIssuePropertyWhenTheResponseBodyIsEmpty",
+			propertyKey:        "issue.support",
+			filter:             &models.IssuePropertyBulkDeleteScheme{EntityIDs: []int{10001, 10002}, CurrentValue: map[string]interface{}{"owner": "support"}},
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/properties/issue.support",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer := startMockTaskServer(&mockOptions)
+			defer mockServer.Close()
+
+			// The HTTP client returns the redirects, unless the test follows them
+			httpClient := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+			if testCase.followRedirects {
+				httpClient = nil
+			}
+
+			//Init the library instance
+			mockClient, err := New(httpClient, mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssuePropertyService{client: mockClient}
+			gotResult, gotResponse, err := service.BulkDelete(testCase.context, testCase.propertyKey, testCase.filter)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				// The task is read from the Location of the redirect
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", "/rest/api/2/task/1", endpointToAssert)
+				assert.Equal(t, "/rest/api/2/task/1", endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+// startMockTaskServer starts the mock server of an asynchronous operation, the 303 See Other responses redirect
+// to the task 1.
+func startMockTaskServer(opts *mockServerOptions) *httptest.Server {
+
+	if opts.ResponseCodeWanted == http.StatusSeeOther {
+		opts.Headers = map[string]string{"Location": "/rest/api/2/task/1"}
+	}
+
+	operation := mockServerHandler(opts)
+	task := mockServerHandler(&mockServerOptions{
+		Endpoint:           "/rest/api/2/task/1",
+		MockFilePath:       "../v3/mocks/task.json",
+		MethodAccepted:     http.MethodGet,
+		ResponseCodeWanted: http.StatusOK,
+	})
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if r.URL.Path == "/rest/api/2/task/1" {
+			task(w, r)
+			return
+		}
+
+		operation(w, r)
+	}))
+}
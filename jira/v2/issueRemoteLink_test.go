@@ -0,0 +1,1220 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestIssueRemoteLinkService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetRemoteLinksWhenTheParametersAreCorrect",
+			issueKeyOrID:       "KP-1",
+			mockFile:           "../v3/mocks/get-remote-links.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetRemoteLinksWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID:       "",
+			mockFile:           "../v3/mocks/get-remote-links.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRemoteLinksWhenTheContextIsNotProvided",
+			issueKeyOrID:       "KP-1",
+			mockFile:           "../v3/mocks/get-remote-links.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRemoteLinksWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID:       "KP-1",
+			mockFile:           "../v3/mocks/get-remote-links.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRemoteLinksWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID:       "KP-1",
+			mockFile:           "../v3/mocks/get-remote-links.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRemoteLinksWhenTheResponseBodyIsEmpty",
+			issueKeyOrID:       "KP-1",
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueRemoteLinkService{client: mockClient}
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.issueKeyOrID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssueRemoteLinkService_GetByGlobalID(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		globalID           string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetRemoteLinkByGlobalIDWhenTheParametersAreCorrect",
+			issueKeyOrID:       "KP-1",
+			globalID:           "system=https://ci.example.com&id=build-1234",
+			mockFile:           "../v3/mocks/get-remote-link.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink?globalId=system%3Dhttps%3A%2F%2Fci.example.com%26id%3Dbuild-1234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetRemoteLinkByGlobalIDWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID:       "",
+			globalID:           "system=https://ci.example.com&id=build-1234",
+			mockFile:           "../v3/mocks/get-remote-link.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink?globalId=system%3Dhttps%3A%2F%2Fci.example.com%26id%3Dbuild-1234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRemoteLinkByGlobalIDWhenTheGlobalIDIsNotProvided",
+			issueKeyOrID:       "KP-1",
+			globalID:           "",
+			mockFile:           "../v3/mocks/get-remote-link.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink?globalId=system%3Dhttps%3A%2F%2Fci.example.com%26id%3Dbuild-1234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRemoteLinkByGlobalIDWhenTheContextIsNotProvided",
+			issueKeyOrID:       "KP-1",
+			globalID:           "system=https://ci.example.com&id=build-1234",
+			mockFile:           "../v3/mocks/get-remote-link.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink?globalId=system%3Dhttps%3A%2F%2Fci.example.com%26id%3Dbuild-1234",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRemoteLinkByGlobalIDWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID:       "KP-1",
+			globalID:           "system=https://ci.example.com&id=build-1234",
+			mockFile:           "../v3/mocks/get-remote-link.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink?globalId=system%3Dhttps%3A%2F%2Fci.example.com%26id%3Dbuild-1234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRemoteLinkByGlobalIDWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID:       "KP-1",
+			globalID:           "system=https://ci.example.com&id=build-1234",
+			mockFile:           "../v3/mocks/get-remote-link.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink?globalId=system%3Dhttps%3A%2F%2Fci.example.com%26id%3Dbuild-1234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRemoteLinkByGlobalIDWhenTheResponseBodyIsEmpty",
+			issueKeyOrID:       "KP-1",
+			globalID:           "system=https://ci.example.com&id=build-1234",
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink?globalId=system%3Dhttps%3A%2F%2Fci.example.com%26id%3Dbuild-1234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueRemoteLinkService{client: mockClient}
+			gotResult, gotResponse, err := service.GetByGlobalID(testCase.context, testCase.issueKeyOrID, testCase.globalID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssueRemoteLinkService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		linkID             string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetRemoteLinkWhenTheParametersAreCorrect",
+			issueKeyOrID:       "KP-1",
+			linkID:             "10000",
+			mockFile:           "../v3/mocks/get-remote-link.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetRemoteLinkWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID:       "",
+			linkID:             "10000",
+			mockFile:           "../v3/mocks/get-remote-link.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRemoteLinkWhenTheLinkIDIsNotProvided",
+			issueKeyOrID:       "KP-1",
+			linkID:             "",
+			mockFile:           "../v3/mocks/get-remote-link.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRemoteLinkWhenTheContextIsNotProvided",
+			issueKeyOrID:       "KP-1",
+			linkID:             "10000",
+			mockFile:           "../v3/mocks/get-remote-link.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRemoteLinkWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID:       "KP-1",
+			linkID:             "10000",
+			mockFile:           "../v3/mocks/get-remote-link.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRemoteLinkWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID:       "KP-1",
+			linkID:             "10000",
+			mockFile:           "../v3/mocks/get-remote-link.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRemoteLinkWhenTheResponseBodyIsEmpty",
+			issueKeyOrID:       "KP-1",
+			linkID:             "10000",
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueRemoteLinkService{client: mockClient}
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.issueKeyOrID, testCase.linkID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssueRemoteLinkService_Create(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		payload            *models.RemoteLinkScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:         "CreateRemoteLinkWhenTheParametersAreCorrect",
+			issueKeyOrID: "KP-1",
+			payload: &models.RemoteLinkScheme{
+				GlobalID:     "system=https://ci.example.com&id=build-1234",
+				Application:  &models.RemoteLinkApplicationScheme{Type: "com.example.ci", Name: "Example CI"},
+				Relationship: "built by",
+				Object: &models.RemoteLinkObjectScheme{
+					URL:    "https://ci.example.com/builds/1234",
+					Title:  "Build #1234",
+					Icon:   &models.RemoteLinkObjectIconScheme{URL: "https://ci.example.com/favicon.png", Title: "Example CI"},
+					Status: &models.RemoteLinkObjectStatusScheme{Resolved: true},
+				},
+			},
+			mockFile:           "../v3/mocks/create-remote-link.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            false,
+		},
+
+		{
+			name:         "CreateRemoteLinkWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID: "",
+			payload: &models.RemoteLinkScheme{
+				GlobalID:     "system=https://ci.example.com&id=build-1234",
+				Application:  &models.RemoteLinkApplicationScheme{Type: "com.example.ci", Name: "Example CI"},
+				Relationship: "built by",
+				Object: &models.RemoteLinkObjectScheme{
+					URL:    "https://ci.example.com/builds/1234",
+					Title:  "Build #1234",
+					Icon:   &models.RemoteLinkObjectIconScheme{URL: "https://ci.example.com/favicon.png", Title: "Example CI"},
+					Status: &models.RemoteLinkObjectStatusScheme{Resolved: true},
+				},
+			},
+			mockFile:           "../v3/mocks/create-remote-link.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateRemoteLinkWhenThePayloadIsNotProvided",
+			issueKeyOrID:       "KP-1",
+			payload:            nil,
+			mockFile:           "../v3/mocks/create-remote-link.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:         "CreateRemoteLinkWhenTheContextIsNotProvided",
+			issueKeyOrID: "KP-1",
+			payload: &models.RemoteLinkScheme{
+				GlobalID:     "system=https://ci.example.com&id=build-1234",
+				Application:  &models.RemoteLinkApplicationScheme{Type: "com.example.ci", Name: "Example CI"},
+				Relationship: "built by",
+				Object: &models.RemoteLinkObjectScheme{
+					URL:    "https://ci.example.com/builds/1234",
+					Title:  "Build #1234",
+					Icon:   &models.RemoteLinkObjectIconScheme{URL: "https://ci.example.com/favicon.png", Title: "Example CI"},
+					Status: &models.RemoteLinkObjectStatusScheme{Resolved: true},
+				},
+			},
+			mockFile:           "../v3/mocks/create-remote-link.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:         "CreateRemoteLinkWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID: "KP-1",
+			payload: &models.RemoteLinkScheme{
+				GlobalID:     "system=https://ci.example.com&id=build-1234",
+				Application:  &models.RemoteLinkApplicationScheme{Type: "com.example.ci", Name: "Example CI"},
+				Relationship: "built by",
+				Object: &models.RemoteLinkObjectScheme{
+					URL:    "https://ci.example.com/builds/1234",
+					Title:  "Build #1234",
+					Icon:   &models.RemoteLinkObjectIconScheme{URL: "https://ci.example.com/favicon.png", Title: "Example CI"},
+					Status: &models.RemoteLinkObjectStatusScheme{Resolved: true},
+				},
+			},
+			mockFile:           "../v3/mocks/create-remote-link.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:         "CreateRemoteLinkWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID: "KP-1",
+			payload: &models.RemoteLinkScheme{
+				GlobalID:     "system=https://ci.example.com&id=build-1234",
+				Application:  &models.RemoteLinkApplicationScheme{Type: "com.example.ci", Name: "Example CI"},
+				Relationship: "built by",
+				Object: &models.RemoteLinkObjectScheme{
+					URL:    "https://ci.example.com/builds/1234",
+					Title:  "Build #1234",
+					Icon:   &models.RemoteLinkObjectIconScheme{URL: "https://ci.example.com/favicon.png", Title: "Example CI"},
+					Status: &models.RemoteLinkObjectStatusScheme{Resolved: true},
+				},
+			},
+			mockFile:           "../v3/mocks/create-remote-link.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:         "CreateRemoteLinkWhenTheResponseBodyIsEmpty",
+			issueKeyOrID: "KP-1",
+			payload: &models.RemoteLinkScheme{
+				GlobalID:     "system=https://ci.example.com&id=build-1234",
+				Application:  &models.RemoteLinkApplicationScheme{Type: "com.example.ci", Name: "Example CI"},
+				Relationship: "built by",
+				Object: &models.RemoteLinkObjectScheme{
+					URL:    "https://ci.example.com/builds/1234",
+					Title:  "Build #1234",
+					Icon:   &models.RemoteLinkObjectIconScheme{URL: "https://ci.example.com/favicon.png", Title: "Example CI"},
+					Status: &models.RemoteLinkObjectStatusScheme{Resolved: true},
+				},
+			},
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueRemoteLinkService{client: mockClient}
+			gotResult, gotResponse, err := service.Create(testCase.context, testCase.issueKeyOrID, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssueRemoteLinkService_Update(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		linkID             string
+		payload            *models.RemoteLinkScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:         "UpdateRemoteLinkWhenTheParametersAreCorrect",
+			issueKeyOrID: "KP-1",
+			linkID:       "10000",
+			payload: &models.RemoteLinkScheme{
+				GlobalID:     "system=https://ci.example.com&id=build-1234",
+				Application:  &models.RemoteLinkApplicationScheme{Type: "com.example.ci", Name: "Example CI"},
+				Relationship: "built by",
+				Object: &models.RemoteLinkObjectScheme{
+					URL:    "https://ci.example.com/builds/1234",
+					Title:  "Build #1234",
+					Icon:   &models.RemoteLinkObjectIconScheme{URL: "https://ci.example.com/favicon.png", Title: "Example CI"},
+					Status: &models.RemoteLinkObjectStatusScheme{Resolved: true},
+				},
+			},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:         "UpdateRemoteLinkWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID: "",
+			linkID:       "10000",
+			payload: &models.RemoteLinkScheme{
+				GlobalID:     "system=https://ci.example.com&id=build-1234",
+				Application:  &models.RemoteLinkApplicationScheme{Type: "com.example.ci", Name: "Example CI"},
+				Relationship: "built by",
+				Object: &models.RemoteLinkObjectScheme{
+					URL:    "https://ci.example.com/builds/1234",
+					Title:  "Build #1234",
+					Icon:   &models.RemoteLinkObjectIconScheme{URL: "https://ci.example.com/favicon.png", Title: "Example CI"},
+					Status: &models.RemoteLinkObjectStatusScheme{Resolved: true},
+				},
+			},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:         "UpdateRemoteLinkWhenTheLinkIDIsNotProvided",
+			issueKeyOrID: "KP-1",
+			linkID:       "",
+			payload: &models.RemoteLinkScheme{
+				GlobalID:     "system=https://ci.example.com&id=build-1234",
+				Application:  &models.RemoteLinkApplicationScheme{Type: "com.example.ci", Name: "Example CI"},
+				Relationship: "built by",
+				Object: &models.RemoteLinkObjectScheme{
+					URL:    "https://ci.example.com/builds/1234",
+					Title:  "Build #1234",
+					Icon:   &models.RemoteLinkObjectIconScheme{URL: "https://ci.example.com/favicon.png", Title: "Example CI"},
+					Status: &models.RemoteLinkObjectStatusScheme{Resolved: true},
+				},
+			},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateRemoteLinkWhenThePayloadIsNotProvided",
+			issueKeyOrID:       "KP-1",
+			linkID:             "10000",
+			payload:            nil,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:         "UpdateRemoteLinkWhenTheContextIsNotProvided",
+			issueKeyOrID: "KP-1",
+			linkID:       "10000",
+			payload: &models.RemoteLinkScheme{
+				GlobalID:     "system=https://ci.example.com&id=build-1234",
+				Application:  &models.RemoteLinkApplicationScheme{Type: "com.example.ci", Name: "Example CI"},
+				Relationship: "built by",
+				Object: &models.RemoteLinkObjectScheme{
+					URL:    "https://ci.example.com/builds/1234",
+					Title:  "Build #1234",
+					Icon:   &models.RemoteLinkObjectIconScheme{URL: "https://ci.example.com/favicon.png", Title: "Example CI"},
+					Status: &models.RemoteLinkObjectStatusScheme{Resolved: true},
+				},
+			},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:         "UpdateRemoteLinkWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID: "KP-1",
+			linkID:       "10000",
+			payload: &models.RemoteLinkScheme{
+				GlobalID:     "system=https://ci.example.com&id=build-1234",
+				Application:  &models.RemoteLinkApplicationScheme{Type: "com.example.ci", Name: "Example CI"},
+				Relationship: "built by",
+				Object: &models.RemoteLinkObjectScheme{
+					URL:    "https://ci.example.com/builds/1234",
+					Title:  "Build #1234",
+					Icon:   &models.RemoteLinkObjectIconScheme{URL: "https://ci.example.com/favicon.png", Title: "Example CI"},
+					Status: &models.RemoteLinkObjectStatusScheme{Resolved: true},
+				},
+			},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:         "UpdateRemoteLinkWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID: "KP-1",
+			linkID:       "10000",
+			payload: &models.RemoteLinkScheme{
+				GlobalID:     "system=https://ci.example.com&id=build-1234",
+				Application:  &models.RemoteLinkApplicationScheme{Type: "com.example.ci", Name: "Example CI"},
+				Relationship: "built by",
+				Object: &models.RemoteLinkObjectScheme{
+					URL:    "https://ci.example.com/builds/1234",
+					Title:  "Build #1234",
+					Icon:   &models.RemoteLinkObjectIconScheme{URL: "https://ci.example.com/favicon.png", Title: "Example CI"},
+					Status: &models.RemoteLinkObjectStatusScheme{Resolved: true},
+				},
+			},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueRemoteLinkService{client: mockClient}
+			gotResponse, err := service.Update(testCase.context, testCase.issueKeyOrID, testCase.linkID, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssueRemoteLinkService_Delete(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		linkID             string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "DeleteRemoteLinkWhenTheParametersAreCorrect",
+			issueKeyOrID:       "KP-1",
+			linkID:             "10000",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeleteRemoteLinkWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID:       "",
+			linkID:             "10000",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteRemoteLinkWhenTheLinkIDIsNotProvided",
+			issueKeyOrID:       "KP-1",
+			linkID:             "",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteRemoteLinkWhenTheContextIsNotProvided",
+			issueKeyOrID:       "KP-1",
+			linkID:             "10000",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteRemoteLinkWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID:       "KP-1",
+			linkID:             "10000",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteRemoteLinkWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID:       "KP-1",
+			linkID:             "10000",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink/10000",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueRemoteLinkService{client: mockClient}
+			gotResponse, err := service.Delete(testCase.context, testCase.issueKeyOrID, testCase.linkID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssueRemoteLinkService_DeleteByGlobalID(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		globalID           string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "DeleteRemoteLinkByGlobalIDWhenTheParametersAreCorrect",
+			issueKeyOrID:       "KP-1",
+			globalID:           "system=https://ci.example.com&id=build-1234",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink?globalId=system%3Dhttps%3A%2F%2Fci.example.com%26id%3Dbuild-1234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeleteRemoteLinkByGlobalIDWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID:       "",
+			globalID:           "system=https://ci.example.com&id=build-1234",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink?globalId=system%3Dhttps%3A%2F%2Fci.example.com%26id%3Dbuild-1234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteRemoteLinkByGlobalIDWhenTheGlobalIDIsNotProvided",
+			issueKeyOrID:       "KP-1",
+			globalID:           "",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink?globalId=system%3Dhttps%3A%2F%2Fci.example.com%26id%3Dbuild-1234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteRemoteLinkByGlobalIDWhenTheContextIsNotProvided",
+			issueKeyOrID:       "KP-1",
+			globalID:           "system=https://ci.example.com&id=build-1234",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink?globalId=system%3Dhttps%3A%2F%2Fci.example.com%26id%3Dbuild-1234",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteRemoteLinkByGlobalIDWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID:       "KP-1",
+			globalID:           "system=https://ci.example.com&id=build-1234",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink?globalId=system%3Dhttps%3A%2F%2Fci.example.com%26id%3Dbuild-1234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteRemoteLinkByGlobalIDWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID:       "KP-1",
+			globalID:           "system=https://ci.example.com&id=build-1234",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/KP-1/remotelink?globalId=system%3Dhttps%3A%2F%2Fci.example.com%26id%3Dbuild-1234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueRemoteLinkService{client: mockClient}
+			gotResponse, err := service.DeleteByGlobalID(testCase.context, testCase.issueKeyOrID, testCase.globalID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
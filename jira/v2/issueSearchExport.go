@@ -0,0 +1,150 @@
+package v2
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"io"
+)
+
+const defaultIssueExportPageSize = 100
+
+// ExportCSV writes every issue matching the JQL query to the writer as CSV, one row per issue.
+// The issues are requested page by page and written as they're received, without keeping the previous pages in memory.
+// The columns are configured with the options, the multi-value fields are joined with the separator and
+// the Atlassian Documents are converted to plain text. It returns the number of issues written.
+// Docs: N/A
+func (s *IssueSearchService) ExportCSV(ctx context.Context, jql string, writer io.Writer,
+	options *models.IssueExportOptionsScheme) (exported int, err error) {
+
+	if writer == nil {
+		return 0, models.ErrNoWriterError
+	}
+
+	if options == nil {
+		options = &models.IssueExportOptionsScheme{}
+	}
+
+	if len(options.Columns) == 0 {
+
+		copied := *options
+		copied.Columns = models.DefaultIssueExportColumns()
+		options = &copied
+	}
+
+	csvWriter := csv.NewWriter(writer)
+
+	if !options.SkipHeader {
+
+		if err = csvWriter.Write(options.Headers()); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.export(ctx, jql, options, func(issue *models.IssueSchemeV2) error {
+		return csvWriter.Write(options.Row(issue.ID, issue.Key, issue.Self, issueExportFields(issue)))
+	}, func() error {
+		csvWriter.Flush()
+		return csvWriter.Error()
+	})
+}
+
+// ExportJSONLines writes every issue matching the JQL query to the writer as JSON Lines, one JSON object per issue.
+// The issues are written with the id, key, self and the raw fields returned by Jira, or as an object of
+// the column values, keyed by the column header, when the options contain columns. It returns the number of issues written.
+// Docs: N/A
+func (s *IssueSearchService) ExportJSONLines(ctx context.Context, jql string, writer io.Writer,
+	options *models.IssueExportOptionsScheme) (exported int, err error) {
+
+	if writer == nil {
+		return 0, models.ErrNoWriterError
+	}
+
+	if options == nil {
+		options = &models.IssueExportOptionsScheme{}
+	}
+
+	encoder := json.NewEncoder(writer)
+	headers := options.Headers()
+
+	return s.export(ctx, jql, options, func(issue *models.IssueSchemeV2) error {
+
+		if len(options.Columns) != 0 {
+
+			object := make(map[string]string)
+			for index, value := range options.Row(issue.ID, issue.Key, issue.Self, issueExportFields(issue)) {
+				object[headers[index]] = value
+			}
+
+			return encoder.Encode(object)
+		}
+
+		return encoder.Encode(struct {
+			ID     string                 `json:"id,omitempty"`
+			Key    string                 `json:"key,omitempty"`
+			Self   string                 `json:"self,omitempty"`
+			Fields map[string]interface{} `json:"fields,omitempty"`
+		}{
+			ID:     issue.ID,
+			Key:    issue.Key,
+			Self:   issue.Self,
+			Fields: issueExportFields(issue),
+		})
+	}, nil)
+}
+
+// export calls the write function with every issue matching the JQL query, and the flush function after each page.
+func (s *IssueSearchService) export(ctx context.Context, jql string, options *models.IssueExportOptionsScheme,
+	write func(issue *models.IssueSchemeV2) error, flush func() error) (exported int, err error) {
+
+	if len(jql) == 0 {
+		return 0, models.ErrNoJQLError
+	}
+
+	pageSize := options.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultIssueExportPageSize
+	}
+
+	fields := options.FieldIDs()
+
+	for startAt := 0; ; {
+
+		page, _, err := s.Post(ctx, jql, fields, options.Expand, startAt, pageSize, options.Validate)
+		if err != nil {
+			return exported, err
+		}
+
+		for _, issue := range page.Issues {
+
+			if err = write(issue); err != nil {
+				return exported, err
+			}
+
+			exported++
+		}
+
+		if flush != nil {
+
+			if err = flush(); err != nil {
+				return exported, err
+			}
+		}
+
+		startAt += len(page.Issues)
+
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			return exported, nil
+		}
+	}
+}
+
+func issueExportFields(issue *models.IssueSchemeV2) map[string]interface{} {
+
+	if issue.Fields == nil {
+		return nil
+	}
+
+	return issue.Fields.Raw
+}
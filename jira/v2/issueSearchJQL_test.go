@@ -0,0 +1,730 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestIssueSearchJQLService_Parse(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		validation         string
+		queries            []string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "ParseJQLQueriesWhenTheParametersAreCorrect",
+			validation:         "strict",
+			queries:            []string{"summary ~ test AND (labels in (urgent, blocker) OR lastCommentedBy = currentUser())", "invalid query"},
+			mockFile:           "../v3/mocks/parse-jql-queries.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/parse?validation=strict",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ParseJQLQueriesWhenTheValidationIsNotProvided",
+			validation:         "",
+			queries:            []string{"summary ~ test AND (labels in (urgent, blocker) OR lastCommentedBy = currentUser())", "invalid query"},
+			mockFile:           "../v3/mocks/parse-jql-queries.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/parse",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ParseJQLQueriesWhenTheQueriesAreNotProvided",
+			validation:         "strict",
+			queries:            nil,
+			mockFile:           "../v3/mocks/parse-jql-queries.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/parse?validation=strict",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ParseJQLQueriesWhenTheContextIsNotProvided",
+			validation:         "strict",
+			queries:            []string{"summary ~ test AND (labels in (urgent, blocker) OR lastCommentedBy = currentUser())", "invalid query"},
+			mockFile:           "../v3/mocks/parse-jql-queries.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/parse?validation=strict",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ParseJQLQueriesWhenTheRequestMethodIsIncorrect",
+			validation:         "strict",
+			queries:            []string{"summary ~ test AND (labels in (urgent, blocker) OR lastCommentedBy = currentUser())", "invalid query"},
+			mockFile:           "../v3/mocks/parse-jql-queries.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/jql/parse?validation=strict",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ParseJQLQueriesWhenTheStatusCodeIsIncorrect",
+			validation:         "strict",
+			queries:            []string{"summary ~ test AND (labels in (urgent, blocker) OR lastCommentedBy = currentUser())", "invalid query"},
+			mockFile:           "../v3/mocks/parse-jql-queries.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/parse?validation=strict",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ParseJQLQueriesWhenTheResponseBodyIsEmpty",
+			validation:         "strict",
+			queries:            []string{"summary ~ test AND (labels in (urgent, blocker) OR lastCommentedBy = currentUser())", "invalid query"},
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/parse?validation=strict",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueSearchJQLService{client: mockClient}
+			gotResult, gotResponse, err := service.Parse(testCase.context, testCase.validation, testCase.queries)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssueSearchJQLService_Sanitize(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		queries            []*models.JQLSanitizeQueryScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "SanitizeJQLQueriesWhenTheParametersAreCorrect",
+			queries:            []*models.JQLSanitizeQueryScheme{{Query: "project = 'Sample project'"}, {Query: "project = 'Sample project'", AccountID: "5b10ac8d82e05b22cc7d4ef5"}},
+			mockFile:           "../v3/mocks/sanitize-jql-queries.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/sanitize",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "SanitizeJQLQueriesWhenTheQueriesAreNotProvided",
+			queries:            nil,
+			mockFile:           "../v3/mocks/sanitize-jql-queries.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/sanitize",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SanitizeJQLQueriesWhenTheContextIsNotProvided",
+			queries:            []*models.JQLSanitizeQueryScheme{{Query: "project = 'Sample project'"}, {Query: "project = 'Sample project'", AccountID: "5b10ac8d82e05b22cc7d4ef5"}},
+			mockFile:           "../v3/mocks/sanitize-jql-queries.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/sanitize",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SanitizeJQLQueriesWhenTheRequestMethodIsIncorrect",
+			queries:            []*models.JQLSanitizeQueryScheme{{Query: "project = 'Sample project'"}, {Query: "project = 'Sample project'", AccountID: "5b10ac8d82e05b22cc7d4ef5"}},
+			mockFile:           "../v3/mocks/sanitize-jql-queries.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/jql/sanitize",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SanitizeJQLQueriesWhenTheStatusCodeIsIncorrect",
+			queries:            []*models.JQLSanitizeQueryScheme{{Query: "project = 'Sample project'"}, {Query: "project = 'Sample project'", AccountID: "5b10ac8d82e05b22cc7d4ef5"}},
+			mockFile:           "../v3/mocks/sanitize-jql-queries.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/sanitize",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SanitizeJQLQueriesWhenTheResponseBodyIsEmpty",
+			queries:            []*models.JQLSanitizeQueryScheme{{Query: "project = 'Sample project'"}, {Query: "project = 'Sample project'", AccountID: "5b10ac8d82e05b22cc7d4ef5"}},
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/sanitize",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueSearchJQLService{client: mockClient}
+			gotResult, gotResponse, err := service.Sanitize(testCase.context, testCase.queries)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssueSearchJQLService_ConvertUserIdentifiers(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		queries            []string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "ConvertJQLUserIdentifiersWhenTheParametersAreCorrect",
+			queries:            []string{"issuetype = Bug AND assignee in (mia) AND reporter in (alana) order by lastViewed DESC"},
+			mockFile:           "../v3/mocks/convert-jql-user-identifiers.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/pdcleaner",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ConvertJQLUserIdentifiersWhenTheQueriesAreNotProvided",
+			queries:            nil,
+			mockFile:           "../v3/mocks/convert-jql-user-identifiers.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/pdcleaner",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ConvertJQLUserIdentifiersWhenTheContextIsNotProvided",
+			queries:            []string{"issuetype = Bug AND assignee in (mia) AND reporter in (alana) order by lastViewed DESC"},
+			mockFile:           "../v3/mocks/convert-jql-user-identifiers.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/pdcleaner",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ConvertJQLUserIdentifiersWhenTheRequestMethodIsIncorrect",
+			queries:            []string{"issuetype = Bug AND assignee in (mia) AND reporter in (alana) order by lastViewed DESC"},
+			mockFile:           "../v3/mocks/convert-jql-user-identifiers.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/jql/pdcleaner",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ConvertJQLUserIdentifiersWhenTheStatusCodeIsIncorrect",
+			queries:            []string{"issuetype = Bug AND assignee in (mia) AND reporter in (alana) order by lastViewed DESC"},
+			mockFile:           "../v3/mocks/convert-jql-user-identifiers.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/pdcleaner",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ConvertJQLUserIdentifiersWhenTheResponseBodyIsEmpty",
+			queries:            []string{"issuetype = Bug AND assignee in (mia) AND reporter in (alana) order by lastViewed DESC"},
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/jql/pdcleaner",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueSearchJQLService{client: mockClient}
+			gotResult, gotResponse, err := service.ConvertUserIdentifiers(testCase.context, testCase.queries)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssueSearchJQLService_AutocompleteData(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetJQLAutocompleteDataWhenTheParametersAreCorrect",
+			mockFile:           "../v3/mocks/get-jql-autocomplete-data.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/jql/autocompletedata",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetJQLAutocompleteDataWhenTheContextIsNotProvided",
+			mockFile:           "../v3/mocks/get-jql-autocomplete-data.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/jql/autocompletedata",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetJQLAutocompleteDataWhenTheRequestMethodIsIncorrect",
+			mockFile:           "../v3/mocks/get-jql-autocomplete-data.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/2/jql/autocompletedata",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetJQLAutocompleteDataWhenTheStatusCodeIsIncorrect",
+			mockFile:           "../v3/mocks/get-jql-autocomplete-data.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/jql/autocompletedata",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetJQLAutocompleteDataWhenTheResponseBodyIsEmpty",
+			mockFile:           "../v3/mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/jql/autocompletedata",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueSearchJQLService{client: mockClient}
+			gotResult, gotResponse, err := service.AutocompleteData(testCase.context)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestIssueSearchJQLService_ProjectAutocompleteData(t *testing.T) {
+
+	testCases := []struct {
+		name                   string
+		projectIDs             []int
+		includeCollapsedFields bool
+		mockFile               string
+		wantHTTPMethod         string
+		endpoint               string
+		context                context.Context
+		wantHTTPCodeReturn     int
+		wantErr                bool
+	}{
+		{
+			name:                   "GetProjectJQLAutocompleteDataWhenTheParametersAreCorrect",
+			projectIDs:             []int{10000, 10001},
+			includeCollapsedFields: true,
+			mockFile:               "../v3/mocks/get-jql-autocomplete-data.json",
+			wantHTTPMethod:         http.MethodPost,
+			endpoint:               "/rest/api/2/jql/autocompletedata",
+			context:                context.Background(),
+			wantHTTPCodeReturn:     http.StatusOK,
+			wantErr:                false,
+		},
+
+		{
+			name:                   "GetProjectJQLAutocompleteDataWhenTheProjectsAreNotProvided",
+			projectIDs:             nil,
+			includeCollapsedFields: false,
+			mockFile:               "../v3/mocks/get-jql-autocomplete-data.json",
+			wantHTTPMethod:         http.MethodPost,
+			endpoint:               "/rest/api/2/jql/autocompletedata",
+			context:                context.Background(),
+			wantHTTPCodeReturn:     http.StatusOK,
+			wantErr:                false,
+		},
+
+		{
+			name:                   "GetProjectJQLAutocompleteDataWhenTheContextIsNotProvided",
+			projectIDs:             []int{10000, 10001},
+			includeCollapsedFields: true,
+			mockFile:               "../v3/mocks/get-jql-autocomplete-data.json",
+			wantHTTPMethod:         http.MethodPost,
+			endpoint:               "/rest/api/2/jql/autocompletedata",
+			context:                nil,
+			wantHTTPCodeReturn:     http.StatusOK,
+			wantErr:                true,
+		},
+
+		{
+			name:                   "GetProjectJQLAutocompleteDataWhenTheRequestMethodIsIncorrect",
+			projectIDs:             []int{10000, 10001},
+			includeCollapsedFields: true,
+			mockFile:               "../v3/mocks/get-jql-autocomplete-data.json",
+			wantHTTPMethod:         http.MethodHead,
+			endpoint:               "/rest/api/2/jql/autocompletedata",
+			context:                context.Background(),
+			wantHTTPCodeReturn:     http.StatusOK,
+			wantErr:                true,
+		},
+
+		{
+			name:                   "GetProjectJQLAutocompleteDataWhenTheStatusCodeIsIncorrect",
+			projectIDs:             []int{10000, 10001},
+			includeCollapsedFields: true,
+			mockFile:               "../v3/mocks/get-jql-autocomplete-data.json",
+			wantHTTPMethod:         http.MethodPost,
+			endpoint:               "/rest/api/2/jql/autocompletedata",
+			context:                context.Background(),
+			wantHTTPCodeReturn:     http.StatusBadRequest,
+			wantErr:                true,
+		},
+
+		{
+			name:                   "GetProjectJQLAutocompleteDataWhenTheResponseBodyIsEmpty",
+			projectIDs:             []int{10000, 10001},
+			includeCollapsedFields: true,
+			mockFile:               "../v3/mocks/empty_json.json",
+			wantHTTPMethod:         http.MethodPost,
+			endpoint:               "/rest/api/2/jql/autocompletedata",
+			context:                context.Background(),
+			wantHTTPCodeReturn:     http.StatusOK,
+			wantErr:                true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueSearchJQLService{client: mockClient}
+			gotResult, gotResponse, err := service.ProjectAutocompleteData(testCase.context, testCase.projectIDs, testCase.includeCollapsedFields)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
@@ -0,0 +1,41 @@
+package v2
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+// Sync edits the fields of an issue that differ from the desired issue, e.g. an issue synchronized from another
+// system. The issue is compared with the desired issue and the desired custom fields by models.DiffIssueV2, and only
+// the changes are sent as update operations: the items are added to and removed from the arrays, and the changed
+// fields are set. The concurrent edits of the other fields and items aren't overwritten.
+// The operations are returned, the issue isn't edited when there are no operations.
+// Docs: N/A
+func (i *IssueService) Sync(ctx context.Context, issueKeyOrID string, notify bool, desired *models.IssueSchemeV2,
+	customFields *models.CustomFields) (operations *models.UpdateOperations, response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	current, response, err := i.Get(ctx, issueKeyOrID, nil, nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	operations, err = models.DiffIssueV2(current, desired, customFields)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if len(operations.Fields) == 0 {
+		return operations, response, nil
+	}
+
+	response, err = i.Update(ctx, issueKeyOrID, notify, &models.IssueSchemeV2{}, nil, operations)
+	if err != nil {
+		return operations, response, err
+	}
+
+	return operations, response, nil
+}
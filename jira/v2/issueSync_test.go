@@ -0,0 +1,185 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// startMockSyncServer starts a server returning the KP-1 issue and recording its edits.
+func startMockSyncServer(t *testing.T, payloads *[]map[string]interface{}) *httptest.Server {
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/2/issue/KP-1":
+
+			_, _ = w.Write([]byte(`{"id":"10001","key":"KP-1","fields":{
+				"summary":"Migrate the billing service","labels":["backend","legacy"],
+				"status":{"id":"1","name":"Open"},
+				"components":[{"id":"10000","name":"Billing"}],
+				"priority":{"id":"2","name":"High"},
+				"customfield_10016":5,
+				"customfield_10045":{"id":"10054","value":"America","child":{"id":"10056","value":"US"}},
+				"customfield_10046":[{"id":"10044","value":"Option 1"},{"id":"10045","value":"Option 2"}]}}`))
+
+		case r.Method == http.MethodPut && r.URL.Path == "/rest/api/2/issue/KP-1":
+
+			payload := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			*payloads = append(*payloads, payload)
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			http.Error(w, fmt.Sprintf("Request: %v %v", r.Method, r.URL), http.StatusNotFound)
+		}
+	}))
+}
+
+func TestIssueService_Sync(t *testing.T) {
+
+	customFields := &models.CustomFields{}
+	_ = customFields.Number("customfield_10016", 5)
+	_ = customFields.Cascading("customfield_10045", "America", "Canada")
+	_ = customFields.MultiSelect("customfield_10046", []string{"Option 2", "Option 3"})
+
+	unchangedCustomFields := &models.CustomFields{}
+	_ = unchangedCustomFields.Number("customfield_10016", 5)
+	_ = unchangedCustomFields.Cascading("customfield_10045", "America", "US")
+
+	testCases := []struct {
+		name         string
+		issueKeyOrID string
+		desired      *models.IssueSchemeV2
+		customFields *models.CustomFields
+		context      context.Context
+		wantUpdate   map[string]interface{}
+		wantErr      bool
+	}{
+		{
+			name:         "SyncIssueWhenTheFieldsAreChanged",
+			issueKeyOrID: "KP-1",
+			desired: &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{
+				Summary:    "Migrate the billing and invoicing services",
+				Labels:     []string{"backend", "q3"},
+				Components: []*models.ComponentScheme{{Name: "Billing"}, {Name: "Invoicing"}},
+				Priority:   &models.PriorityScheme{Name: "High"},
+				Status:     &models.StatusScheme{Name: "Done"},
+			}},
+			customFields: customFields,
+			context:      context.Background(),
+			wantUpdate: map[string]interface{}{
+				"components": []interface{}{
+					map[string]interface{}{"add": map[string]interface{}{"name": "Invoicing"}},
+				},
+				"customfield_10045": []interface{}{
+					map[string]interface{}{"set": map[string]interface{}{"value": "America",
+						"child": map[string]interface{}{"value": "Canada"}}},
+				},
+				"customfield_10046": []interface{}{
+					map[string]interface{}{"add": map[string]interface{}{"value": "Option 3"}},
+					map[string]interface{}{"remove": map[string]interface{}{"id": "10044"}},
+				},
+				"labels": []interface{}{
+					map[string]interface{}{"add": "q3"},
+					map[string]interface{}{"remove": "legacy"},
+				},
+				"summary": []interface{}{
+					map[string]interface{}{"set": "Migrate the billing and invoicing services"},
+				},
+			},
+			wantErr: false,
+		},
+
+		{
+			name:         "SyncIssueWhenTheFieldsAreNotChanged",
+			issueKeyOrID: "KP-1",
+			desired: &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{
+				Summary:  "Migrate the billing service",
+				Labels:   []string{"legacy", "backend"},
+				Priority: &models.PriorityScheme{ID: "2"},
+			}},
+			customFields: unchangedCustomFields,
+			context:      context.Background(),
+			wantErr:      false,
+		},
+
+		{
+			name:         "SyncIssueWhenTheIssueDoesNotExist",
+			issueKeyOrID: "KP-2",
+			desired:      &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{Summary: "Migrate the billing service"}},
+			context:      context.Background(),
+			wantErr:      true,
+		},
+
+		{
+			name:         "SyncIssueWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID: "",
+			desired:      &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{Summary: "Migrate the billing service"}},
+			context:      context.Background(),
+			wantErr:      true,
+		},
+
+		{
+			name:         "SyncIssueWhenTheContextIsNotProvided",
+			issueKeyOrID: "KP-1",
+			desired:      &models.IssueSchemeV2{Fields: &models.IssueFieldsSchemeV2{Summary: "Migrate the billing service"}},
+			context:      nil,
+			wantErr:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var payloads []map[string]interface{}
+
+			mockServer := startMockSyncServer(t, &payloads)
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotOperations, gotResponse, err := mockClient.Issue.Sync(testCase.context, testCase.issueKeyOrID, false,
+				testCase.desired, testCase.customFields)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, gotResponse, nil)
+
+			if testCase.wantUpdate == nil {
+				assert.Equal(t, 0, len(gotOperations.Fields))
+				assert.Equal(t, 0, len(payloads))
+				return
+			}
+
+			assert.Equal(t, len(testCase.wantUpdate), len(gotOperations.Fields))
+			assert.Equal(t, 1, len(payloads))
+			assert.Equal(t, map[string]interface{}{"update": testCase.wantUpdate}, payloads[0])
+		})
+
+	}
+
+}
@@ -0,0 +1,218 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"net/http"
+	"strconv"
+)
+
+// MoveTo moves an issue to a status, the status is the name of the status or the name of a transition.
+// The transition is looked up on the issue transitions, and the values of the transition screen fields are taken
+// from the options. An IssueTransitionFieldsError is returned when the value of a required field is missing.
+// With the MultipleHops option, the issue is moved through the shortest path of the workflow when the status isn't
+// reachable with a transition. The transitions performed are returned, with the error of the failed transition.
+// Docs: N/A
+func (i *IssueService) MoveTo(ctx context.Context, issueKeyOrID, status string, options *models.IssueTransitionToOptionsScheme) (
+	result []*models.IssueTransitionScheme, response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if len(status) == 0 {
+		return nil, nil, models.ErrNoStatusNameError
+	}
+
+	if options == nil {
+		options = &models.IssueTransitionToOptionsScheme{}
+	}
+
+	transitions, response, err := i.transitionsWithFields(ctx, issueKeyOrID)
+	if err != nil {
+		return nil, response, err
+	}
+
+	for _, transition := range transitions.Transitions {
+
+		if !transition.Matches(status) {
+			continue
+		}
+
+		response, err = i.transition(ctx, issueKeyOrID, transition, options.Fields)
+		if err != nil {
+			return nil, response, err
+		}
+
+		return []*models.IssueTransitionScheme{transition}, response, nil
+	}
+
+	if !options.MultipleHops {
+		return nil, response, fmt.Errorf("%w %v", models.ErrNoTransitionPathError, status)
+	}
+
+	path, response, err := i.transitionPath(ctx, issueKeyOrID, status, options.WorkflowName)
+	if err != nil {
+		return nil, response, err
+	}
+
+	for _, step := range path {
+
+		transitions, response, err = i.transitionsWithFields(ctx, issueKeyOrID)
+		if err != nil {
+			return result, response, err
+		}
+
+		var next *models.IssueTransitionScheme
+		for _, transition := range transitions.Transitions {
+
+			if transition.ID == step.ID || (next == nil && transition.To != nil && transition.To.ID == step.To) {
+				next = transition
+			}
+		}
+
+		if next == nil {
+			return result, response, fmt.Errorf("%w %v, the transition %v isn't available", models.ErrNoTransitionPathError,
+				status, step.Name)
+		}
+
+		response, err = i.transition(ctx, issueKeyOrID, next, options.Fields)
+		if err != nil {
+			return result, response, err
+		}
+
+		result = append(result, next)
+	}
+
+	return result, response, nil
+}
+
+// transitionsWithFields returns the transitions of the issue with the fields of their screens.
+func (i *IssueService) transitionsWithFields(ctx context.Context, issueKeyOrID string) (result *models.IssueTransitionsScheme,
+	response *ResponseScheme, err error) {
+
+	var endpoint = fmt.Sprintf("rest/api/2/issue/%v/transitions?expand=transitions.fields", issueKeyOrID)
+
+	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = i.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// transition performs the transition, sending the values of the fields on the transition screen.
+func (i *IssueService) transition(ctx context.Context, issueKeyOrID string, transition *models.IssueTransitionScheme,
+	values map[string]interface{}) (response *ResponseScheme, err error) {
+
+	if missing := transition.MissingFields(values); len(missing) != 0 {
+		return nil, &models.IssueTransitionFieldsError{TransitionID: transition.ID, TransitionName: transition.Name, Fields: missing}
+	}
+
+	fields := make(map[string]interface{})
+	for fieldID, value := range values {
+
+		if _, ok := transition.Fields[fieldID]; ok {
+			fields[fieldID] = value
+		}
+	}
+
+	payload := map[string]interface{}{"transition": map[string]interface{}{"id": transition.ID}}
+	if len(fields) != 0 {
+		payload["fields"] = fields
+	}
+
+	payloadAsReader, err := transformStructToReader(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/2/issue/%v/transitions", issueKeyOrID)
+
+	request, err := i.client.newRequest(ctx, http.MethodPost, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = i.client.call(request, nil)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// transitionPath returns the workflow transitions from the status of the issue to the status.
+// The workflow of the issue is looked up on the workflow scheme of the project when the name is empty.
+func (i *IssueService) transitionPath(ctx context.Context, issueKeyOrID, status, workflowName string) (
+	path []*models.WorkflowTransitionScheme, response *ResponseScheme, err error) {
+
+	issue, response, err := i.Get(ctx, issueKeyOrID, []string{"status", "project", "issuetype"}, nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if issue.Fields == nil || issue.Fields.Status == nil || issue.Fields.Project == nil || issue.Fields.IssueType == nil {
+		return nil, response, fmt.Errorf("%w %v, the issue status is unknown", models.ErrNoTransitionPathError, status)
+	}
+
+	if workflowName == "" {
+
+		projectID, err := strconv.Atoi(issue.Fields.Project.ID)
+		if err != nil {
+			return nil, response, err
+		}
+
+		associations, response, err := i.client.Workflow.Scheme.Associations(ctx, []int{projectID})
+		if err != nil {
+			return nil, response, err
+		}
+
+		for _, association := range associations.Values {
+
+			if association.WorkflowScheme == nil {
+				continue
+			}
+
+			workflowName = association.WorkflowScheme.IssueTypeMappings[issue.Fields.IssueType.ID]
+			if workflowName == "" {
+				workflowName = association.WorkflowScheme.DefaultWorkflow
+			}
+		}
+
+		if workflowName == "" {
+			return nil, response, fmt.Errorf("%w %v, the workflow of the issue is unknown", models.ErrNoTransitionPathError, status)
+		}
+	}
+
+	workflows, response, err := i.client.Workflow.Gets(ctx, []string{workflowName}, []string{"transitions", "statuses"}, 0, 50)
+	if err != nil {
+		return nil, response, err
+	}
+
+	for _, workflow := range workflows.Values {
+
+		if workflow.ID == nil || workflow.ID.Name != workflowName {
+			continue
+		}
+
+		if path = models.WorkflowTransitionPath(workflow, issue.Fields.Status.ID, status); path == nil {
+			return nil, response, fmt.Errorf("%w %v", models.ErrNoTransitionPathError, status)
+		}
+
+		return path, response, nil
+	}
+
+	return nil, response, fmt.Errorf("%w %v, the workflow %v doesn't exist", models.ErrNoTransitionPathError, status, workflowName)
+}
@@ -64,6 +64,42 @@ func (w *WatcherService) Add(ctx context.Context, issueKeyOrID string) (response
 	return
 }
 
+// AddUser adds the user as a watcher of an issue by passing the account ID of the user.
+// Docs: N/A
+// Atlassian Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-watchers/#api-rest-api-2-issue-issueidorkey-watchers-post
+func (w *WatcherService) AddUser(ctx context.Context, issueKeyOrID, accountID string) (response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if len(accountID) == 0 {
+		return nil, models.ErrNoAccountIDError
+	}
+
+	payloadAsReader, err := transformStructToReader(&accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/2/issue/%v/watchers", issueKeyOrID)
+
+	request, err := w.client.newRequest(ctx, http.MethodPost, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+
+	response, err = w.client.call(request, nil)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
 // Delete deletes a user as a watcher of an issue.
 // Docs: https://docs.go-atlassian.io/jira-software-cloud/issues/watcher#delete-watcher
 // Atlassian Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-watchers/#api-rest-api-2-issue-issueidorkey-watchers-delete
@@ -144,6 +144,159 @@ func TestWatcherService_Add(t *testing.T) {
 
 }
 
+func TestWatcherService_AddUser(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		accountID          string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "AddIssueWatcherUserWhenTheIssueKeyIsProvided",
+			issueKeyOrID:       "DUMMY-2",
+			accountID:          "5b10ac8d82e05b22cc7d4ef5",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/DUMMY-2/watchers",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:               "AddIssueWatcherUserWhenTheIssueKeyIsEmpty",
+			issueKeyOrID:       "",
+			accountID:          "5b10ac8d82e05b22cc7d4ef5",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/DUMMY-2/watchers",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddIssueWatcherUserWhenTheEndpointIsIncorrect",
+			issueKeyOrID:       "DUMMY-2",
+			accountID:          "5b10ac8d82e05b22cc7d4ef5",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/DUMMY-2/watcher",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:               "AddIssueWatcherUserWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID:       "DUMMY-2",
+			accountID:          "5b10ac8d82e05b22cc7d4ef5",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/2/issue/DUMMY-2/watchers",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddIssueWatcherUserWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID:       "DUMMY-2",
+			accountID:          "5b10ac8d82e05b22cc7d4ef5",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/DUMMY-2/watchers",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddIssueWatcherUserWhenTheAccountIDIsEmpty",
+			issueKeyOrID:       "DUMMY-2",
+			accountID:          "",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/DUMMY-2/watchers",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddIssueWatcherUserWhenTheContextIsNil",
+			issueKeyOrID:       "DUMMY-2",
+			accountID:          "5b10ac8d82e05b22cc7d4ef5",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/2/issue/DUMMY-2/watchers",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			i := &WatcherService{client: mockClient}
+
+			gotResponse, err := i.AddUser(testCase.context, testCase.issueKeyOrID, testCase.accountID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
 func TestWatcherService_Delete(t *testing.T) {
 
 	testCases := []struct {
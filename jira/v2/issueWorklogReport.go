@@ -0,0 +1,336 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// worklogReportBatchSize is the maximum number of worklogs returned per request by the Issue and Gets methods.
+	worklogReportBatchSize = 1000
+
+	// worklogReportParentBatchSize is the number of parents of the subtasks searched per JQL query.
+	worklogReportParentBatchSize = 100
+)
+
+// Report returns the worklogs started in the range of the options on the issues of the JQL or the project, with the
+// issue, the epic and the components of every worklog. The worklogs are collected from every issue with the Issue
+// method, or from the worklogs updated since a time with the Updated and Gets methods. The days of the worklogs are
+// the days of the location of the options, see the Aggregate, WriteCSV and WriteJSON methods of the report.
+// Docs: N/A
+func (w *IssueWorklogService) Report(ctx context.Context, options *models.WorklogReportOptionsScheme) (
+	report *models.WorklogReportScheme, err error) {
+
+	if options == nil || (options.JQL == "" && options.ProjectKey == "") {
+		return nil, models.ErrNoJQLError
+	}
+
+	if options.From.IsZero() || !options.To.After(options.From) {
+		return nil, models.ErrNoWorklogReportRangeError
+	}
+
+	location := options.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	issues, err := w.reportIssues(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	epics, err := w.reportEpics(ctx, issues, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var worklogs []*models.IssueWorklogScheme
+	if options.UpdatedSince.IsZero() {
+
+		for _, issue := range issues {
+
+			issueWorklogs, err := w.reportIssueWorklogs(ctx, issue.Key, options.From)
+			if err != nil {
+				return nil, err
+			}
+
+			worklogs = append(worklogs, issueWorklogs...)
+		}
+
+	} else {
+
+		if worklogs, err = w.reportUpdatedWorklogs(ctx, options.UpdatedSince); err != nil {
+			return nil, err
+		}
+	}
+
+	accounts := make(map[string]bool)
+	for _, accountID := range options.AccountIDs {
+		accounts[accountID] = true
+	}
+
+	report = &models.WorklogReportScheme{From: options.From, To: options.To, Timezone: location.String()}
+	reported := make(map[string]bool)
+
+	for _, worklog := range worklogs {
+
+		issue, ok := issues[worklog.IssueID]
+		if !ok || reported[worklog.ID] {
+			continue
+		}
+
+		started, err := models.ParseDateTime(worklog.Started)
+		if err != nil {
+			return nil, fmt.Errorf("jira: the start of the worklog %v is invalid: %v", worklog.ID, err)
+		}
+
+		if started.Before(options.From) || !started.Before(options.To) {
+			continue
+		}
+
+		entry := &models.WorklogReportEntryScheme{
+			WorklogID:        worklog.ID,
+			IssueID:          issue.ID,
+			IssueKey:         issue.Key,
+			EpicKey:          epics[issue.ID],
+			Started:          started.In(location),
+			Day:              started.In(location).Format(models.DateFormatJiraDay),
+			TimeSpentSeconds: worklog.TimeSpentSeconds,
+		}
+
+		if worklog.Author != nil {
+			entry.AccountID, entry.DisplayName = worklog.Author.AccountID, worklog.Author.DisplayName
+		}
+
+		if len(accounts) != 0 && !accounts[entry.AccountID] {
+			continue
+		}
+
+		if issue.Fields != nil {
+
+			entry.IssueSummary = issue.Fields.Summary
+			for _, component := range issue.Fields.Components {
+				entry.Components = append(entry.Components, component.Name)
+			}
+		}
+
+		reported[worklog.ID] = true
+		report.Entries = append(report.Entries, entry)
+	}
+
+	sort.SliceStable(report.Entries, func(i, j int) bool {
+
+		if !report.Entries[i].Started.Equal(report.Entries[j].Started) {
+			return report.Entries[i].Started.Before(report.Entries[j].Started)
+		}
+
+		return report.Entries[i].WorklogID < report.Entries[j].WorklogID
+	})
+
+	return report, nil
+}
+
+// reportIssues returns the issues of the JQL and the project, keyed by their ID.
+func (w *IssueWorklogService) reportIssues(ctx context.Context, options *models.WorklogReportOptionsScheme) (
+	map[string]*models.IssueSchemeV2, error) {
+
+	jql := options.JQL
+	if options.ProjectKey != "" {
+
+		if jql == "" {
+			jql = "project = " + models.JQLQuote(options.ProjectKey)
+		} else {
+			jql = fmt.Sprintf("project = %v AND (%v)", models.JQLQuote(options.ProjectKey), jql)
+		}
+	}
+
+	return w.reportSearch(ctx, jql, options)
+}
+
+// reportEpics returns the keys of the epics of the issues, keyed by the issue ID. The epic of a subtask is the epic
+// of its parent, the parents not reported are searched.
+func (w *IssueWorklogService) reportEpics(ctx context.Context, issues map[string]*models.IssueSchemeV2,
+	options *models.WorklogReportOptionsScheme) (map[string]string, error) {
+
+	var (
+		epics    = make(map[string]string)
+		parents  = make(map[string]*models.IssueSchemeV2)
+		subtasks = make(map[string]string)
+	)
+
+	for _, issue := range issues {
+		parents[issue.Key] = issue
+	}
+
+	var missing []string
+	for _, issue := range issues {
+
+		if epics[issue.ID] = issueReportEpic(issue, options.EpicLinkField); epics[issue.ID] != "" {
+			continue
+		}
+
+		if issue.Fields == nil || issue.Fields.Parent == nil || issue.Fields.IssueType == nil || !issue.Fields.IssueType.Subtask {
+			continue
+		}
+
+		parentKey := issue.Fields.Parent.Key
+		if _, ok := parents[parentKey]; !ok {
+			parents[parentKey] = nil
+			missing = append(missing, models.JQLQuote(parentKey))
+		}
+
+		subtasks[issue.ID] = parentKey
+	}
+
+	sort.Strings(missing)
+
+	for start := 0; start < len(missing); start += worklogReportParentBatchSize {
+
+		end := start + worklogReportParentBatchSize
+		if end > len(missing) {
+			end = len(missing)
+		}
+
+		found, err := w.reportSearch(ctx, fmt.Sprintf("key in (%v)", strings.Join(missing[start:end], ", ")), options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, parent := range found {
+			parents[parent.Key] = parent
+		}
+	}
+
+	for issueID, parentKey := range subtasks {
+
+		if parent := parents[parentKey]; parent != nil {
+			epics[issueID] = issueReportEpic(parent, options.EpicLinkField)
+		}
+	}
+
+	return epics, nil
+}
+
+// reportSearch returns the issues of the JQL with the fields of the report, keyed by their ID.
+func (w *IssueWorklogService) reportSearch(ctx context.Context, jql string, options *models.WorklogReportOptionsScheme) (
+	map[string]*models.IssueSchemeV2, error) {
+
+	fields := []string{"summary", "components", "parent", "issuetype"}
+	if options.EpicLinkField != "" {
+		fields = append(fields, options.EpicLinkField)
+	}
+
+	pageSize := options.PageSize
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+
+	issues := make(map[string]*models.IssueSchemeV2)
+	for startAt := 0; ; {
+
+		page, _, err := w.client.Issue.Search.Post(ctx, jql, fields, nil, startAt, pageSize, "")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, issue := range page.Issues {
+			issues[issue.ID] = issue
+		}
+
+		startAt += len(page.Issues)
+
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			return issues, nil
+		}
+	}
+}
+
+// reportIssueWorklogs returns the worklogs of the issue started after the time.
+func (w *IssueWorklogService) reportIssueWorklogs(ctx context.Context, issueKey string, after time.Time) (
+	worklogs []*models.IssueWorklogScheme, err error) {
+
+	startedAfter := int(after.UnixNano() / int64(time.Millisecond))
+
+	for startAt := 0; ; {
+
+		page, _, err := w.Issue(ctx, issueKey, startAt, worklogReportBatchSize, startedAfter, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		worklogs = append(worklogs, page.Worklogs...)
+		startAt += len(page.Worklogs)
+
+		if len(page.Worklogs) == 0 || startAt >= page.Total {
+			return worklogs, nil
+		}
+	}
+}
+
+// reportUpdatedWorklogs returns the worklogs updated since the time, the worklogs are requested in batches.
+func (w *IssueWorklogService) reportUpdatedWorklogs(ctx context.Context, since time.Time) (
+	worklogs []*models.IssueWorklogScheme, err error) {
+
+	var worklogIDs []int
+	for updatedSince := int(since.UnixNano() / int64(time.Millisecond)); ; {
+
+		page, _, err := w.Updated(ctx, updatedSince, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, worklog := range page.Values {
+			worklogIDs = append(worklogIDs, worklog.WorklogID)
+		}
+
+		if page.LastPage || len(page.Values) == 0 || page.Until <= updatedSince {
+			break
+		}
+
+		updatedSince = page.Until
+	}
+
+	for start := 0; start < len(worklogIDs); start += worklogReportBatchSize {
+
+		end := start + worklogReportBatchSize
+		if end > len(worklogIDs) {
+			end = len(worklogIDs)
+		}
+
+		batch, _, err := w.Gets(ctx, worklogIDs[start:end], nil)
+		if err != nil {
+			return nil, err
+		}
+
+		worklogs = append(worklogs, batch...)
+	}
+
+	return worklogs, nil
+}
+
+// issueReportEpic returns the key of the epic of an issue, from the Epic Link field or the parent epic.
+func issueReportEpic(issue *models.IssueSchemeV2, epicLinkField string) string {
+
+	if issue.Fields == nil {
+		return ""
+	}
+
+	if epicLinkField != "" {
+
+		if epic, ok := issue.Fields.Raw[epicLinkField].(string); ok {
+			return epic
+		}
+	}
+
+	parent := issue.Fields.Parent
+	if parent != nil && parent.Fields != nil && parent.Fields.IssueType != nil &&
+		(parent.Fields.IssueType.HierarchyLevel == 1 || parent.Fields.IssueType.Name == "Epic") {
+		return parent.Key
+	}
+
+	return ""
+}
@@ -6,10 +6,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"path"
 	"reflect"
 	"strings"
 )
@@ -35,7 +37,7 @@ type Client struct {
 }
 
 const (
-	DateFormatJira = "2006-01-02T15:04:05.999-0700"
+	DateFormatJira = models.DateFormatJira
 )
 
 func New(httpClient *http.Client, site string) (client *Client, err error) {
@@ -85,7 +87,8 @@ func New(httpClient *http.Client, site string) (client *Client, err error) {
 		client:     client,
 		Attachment: &AttachmentService{client: client},
 		Comment: &CommentService{
-			client: client,
+			client:   client,
+			Property: &CommentPropertyService{client: client},
 		},
 		Field: &FieldService{
 			client: client,
@@ -103,7 +106,7 @@ func New(httpClient *http.Client, site string) (client *Client, err error) {
 		Priority:   &PriorityService{client: client},
 		Resolution: &ResolutionService{client: client},
 
-		Search: &IssueSearchService{client: client},
+		Search: &IssueSearchService{client: client, JQL: &IssueSearchJQLService{client: client}},
 
 		Type: &IssueTypeService{
 			client:       client,
@@ -115,11 +118,14 @@ func New(httpClient *http.Client, site string) (client *Client, err error) {
 			client: client,
 			Type:   &IssueLinkTypeService{client: client},
 		},
-		Votes:    &VoteService{client: client},
-		Watchers: &WatcherService{client: client},
-		Label:    &LabelService{client: client},
-		Worklog:  &IssueWorklogService{client: client},
-		Metadata: &IssueMetadataService{client: client},
+		Votes:      &VoteService{client: client},
+		Watchers:   &WatcherService{client: client},
+		Label:      &LabelService{client: client},
+		Worklog:    &IssueWorklogService{client: client},
+		Metadata:   &IssueMetadataService{client: client},
+		Changelog:  &IssueChangelogService{client: client},
+		Property:   &IssuePropertyService{client: client},
+		RemoteLink: &IssueRemoteLinkService{client: client},
 	}
 
 	client.Permission = &PermissionService{
@@ -189,10 +195,128 @@ func (c *Client) newRequest(ctx context.Context, method, apiEndpoint string, pay
 }
 
 func (c *Client) call(request *http.Request, structure interface{}) (result *ResponseScheme, err error) {
-	response, _ := c.HTTP.Do(request)
+
+	// The transport errors, e.g. the timeouts or the connection resets, are returned to every service as they are
+	response, err := c.HTTP.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
 	return transformTheHTTPResponse(response, structure)
 }
 
+// callTask sends the request of an asynchronous operation, Jira answers with a 303 See Other to the task and its
+// Location is fetched with the TaskService.Get method. The task is returned as it is when the HTTP client follows
+// the redirect.
+func (c *Client) callTask(request *http.Request) (result *models.TaskScheme, response *ResponseScheme, err error) {
+
+	httpResponse, err := c.HTTP.Do(request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if httpResponse.StatusCode != http.StatusSeeOther {
+		response, err = transformTheHTTPResponse(httpResponse, &result)
+		return result, response, err
+	}
+
+	httpResponse.Body.Close()
+
+	location, err := httpResponse.Location()
+	if err != nil {
+		return nil, nil, fmt.Errorf(redirectFailedError, httpResponse.StatusCode)
+	}
+
+	return c.Task.Get(request.Context(), path.Base(location.Path))
+}
+
+// download streams the body of the request to the writer, following the redirects when the HTTP client doesn't.
+// The offset and length are the byte range sent on the Range header, the range is applied to the body when the
+// server ignores the header and returns the whole content.
+func (c *Client) download(request *http.Request, writer io.Writer, offset, length int64) (written int64,
+	result *ResponseScheme, err error) {
+
+	if offset > 0 || length > 0 {
+
+		var byteRange = fmt.Sprintf("bytes=%v-", offset)
+		if length > 0 {
+			byteRange = fmt.Sprintf("bytes=%v-%v", offset, offset+length-1)
+		}
+
+		request.Header.Set("Range", byteRange)
+	}
+
+	response, err := c.HTTP.Do(request)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for redirects := 0; response.StatusCode >= 300 && response.StatusCode < 400; redirects++ {
+
+		location, err := response.Location()
+		response.Body.Close()
+
+		if err != nil || redirects == 10 {
+			return 0, nil, fmt.Errorf(redirectFailedError, response.StatusCode)
+		}
+
+		redirect, err := http.NewRequestWithContext(request.Context(), http.MethodGet, location.String(), nil)
+		if err != nil {
+			return 0, nil, fmt.Errorf(requestCreationError, err.Error())
+		}
+
+		// The credentials are only sent to the site, the redirects to the media servers are signed
+		for _, header := range []string{"Range", "User-Agent", "Accept"} {
+			if value := request.Header.Get(header); value != "" {
+				redirect.Header.Set(header, value)
+			}
+		}
+
+		if location.Host == request.URL.Host {
+			redirect.Header.Set("Authorization", request.Header.Get("Authorization"))
+		}
+
+		response, err = c.HTTP.Do(redirect)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	defer response.Body.Close()
+
+	result = &ResponseScheme{
+		Code:     response.StatusCode,
+		Endpoint: response.Request.URL.String(),
+		Method:   response.Request.Method,
+		Headers:  response.Header,
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+
+		if _, err = io.Copy(&result.Bytes, response.Body); err != nil {
+			return 0, result, err
+		}
+
+		return 0, result, fmt.Errorf(requestFailedError, response.StatusCode)
+	}
+
+	var body io.Reader = response.Body
+
+	if response.StatusCode != http.StatusPartialContent && offset > 0 {
+
+		if _, err = io.CopyN(ioutil.Discard, body, offset); err != nil {
+			return 0, result, err
+		}
+	}
+
+	if length > 0 {
+		body = io.LimitReader(body, length)
+	}
+
+	written, err = io.Copy(writer, body)
+	return written, result, err
+}
+
 func transformStructToReader(structure interface{}) (reader io.Reader, err error) {
 
 	if structure == nil || reflect.ValueOf(structure).IsNil() {
@@ -262,5 +386,6 @@ var (
 	requestCreationError    = "request creation failed: %v"
 	urlParsedError          = "URL parsing failed: %v"
 	requestFailedError      = "request failed. Please analyze the request body for more details. Status Code: %d"
+	redirectFailedError     = "the redirect failed. Status Code: %d"
 	structureNotParsedError = errors.New("failed to parse the interface pointer, please provide a valid one")
 )
@@ -3,10 +3,12 @@ package v2
 import (
 	"context"
 	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 )
 
 func TestTaskService_Cancel(t1 *testing.T) {
@@ -287,3 +289,116 @@ func TestTaskService_Get(t1 *testing.T) {
 	}
 
 }
+
+func TestTaskService_Wait(t1 *testing.T) {
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	testCases := []struct {
+		name               string
+		taskID             string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantStatus         string
+		wantErr            bool
+	}{
+		{
+			name:               "WaitTaskWhenTheParamsAreCorrect",
+			taskID:             "1",
+			mockFile:           "../v3/mocks/task.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/task/1",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantStatus:         models.TaskStatusComplete,
+			wantErr:            false,
+		},
+
+		{
+			name:               "WaitTaskWhenTheContextIsDoneBeforeTheTask",
+			taskID:             "1",
+			mockFile:           "../v3/mocks/task-running.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/task/1",
+			context:            timeoutCtx,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantStatus:         models.TaskStatusRunning,
+			wantErr:            true,
+		},
+
+		{
+			name:               "WaitTaskWhenTheTaskIDIsEmpty",
+			taskID:             "",
+			mockFile:           "../v3/mocks/task.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/task/1",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "WaitTaskWhenTheStatusCodeIsIncorrect",
+			taskID:             "1",
+			mockFile:           "../v3/mocks/task.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/2/task/1",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t1.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			i := &TaskService{client: mockClient}
+
+			gotResult, err := i.Wait(testCase.context, testCase.taskID, 10*time.Millisecond)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if testCase.wantStatus != "" {
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, testCase.wantStatus, gotResult.Status)
+			}
+		})
+
+	}
+
+}
@@ -0,0 +1,226 @@
+package v3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"net/http"
+)
+
+// IssueMerge returns the edit of the issue merged with the current issue, it's called by the
+// IssueService.UpdateIfUnchanged method when the issue has been modified since the snapshot.
+type IssueMerge func(current *models.IssueScheme) (payload *models.IssueScheme, customFields *models.CustomFields,
+	operations *models.UpdateOperations, err error)
+
+// CommentMerge returns the edit of the comment merged with the current comment, it's called by the
+// CommentService.UpdateIfUnchanged method when the comment has been modified since the snapshot.
+type CommentMerge func(current *models.IssueCommentScheme) (payload *models.CommentPayloadScheme, err error)
+
+// ProjectPropertyMerge returns the value of the property merged with the current property, it's called by the
+// ProjectPropertyService.SetIfUnchanged method when the property has been modified since the snapshot.
+// The current property is nil when the property has been deleted.
+type ProjectPropertyMerge func(current *models.EntityPropertyScheme) (payload interface{}, err error)
+
+// UpdateIfUnchanged edits an issue when it hasn't been modified since the snapshot, see the Update method.
+// The updated timestamp of the issue is read right before the edit and compared with the timestamp of the snapshot.
+// When the issue has been modified, the merge function is called with the current issue and its edit is sent
+// instead, a models.UpdateConflictError is returned when there is no merge function or the merges are exhausted.
+// Docs: N/A
+func (i *IssueService) UpdateIfUnchanged(ctx context.Context, issueKeyOrID string, snapshot *models.IssueScheme, notify bool,
+	payload *models.IssueScheme, customFields *models.CustomFields, operations *models.UpdateOperations, merge IssueMerge,
+	options *models.ConditionalUpdateOptionsScheme) (response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if snapshot == nil || snapshot.Fields == nil || snapshot.Fields.Updated == "" {
+		return nil, models.ErrNoUpdatedSnapshotError
+	}
+
+	version, err := snapshot.Fields.UpdatedTime()
+	if err != nil {
+		return nil, err
+	}
+
+	// The whole issue is read only when it can be merged
+	var fields []string
+	if merge == nil {
+		fields = []string{"updated"}
+	}
+
+	for merges := 0; ; merges++ {
+
+		current, response, err := i.Get(ctx, issueKeyOrID, fields, nil)
+		if err != nil {
+			return response, err
+		}
+
+		if current.Fields == nil || current.Fields.Updated == "" {
+			return response, fmt.Errorf("jira: the updated timestamp of the issue %v is unknown", issueKeyOrID)
+		}
+
+		updated, err := current.Fields.UpdatedTime()
+		if err != nil {
+			return response, err
+		}
+
+		if updated.Equal(version) {
+			return i.Update(ctx, issueKeyOrID, notify, payload, customFields, operations)
+		}
+
+		if merge == nil || merges >= conditionalUpdateMerges(options) {
+
+			return response, &models.UpdateConflictError{
+				Resource: fmt.Sprintf("issue %v", issueKeyOrID),
+				Snapshot: version.Format(models.DateFormatJira),
+				Current:  current.Fields.Updated,
+			}
+		}
+
+		if payload, customFields, operations, err = merge(current); err != nil {
+			return response, err
+		}
+
+		version = updated
+	}
+}
+
+// UpdateIfUnchanged updates a comment when it hasn't been modified since the snapshot, see the Update method.
+// The updated timestamp of the comment is read right before the update and compared with the timestamp of the
+// snapshot. When the comment has been modified, the merge function is called with the current comment and its
+// payload is sent instead, a models.UpdateConflictError is returned when there is no merge function or the merges
+// are exhausted.
+// Docs: N/A
+func (c *CommentService) UpdateIfUnchanged(ctx context.Context, issueKeyOrID, commentID string, snapshot *models.IssueCommentScheme,
+	notify bool, payload *models.CommentPayloadScheme, merge CommentMerge, options *models.ConditionalUpdateOptionsScheme) (
+	result *models.IssueCommentScheme, response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if len(commentID) == 0 {
+		return nil, nil, models.ErrNoCommentIDError
+	}
+
+	if snapshot == nil || snapshot.Updated == "" {
+		return nil, nil, models.ErrNoUpdatedSnapshotError
+	}
+
+	version := snapshot.Updated
+
+	for merges := 0; ; merges++ {
+
+		current, response, err := c.Get(ctx, issueKeyOrID, commentID)
+		if err != nil {
+			return nil, response, err
+		}
+
+		if current.Updated == version {
+			return c.Update(ctx, issueKeyOrID, commentID, notify, payload, nil)
+		}
+
+		if merge == nil || merges >= conditionalUpdateMerges(options) {
+
+			return nil, response, &models.UpdateConflictError{
+				Resource: fmt.Sprintf("comment %v of the issue %v", commentID, issueKeyOrID),
+				Snapshot: version,
+				Current:  current.Updated,
+			}
+		}
+
+		if payload, err = merge(current); err != nil {
+			return nil, response, err
+		}
+
+		version = current.Updated
+	}
+}
+
+// SetIfUnchanged sets the value of a project property when it hasn't been modified since the snapshot, see the Set
+// method. The properties don't have a version, the value of the property is read right before it's set and compared
+// with the value of the snapshot. A nil snapshot expects the property not to exist.
+// When the property has been modified, the merge function is called with the current property and its value is set
+// instead, a models.UpdateConflictError is returned when there is no merge function or the merges are exhausted.
+// Docs: N/A
+func (p *ProjectPropertyService) SetIfUnchanged(ctx context.Context, projectKeyOrID, propertyKey string,
+	snapshot *models.EntityPropertyScheme, payload interface{}, merge ProjectPropertyMerge,
+	options *models.ConditionalUpdateOptionsScheme) (response *ResponseScheme, err error) {
+
+	if len(projectKeyOrID) == 0 {
+		return nil, models.ErrNoProjectIDError
+	}
+
+	if len(propertyKey) == 0 {
+		return nil, models.ErrNoPropertyKeyError
+	}
+
+	version, err := entityPropertyVersion(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	for merges := 0; ; merges++ {
+
+		current, response, err := p.Get(ctx, projectKeyOrID, propertyKey)
+		if err != nil {
+
+			if response == nil || response.Code != http.StatusNotFound {
+				return response, err
+			}
+
+			current = nil
+		}
+
+		currentVersion, err := entityPropertyVersion(current)
+		if err != nil {
+			return response, err
+		}
+
+		if currentVersion == version {
+			return p.Set(ctx, projectKeyOrID, propertyKey, payload)
+		}
+
+		if merge == nil || merges >= conditionalUpdateMerges(options) {
+
+			return response, &models.UpdateConflictError{
+				Resource: fmt.Sprintf("property %v of the project %v", propertyKey, projectKeyOrID),
+				Snapshot: version,
+				Current:  currentVersion,
+			}
+		}
+
+		if payload, err = merge(current); err != nil {
+			return response, err
+		}
+
+		version = currentVersion
+	}
+}
+
+// entityPropertyVersion returns the value of the property encoded as JSON, the properties that don't exist are
+// encoded as none.
+func entityPropertyVersion(property *models.EntityPropertyScheme) (string, error) {
+
+	if property == nil {
+		return "none", nil
+	}
+
+	value, err := json.Marshal(property.Value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(value), nil
+}
+
+func conditionalUpdateMerges(options *models.ConditionalUpdateOptionsScheme) int {
+
+	if options == nil || options.Merges <= 0 {
+		return 3
+	}
+
+	return options.Merges
+}
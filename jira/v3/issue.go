@@ -25,6 +25,9 @@ type IssueService struct {
 	Search     *IssueSearchService
 	Worklog    *IssueWorklogService
 	Metadata   *IssueMetadataService
+	Changelog  *IssueChangelogService
+	Property   *IssuePropertyService
+	RemoteLink *IssueRemoteLinkService
 }
 
 // Create creates an issue or, where the option to create subtasks is enabled in Jira, a subtask.
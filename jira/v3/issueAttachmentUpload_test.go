@@ -0,0 +1,350 @@
+package v3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestAttachmentService_Upload(t *testing.T) {
+
+	newFiles := func() []*models.AttachmentUploadFileScheme {
+		return []*models.AttachmentUploadFileScheme{
+			{Name: "notes.txt", Reader: strings.NewReader("release notes"), Size: 13},
+			{Name: "log.txt", Reader: strings.NewReader("deployment log")},
+		}
+	}
+
+	testCases := []struct {
+		name               string
+		issueKeyOrID       string
+		files              []*models.AttachmentUploadFileScheme
+		options            *models.AttachmentUploadOptionsScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantAttachments    int
+		wantErr            bool
+	}{
+		{
+			name:               "UploadAttachmentsWhenTheParametersAreCorrect",
+			issueKeyOrID:       "KP-1",
+			files:              newFiles(),
+			options:            &models.AttachmentUploadOptionsScheme{SkipSettingsCheck: true},
+			mockFile:           "./mocks/get-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/issue/KP-1/attachments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantAttachments:    2,
+			wantErr:            false,
+		},
+
+		{
+			name:               "UploadAttachmentsWhenTheFilesAreSentInBatches",
+			issueKeyOrID:       "KP-1",
+			files:              newFiles(),
+			options:            &models.AttachmentUploadOptionsScheme{SkipSettingsCheck: true, BatchSize: 1},
+			mockFile:           "./mocks/get-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/issue/KP-1/attachments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantAttachments:    4,
+			wantErr:            false,
+		},
+
+		{
+			name:               "UploadAttachmentsWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID:       "",
+			files:              newFiles(),
+			options:            &models.AttachmentUploadOptionsScheme{SkipSettingsCheck: true},
+			mockFile:           "./mocks/get-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/issue/KP-1/attachments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UploadAttachmentsWhenTheFilesAreNotProvided",
+			issueKeyOrID:       "KP-1",
+			files:              nil,
+			options:            &models.AttachmentUploadOptionsScheme{SkipSettingsCheck: true},
+			mockFile:           "./mocks/get-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/issue/KP-1/attachments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UploadAttachmentsWhenTheFileNameIsNotProvided",
+			issueKeyOrID:       "KP-1",
+			files:              []*models.AttachmentUploadFileScheme{{Reader: strings.NewReader("release notes")}},
+			options:            &models.AttachmentUploadOptionsScheme{SkipSettingsCheck: true},
+			mockFile:           "./mocks/get-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/issue/KP-1/attachments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UploadAttachmentsWhenTheFileReaderIsNotProvided",
+			issueKeyOrID:       "KP-1",
+			files:              []*models.AttachmentUploadFileScheme{{Name: "notes.txt"}},
+			options:            &models.AttachmentUploadOptionsScheme{SkipSettingsCheck: true},
+			mockFile:           "./mocks/get-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/issue/KP-1/attachments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UploadAttachmentsWhenTheContextIsNotProvided",
+			issueKeyOrID:       "KP-1",
+			files:              newFiles(),
+			options:            &models.AttachmentUploadOptionsScheme{SkipSettingsCheck: true},
+			mockFile:           "./mocks/get-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/issue/KP-1/attachments",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UploadAttachmentsWhenTheRequestMethodIsIncorrect",
+			issueKeyOrID:       "KP-1",
+			files:              newFiles(),
+			options:            &models.AttachmentUploadOptionsScheme{SkipSettingsCheck: true},
+			mockFile:           "./mocks/get-attachments.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/3/issue/KP-1/attachments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UploadAttachmentsWhenTheStatusCodeIsIncorrect",
+			issueKeyOrID:       "KP-1",
+			files:              newFiles(),
+			options:            &models.AttachmentUploadOptionsScheme{SkipSettingsCheck: true},
+			mockFile:           "./mocks/get-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/issue/KP-1/attachments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UploadAttachmentsWhenTheResponseBodyIsEmpty",
+			issueKeyOrID:       "KP-1",
+			files:              newFiles(),
+			options:            &models.AttachmentUploadOptionsScheme{SkipSettingsCheck: true},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/issue/KP-1/attachments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UploadAttachmentsWhenAFileExceedsTheUploadLimit",
+			issueKeyOrID:       "KP-1",
+			files:              []*models.AttachmentUploadFileScheme{{Name: "backup.zip", Reader: strings.NewReader("backup"), Size: 2000000}},
+			options:            nil,
+			mockFile:           "./mocks/get-attachment-settings.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/attachment/meta",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var progress []*models.AttachmentUploadProgressScheme
+			if testCase.options != nil {
+				testCase.options.Progress = func(p *models.AttachmentUploadProgressScheme) { progress = append(progress, p) }
+			}
+
+			service := &AttachmentService{client: mockClient}
+			gotResult, gotResponse, err := service.Upload(testCase.context, testCase.issueKeyOrID, testCase.files, testCase.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.wantAttachments, len(gotResult))
+
+				// The total size is unknown, the size of the second file is unknown
+				last := progress[len(progress)-1]
+				assert.Equal(t, int64(27), last.Written)
+				assert.Equal(t, int64(0), last.Size)
+				assert.Equal(t, 1, last.FileIndex)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, apiEndpoint.Path)
+				assert.Equal(t, testCase.endpoint, apiEndpoint.Path)
+			}
+		})
+
+	}
+
+}
+
+func TestAttachmentService_UploadWhenTheStreamExceedsTheUploadLimit(t *testing.T) {
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		switch r.URL.Path {
+		case "/rest/api/3/attachment/meta":
+			fmt.Fprint(w, `{"enabled": true, "uploadLimit": 10}`)
+		case "/rest/api/3/issue/KP-1/attachments":
+			_, _ = ioutil.ReadAll(r.Body)
+			fmt.Fprint(w, `[]`)
+		default:
+			http.Error(w, fmt.Sprintf("Request URL: %v", r.URL.Path), http.StatusBadRequest)
+		}
+	}))
+
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := &AttachmentService{client: mockClient}
+
+	// The size of the file is unknown, the limit is checked while it's sent
+	files := []*models.AttachmentUploadFileScheme{{Name: "backup.zip", Reader: strings.NewReader("a backup larger than the limit")}}
+
+	_, _, err = service.Upload(context.Background(), "KP-1", files, nil)
+
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, models.ErrAttachmentTooLargeError))
+}
+
+func TestAttachmentService_UploadWhenTheFilesAreAttached(t *testing.T) {
+
+	mockServer, err := startMockServer(&mockServerOptions{
+		Endpoint:           "/rest/api/3/issue/KP-1?fields=attachment",
+		MockFilePath:       "./mocks/get-issue-attachments.json",
+		MethodAccepted:     http.MethodGet,
+		ResponseCodeWanted: http.StatusOK,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := &AttachmentService{client: mockClient}
+
+	// The attachments are uploaded by a previous upload, there's nothing to upload
+	files := []*models.AttachmentUploadFileScheme{{Name: "picture.jpg", Reader: strings.NewReader("picture"), Size: 23123}}
+	options := &models.AttachmentUploadOptionsScheme{SkipSettingsCheck: true, SkipExisting: true}
+
+	gotResult, gotResponse, err := service.Upload(context.Background(), "KP-1", files, options)
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, gotResponse, nil)
+	assert.Equal(t, 0, len(gotResult))
+}
+
+func TestAttachmentService_UploadWhenTheIssueHasNoFields(t *testing.T) {
+
+	// The issue is returned without fields, the files aren't attached yet
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/KP-1":
+			_, _ = fmt.Fprint(w, `{"id":"10000","key":"KP-1"}`)
+
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/3/issue/KP-1/attachments":
+			_, _ = fmt.Fprint(w, `[{"id":"10001","filename":"picture.jpg","size":23123}]`)
+
+		default:
+			http.Error(w, fmt.Sprintf("Request: %v %v", r.Method, r.URL), http.StatusNotFound)
+		}
+	}))
+
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := &AttachmentService{client: mockClient}
+
+	files := []*models.AttachmentUploadFileScheme{{Name: "picture.jpg", Reader: strings.NewReader("picture"), Size: 23123}}
+	options := &models.AttachmentUploadOptionsScheme{SkipSettingsCheck: true, SkipExisting: true}
+
+	gotResult, gotResponse, err := service.Upload(context.Background(), "KP-1", files, options)
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, gotResponse, nil)
+	assert.Equal(t, 1, len(gotResult))
+}
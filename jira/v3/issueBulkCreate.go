@@ -0,0 +1,295 @@
+package v3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// issueBulkCreateLimit is the maximum number of issues created per request by Jira.
+const issueBulkCreateLimit = 50
+
+// BulkCreate creates any number of issues, the issues are sent in chunks of at most 50 issues to the endpoint of the
+// Creates method, and the chunks are sent concurrently. The custom fields of the issues are optional.
+// The failures reported by Jira are mapped back to the issues of the caller, and the failed issues are created again
+// after the rate limits and the server errors. The creation isn't idempotent, the issues of the requests without
+// response, e.g. after a timeout, aren't created again because Jira may have created them.
+// The report contains the result of every issue in the order of the issues, the error is returned when the context
+// is done.
+// Docs: N/A
+func (i *IssueService) BulkCreate(ctx context.Context, payload []*models.IssueBulkSchemeV3,
+	options *models.IssueBulkCreateOptionsScheme) (report *models.IssueBulkCreateReportScheme, err error) {
+
+	if ctx == nil {
+		return nil, models.ErrNoContextError
+	}
+
+	if len(payload) == 0 {
+		return nil, models.ErrNoIssuesError
+	}
+
+	issueUpdates := make([]map[string]interface{}, len(payload))
+	for index, issue := range payload {
+
+		if issue == nil || issue.Payload == nil {
+			return nil, fmt.Errorf("jira: no payload set on the issue #%v", index)
+		}
+
+		if issueUpdates[index], err = issueBulkCreatePayload(issue); err != nil {
+			return nil, err
+		}
+	}
+
+	if options == nil {
+		options = &models.IssueBulkCreateOptionsScheme{}
+	}
+
+	var (
+		results   = make([]*models.IssueBulkCreateResultScheme, len(payload))
+		retryable = make([]bool, len(payload))
+		pending   = make([]int, len(payload))
+	)
+
+	for index := range payload {
+		results[index] = &models.IssueBulkCreateResultScheme{Index: index}
+		pending[index] = index
+	}
+
+	delay := options.RetryDelay
+	if delay <= 0 {
+		delay = time.Second
+	}
+
+	for retries := 0; ; retries++ {
+
+		i.bulkCreateChunks(ctx, issueUpdates, pending, options, results, retryable)
+
+		pending = nil
+		for index, result := range results {
+
+			if result.Error != nil && retryable[index] {
+				pending = append(pending, index)
+			}
+		}
+
+		if len(pending) == 0 || retries >= options.Retries || ctx.Err() != nil {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(delay):
+			delay *= 2
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	// The issues not sent when the context is done are failed
+	for _, result := range results {
+
+		if result.Attempts == 0 {
+			result.Error = ctx.Err()
+		}
+	}
+
+	return models.NewIssueBulkCreateReport(results), ctx.Err()
+}
+
+// bulkCreateChunks creates the pending issues, the chunks are sent concurrently.
+func (i *IssueService) bulkCreateChunks(ctx context.Context, issueUpdates []map[string]interface{}, pending []int,
+	options *models.IssueBulkCreateOptionsScheme, results []*models.IssueBulkCreateResultScheme, retryable []bool) {
+
+	chunkSize := options.ChunkSize
+	if chunkSize <= 0 || chunkSize > issueBulkCreateLimit {
+		chunkSize = issueBulkCreateLimit
+	}
+
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	var (
+		mutex     sync.Mutex
+		waitGroup sync.WaitGroup
+		jobs      = make(chan []int)
+	)
+
+	for worker := 0; worker < concurrency; worker++ {
+
+		waitGroup.Add(1)
+
+		go func() {
+
+			defer waitGroup.Done()
+
+			for chunk := range jobs {
+
+				i.bulkCreateChunk(ctx, issueUpdates, chunk, results, retryable)
+
+				if options.Progress != nil {
+
+					chunkResults := make([]*models.IssueBulkCreateResultScheme, len(chunk))
+					for position, index := range chunk {
+						chunkResults[position] = results[index]
+					}
+
+					mutex.Lock()
+					options.Progress(chunkResults)
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+
+	for start := 0; start < len(pending) && ctx.Err() == nil; start += chunkSize {
+
+		end := start + chunkSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+
+		select {
+		case jobs <- pending[start:end]:
+		case <-ctx.Done():
+		}
+	}
+
+	close(jobs)
+	waitGroup.Wait()
+}
+
+// bulkCreateChunk creates the issues of a chunk and maps the results back to the issues, the failed elements are
+// numbered by their position on the chunk and the issues created are returned in the order of the chunk.
+func (i *IssueService) bulkCreateChunk(ctx context.Context, issueUpdates []map[string]interface{}, chunk []int,
+	results []*models.IssueBulkCreateResultScheme, retryable []bool) {
+
+	issues := make([]map[string]interface{}, len(chunk))
+	for position, index := range chunk {
+		issues[position] = issueUpdates[index]
+		results[index].Attempts++
+	}
+
+	created, response, err := i.bulkCreateRequest(ctx, issues)
+
+	// Jira returns a bad request with the errors of the issues when none of them is created
+	if err != nil && response != nil && response.Code == http.StatusBadRequest {
+
+		rejected := &models.IssueBulkResponseScheme{}
+		if json.Unmarshal(response.Bytes.Bytes(), rejected) == nil && len(rejected.Errors) != 0 {
+			created, err = rejected, nil
+		}
+	}
+
+	if err != nil {
+
+		for _, index := range chunk {
+
+			*results[index] = models.IssueBulkCreateResultScheme{Index: index, Attempts: results[index].Attempts, Error: err}
+			if response != nil {
+				results[index].Status = response.Code
+			}
+
+			// The requests without response may have been processed, only the rejected requests are retried
+			retryable[index] = response != nil &&
+				(response.Code == http.StatusTooManyRequests || response.Code >= http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	failed := make(map[int]*models.IssueBulkResponseErrorScheme)
+	for _, elementError := range created.Errors {
+		failed[elementError.FailedElementNumber] = elementError
+	}
+
+	var next int
+	for position, index := range chunk {
+
+		result := &models.IssueBulkCreateResultScheme{Index: index, Attempts: results[index].Attempts}
+
+		if elementError, ok := failed[position]; ok {
+
+			result.Status = elementError.Status
+			if result.Status == 0 {
+				result.Status = elementError.ElementErrors.Status
+			}
+
+			result.ErrorMessages = elementError.ElementErrors.ErrorMessages
+			result.Errors = elementError.ElementErrors.Errors
+			result.Error = fmt.Errorf("jira: the issue #%v isn't created, status %v: %v", index, result.Status,
+				strings.Join(models.IssueBulkErrorMessages(elementError), ", "))
+
+			retryable[index] = result.Status == http.StatusTooManyRequests || result.Status >= http.StatusInternalServerError
+
+		} else if next < len(created.Issues) {
+
+			result.ID, result.Key, result.Self = created.Issues[next].ID, created.Issues[next].Key, created.Issues[next].Self
+			next++
+
+		} else {
+
+			// The issue may have been created, it isn't retried
+			result.Error = fmt.Errorf("jira: the issue #%v isn't returned by the bulk creation", index)
+			retryable[index] = false
+		}
+
+		*results[index] = *result
+	}
+}
+
+// bulkCreateRequest sends a chunk of issues to the endpoint of the Creates method.
+func (i *IssueService) bulkCreateRequest(ctx context.Context, issues []map[string]interface{}) (
+	result *models.IssueBulkResponseScheme, response *ResponseScheme, err error) {
+
+	payload := map[string]interface{}{"issueUpdates": issues}
+
+	payloadAsReader, err := transformStructToReader(&payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.client.newRequest(ctx, http.MethodPost, "rest/api/3/issue/bulk", payloadAsReader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = i.client.call(request, &result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return
+}
+
+// issueBulkCreatePayload returns the issue with its custom fields, unlike the Creates method the custom fields
+// are optional.
+func issueBulkCreatePayload(issue *models.IssueBulkSchemeV3) (map[string]interface{}, error) {
+
+	if issue.CustomFields != nil && len(issue.CustomFields.Fields) != 0 {
+		return issue.Payload.MergeCustomFields(issue.CustomFields)
+	}
+
+	payloadAsBytes, err := json.Marshal(issue.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := make(map[string]interface{})
+	if err = json.Unmarshal(payloadAsBytes, &payload); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,314 @@
+package v3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// startMockBulkCreateServer starts a server creating the issues with the key KP-<summary>, the first word of the
+// summary. The "invalid" issues are rejected, the first attempt of the "flaky" issues fails with a server error, and
+// the requests with a "down" issue fail with a server error. The connection of the requests with a "reset" issue is
+// closed without response. The sizes of the chunks are recorded.
+func startMockBulkCreateServer(chunks *[]int, mutex *sync.Mutex) *httptest.Server {
+
+	attempts := make(map[string]int)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/api/3/issue/bulk" {
+			http.Error(w, fmt.Sprintf("Request: %v %v", r.Method, r.URL), http.StatusNotFound)
+			return
+		}
+
+		payload := struct {
+			IssueUpdates []struct {
+				Fields struct {
+					Summary string `json:"summary"`
+				} `json:"fields"`
+			} `json:"issueUpdates"`
+		}{}
+
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		*chunks = append(*chunks, len(payload.IssueUpdates))
+
+		result := &models.IssueBulkResponseScheme{}
+		for position, issue := range payload.IssueUpdates {
+
+			words := strings.Fields(issue.Fields.Summary)
+			attempts[words[0]]++
+
+			elementError := &models.IssueBulkResponseErrorScheme{FailedElementNumber: position}
+
+			switch words[len(words)-1] {
+			case "reset":
+
+				connection, _, err := w.(http.Hijacker).Hijack()
+				if err == nil {
+					connection.Close()
+				}
+
+				return
+
+			case "down":
+				http.Error(w, "the server is down", http.StatusInternalServerError)
+				return
+
+			case "invalid":
+				elementError.Status = http.StatusBadRequest
+				elementError.ElementErrors.Errors = map[string]string{"summary": "The summary is invalid."}
+				result.Errors = append(result.Errors, elementError)
+				continue
+
+			case "flaky":
+
+				if attempts[words[0]] == 1 {
+					elementError.Status = http.StatusServiceUnavailable
+					elementError.ElementErrors.ErrorMessages = []string{"The issue can't be created now."}
+					result.Errors = append(result.Errors, elementError)
+					continue
+				}
+			}
+
+			result.Issues = append(result.Issues, struct {
+				ID   string `json:"id,omitempty"`
+				Key  string `json:"key,omitempty"`
+				Self string `json:"self,omitempty"`
+			}{ID: fmt.Sprint(10000 + len(attempts)), Key: "KP-" + words[0]})
+		}
+
+		if len(result.Issues) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			w.WriteHeader(http.StatusCreated)
+		}
+
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+}
+
+func TestIssueService_BulkCreate(t *testing.T) {
+
+	issues := func(count int, tags map[int]string) (payload []*models.IssueBulkSchemeV3) {
+
+		for index := 0; index < count; index++ {
+
+			summary := fmt.Sprint(index)
+			if tag, ok := tags[index]; ok {
+				summary += " " + tag
+			}
+
+			payload = append(payload, &models.IssueBulkSchemeV3{Payload: &models.IssueScheme{
+				Fields: &models.IssueFieldsScheme{Summary: summary, Project: &models.ProjectScheme{Key: "KP"}}}})
+		}
+
+		return payload
+	}
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		name         string
+		payload      []*models.IssueBulkSchemeV3
+		options      *models.IssueBulkCreateOptionsScheme
+		context      context.Context
+		wantChunks   []int
+		wantFailed   map[int]int
+		wantAttempts map[int]int
+		wantErr      bool
+	}{
+		{
+			name:       "BulkCreateWhenTheIssuesExceedTheLimit",
+			payload:    issues(120, nil),
+			context:    context.Background(),
+			wantChunks: []int{50, 50, 20},
+			wantErr:    false,
+		},
+
+		{
+			name:    "BulkCreateWhenSomeIssuesFail",
+			payload: issues(120, map[int]string{7: "invalid", 60: "flaky"}),
+			options: &models.IssueBulkCreateOptionsScheme{
+				Concurrency: 2,
+				Retries:     1,
+				RetryDelay:  time.Millisecond,
+			},
+			context:      context.Background(),
+			wantChunks:   []int{50, 50, 20, 1},
+			wantFailed:   map[int]int{7: http.StatusBadRequest},
+			wantAttempts: map[int]int{7: 1, 60: 2, 61: 1},
+			wantErr:      false,
+		},
+
+		{
+			name:         "BulkCreateWhenTheRetriesAreDisabled",
+			payload:      issues(5, map[int]string{1: "flaky"}),
+			options:      &models.IssueBulkCreateOptionsScheme{ChunkSize: 2},
+			context:      context.Background(),
+			wantChunks:   []int{2, 2, 1},
+			wantFailed:   map[int]int{1: http.StatusServiceUnavailable},
+			wantAttempts: map[int]int{1: 1},
+			wantErr:      false,
+		},
+
+		{
+			name:         "BulkCreateWhenEveryIssueOfAChunkIsRejected",
+			payload:      issues(3, map[int]string{0: "invalid", 1: "invalid"}),
+			options:      &models.IssueBulkCreateOptionsScheme{ChunkSize: 2, Retries: 2, RetryDelay: time.Millisecond},
+			context:      context.Background(),
+			wantChunks:   []int{2, 1},
+			wantFailed:   map[int]int{0: http.StatusBadRequest, 1: http.StatusBadRequest},
+			wantAttempts: map[int]int{0: 1, 1: 1, 2: 1},
+			wantErr:      false,
+		},
+
+		{
+			name:         "BulkCreateWhenTheServerIsDown",
+			payload:      issues(3, map[int]string{1: "down"}),
+			options:      &models.IssueBulkCreateOptionsScheme{ChunkSize: 2, Retries: 1, RetryDelay: time.Millisecond},
+			context:      context.Background(),
+			wantChunks:   []int{2, 1, 2},
+			wantFailed:   map[int]int{0: http.StatusInternalServerError, 1: http.StatusInternalServerError},
+			wantAttempts: map[int]int{0: 2, 1: 2, 2: 1},
+			wantErr:      false,
+		},
+
+		{
+			name:         "BulkCreateWhenTheRequestHasNoResponse",
+			payload:      issues(3, map[int]string{1: "reset"}),
+			options:      &models.IssueBulkCreateOptionsScheme{ChunkSize: 2, Retries: 2, RetryDelay: time.Millisecond},
+			context:      context.Background(),
+			wantChunks:   []int{2, 1},
+			wantFailed:   map[int]int{0: 0, 1: 0},
+			wantAttempts: map[int]int{0: 1, 1: 1, 2: 1},
+			wantErr:      false,
+		},
+
+		{
+			name:    "BulkCreateWhenTheIssuesAreNotProvided",
+			payload: nil,
+			context: context.Background(),
+			wantErr: true,
+		},
+
+		{
+			name:    "BulkCreateWhenAnIssueHasNoPayload",
+			payload: append(issues(2, nil), &models.IssueBulkSchemeV3{}),
+			context: context.Background(),
+			wantErr: true,
+		},
+
+		{
+			name:    "BulkCreateWhenTheContextIsNotProvided",
+			payload: issues(2, nil),
+			context: nil,
+			wantErr: true,
+		},
+
+		{
+			name:    "BulkCreateWhenTheContextIsCanceled",
+			payload: issues(2, nil),
+			context: canceledCtx,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var (
+				mutex  sync.Mutex
+				chunks []int
+			)
+
+			mockServer := startMockBulkCreateServer(&chunks, &mutex)
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var progress int
+			if testCase.options != nil {
+				testCase.options.Progress = func(results []*models.IssueBulkCreateResultScheme) { progress += len(results) }
+			}
+
+			gotReport, err := mockClient.Issue.BulkCreate(testCase.context, testCase.payload, testCase.options)
+
+			// The server may still be handling a request closed without response
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, len(testCase.payload), len(gotReport.Results))
+			assert.Equal(t, len(testCase.payload)-len(testCase.wantFailed), gotReport.Created)
+			assert.Equal(t, len(testCase.wantFailed), gotReport.Failed)
+
+			// The chunks are sent concurrently, the order of the requests isn't known
+			assert.ElementsMatch(t, testCase.wantChunks, chunks)
+
+			for index, result := range gotReport.Results {
+
+				assert.Equal(t, index, result.Index)
+
+				if status, ok := testCase.wantFailed[index]; ok {
+
+					t.Logf("issue #%v failed: %v", index, result.Error)
+
+					assert.Error(t, result.Error)
+					assert.Equal(t, status, result.Status)
+					assert.Empty(t, result.Key)
+
+				} else {
+
+					assert.NoError(t, result.Error)
+					assert.Equal(t, fmt.Sprintf("KP-%v", index), result.Key)
+				}
+
+				if attempts, ok := testCase.wantAttempts[index]; ok {
+					assert.Equal(t, attempts, result.Attempts, index)
+				}
+			}
+
+			// The results of every request are reported
+			if testCase.options != nil {
+
+				var sent int
+				for _, chunk := range chunks {
+					sent += chunk
+				}
+
+				assert.Equal(t, sent, progress)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package v3
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// IssueBulkOperation is applied to every issue matched by the JQL of the IssueService.BulkApply method.
+type IssueBulkOperation func(ctx context.Context, issue *models.IssueScheme) (*ResponseScheme, error)
+
+// BulkApply applies the operation to every issue matched by the JQL, e.g. the operations returned by the
+// MoveToOperation, AssignOperation, UpdateOperation or LabelsOperation methods.
+// The issues are searched before the operation is applied, the issues updated don't change the search pages.
+// The issues are updated concurrently, and retried after the timeouts, the connection resets, the rate limits and
+// the server errors.
+// The keys of the issues updated are written on the checkpoint file, the issues of the file are skipped.
+// The report contains the result of every issue, the error is returned when the search or the checkpoint fails,
+// or when the context is done.
+// Docs: N/A
+func (i *IssueService) BulkApply(ctx context.Context, jql string, operation IssueBulkOperation,
+	options *models.IssueBulkOperationOptionsScheme) (report *models.IssueBulkOperationReportScheme, err error) {
+
+	if len(jql) == 0 {
+		return nil, models.ErrNoJQLError
+	}
+
+	if operation == nil {
+		return nil, models.ErrNoIssueBulkOperationError
+	}
+
+	if options == nil {
+		options = &models.IssueBulkOperationOptionsScheme{}
+	}
+
+	checkpoint, err := readIssueBulkCheckpoint(options.CheckpointFile)
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := i.bulkSearch(ctx, jql, options.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*models.IssueBulkOperationResultScheme, len(issues))
+	for index, issue := range issues {
+
+		results[index] = &models.IssueBulkOperationResultScheme{IssueID: issue.ID, IssueKey: issue.Key}
+
+		if issue.Fields != nil {
+
+			results[index].Summary = issue.Fields.Summary
+			if issue.Fields.Status != nil {
+				results[index].Status = issue.Fields.Status.Name
+			}
+		}
+	}
+
+	var checkpointFile *os.File
+	if options.CheckpointFile != "" && !options.DryRun {
+
+		checkpointFile, err = os.OpenFile(options.CheckpointFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, err
+		}
+
+		defer checkpointFile.Close()
+	}
+
+	var (
+		mutex         sync.Mutex
+		waitGroup     sync.WaitGroup
+		checkpointErr error
+		jobs          = make(chan int)
+	)
+
+	// finish records the result, the results are reported one at a time
+	finish := func(result *models.IssueBulkOperationResultScheme) {
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if result.Result == models.IssueBulkOperationSucceeded && checkpointFile != nil && checkpointErr == nil {
+			_, checkpointErr = fmt.Fprintln(checkpointFile, result.IssueKey)
+		}
+
+		if options.Progress != nil {
+			options.Progress(result)
+		}
+	}
+
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	for worker := 0; worker < concurrency; worker++ {
+
+		waitGroup.Add(1)
+
+		go func() {
+
+			defer waitGroup.Done()
+
+			for index := range jobs {
+
+				i.bulkApply(ctx, issues[index], operation, options, results[index])
+				finish(results[index])
+			}
+		}()
+	}
+
+	for index, result := range results {
+
+		switch {
+		case checkpoint[result.IssueKey]:
+			result.Result = models.IssueBulkOperationSkipped
+			finish(result)
+
+		case options.DryRun:
+			result.Result = models.IssueBulkOperationPreviewed
+			finish(result)
+
+		case ctx.Err() == nil:
+
+			select {
+			case jobs <- index:
+			case <-ctx.Done():
+			}
+		}
+	}
+
+	close(jobs)
+	waitGroup.Wait()
+
+	// The issues not updated when the context is done are failed
+	for _, result := range results {
+
+		if result.Result == "" {
+			result.Result, result.Error = models.IssueBulkOperationFailed, ctx.Err()
+		}
+	}
+
+	report = models.NewIssueBulkOperationReport(results)
+
+	if checkpointErr != nil {
+		return report, checkpointErr
+	}
+
+	return report, ctx.Err()
+}
+
+// bulkSearch returns every issue matched by the JQL.
+func (i *IssueService) bulkSearch(ctx context.Context, jql string, pageSize int) (issues []*models.IssueScheme, err error) {
+
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+
+	for startAt := 0; ; {
+
+		page, _, err := i.Search.Post(ctx, jql, []string{"summary", "status"}, nil, startAt, pageSize, "")
+		if err != nil {
+			return nil, err
+		}
+
+		issues = append(issues, page.Issues...)
+		startAt += len(page.Issues)
+
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			return issues, nil
+		}
+	}
+}
+
+// bulkApply applies the operation to the issue, retrying the timeouts, the connection resets, the rate limits and
+// the server errors.
+func (i *IssueService) bulkApply(ctx context.Context, issue *models.IssueScheme, operation IssueBulkOperation,
+	options *models.IssueBulkOperationOptionsScheme, result *models.IssueBulkOperationResultScheme) {
+
+	delay := options.RetryDelay
+	if delay <= 0 {
+		delay = time.Second
+	}
+
+	for {
+
+		result.Attempts++
+
+		response, err := operation(ctx, issue)
+		if err == nil {
+			result.Result, result.Error = models.IssueBulkOperationSucceeded, nil
+			return
+		}
+
+		result.Result, result.Error = models.IssueBulkOperationFailed, err
+
+		if !isIssueBulkRetryable(response, err) || result.Attempts > options.Retries || ctx.Err() != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+			delay *= 2
+		}
+	}
+}
+
+// isIssueBulkRetryable reports whether the error is a timeout, a connection reset, a rate limit or a server error.
+// The canceled contexts and the local errors, e.g. the errors of the transitions or the payloads, aren't retried.
+func isIssueBulkRetryable(response *ResponseScheme, err error) bool {
+
+	if response != nil {
+		return response.Code == http.StatusTooManyRequests || response.Code >= http.StatusInternalServerError
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var networkError net.Error
+	if errors.As(err, &networkError) && networkError.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET)
+}
+
+// readIssueBulkCheckpoint returns the issue keys of the checkpoint file, the file doesn't exist on the first run.
+func readIssueBulkCheckpoint(fileName string) (map[string]bool, error) {
+
+	keys := make(map[string]bool)
+	if fileName == "" {
+		return keys, nil
+	}
+
+	file, err := os.Open(fileName)
+	if os.IsNotExist(err) {
+		return keys, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+
+		if key := strings.TrimSpace(scanner.Text()); key != "" {
+			keys[key] = true
+		}
+	}
+
+	return keys, scanner.Err()
+}
+
+// MoveOperation returns a bulk operation performing the transition, see the Move method.
+func (i *IssueService) MoveOperation(transitionID string, options *models.IssueMoveOptionsV3) IssueBulkOperation {
+
+	return func(ctx context.Context, issue *models.IssueScheme) (*ResponseScheme, error) {
+		return i.Move(ctx, issue.Key, transitionID, options)
+	}
+}
+
+// MoveToOperation returns a bulk operation moving the issues to the status, see the MoveTo method.
+func (i *IssueService) MoveToOperation(status string, options *models.IssueTransitionToOptionsScheme) IssueBulkOperation {
+
+	return func(ctx context.Context, issue *models.IssueScheme) (*ResponseScheme, error) {
+
+		_, response, err := i.MoveTo(ctx, issue.Key, status, options)
+		return response, err
+	}
+}
+
+// AssignOperation returns a bulk operation assigning the issues to the account, see the Assign method.
+func (i *IssueService) AssignOperation(accountID string) IssueBulkOperation {
+
+	return func(ctx context.Context, issue *models.IssueScheme) (*ResponseScheme, error) {
+		return i.Assign(ctx, issue.Key, accountID)
+	}
+}
+
+// UpdateOperation returns a bulk operation editing the issues, see the Update method.
+func (i *IssueService) UpdateOperation(notify bool, payload *models.IssueScheme, customFields *models.CustomFields,
+	operations *models.UpdateOperations) IssueBulkOperation {
+
+	return func(ctx context.Context, issue *models.IssueScheme) (*ResponseScheme, error) {
+		return i.Update(ctx, issue.Key, notify, payload, customFields, operations)
+	}
+}
+
+// LabelsOperation returns a bulk operation adding and removing labels of the issues, the users aren't notified.
+func (i *IssueService) LabelsOperation(add, remove []string) IssueBulkOperation {
+
+	labels := make(map[string]string)
+	for _, label := range add {
+		labels[label] = "add"
+	}
+
+	for _, label := range remove {
+		labels[label] = "remove"
+	}
+
+	operations := &models.UpdateOperations{}
+	_ = operations.AddArrayOperation("labels", labels)
+
+	return func(ctx context.Context, issue *models.IssueScheme) (*ResponseScheme, error) {
+		return i.Update(ctx, issue.Key, false, &models.IssueScheme{}, nil, operations)
+	}
+}
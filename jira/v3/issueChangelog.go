@@ -0,0 +1,184 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type IssueChangelogService struct{ client *Client }
+
+const issueChangelogPageSize = 100
+
+// Gets returns a paginated list of all changelogs for an issue sorted by date, starting from the oldest.
+// Docs: N/A
+// Atlassian Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-changelog-get
+func (c *IssueChangelogService) Gets(ctx context.Context, issueKeyOrID string, startAt, maxResults int) (
+	result *models.IssueChangelogPageScheme, response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/changelog?%v", issueKeyOrID, params.Encode())
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// All returns every changelog of an issue, requesting the pages until the last one is reached.
+// Unlike the changelog expand of the IssueService.Get method, the histories are not truncated.
+func (c *IssueChangelogService) All(ctx context.Context, issueKeyOrID string) (result []*models.IssueChangelogHistoryScheme,
+	response *ResponseScheme, err error) {
+
+	for startAt := 0; ; {
+
+		page, pageResponse, err := c.Gets(ctx, issueKeyOrID, startAt, issueChangelogPageSize)
+		if err != nil {
+			return nil, pageResponse, err
+		}
+
+		response = pageResponse
+		result = append(result, page.Values...)
+		startAt += len(page.Values)
+
+		if page.IsLast || len(page.Values) == 0 || (page.Total != 0 && startAt >= page.Total) {
+			return result, response, nil
+		}
+	}
+}
+
+// List returns changelogs for an issue specified by a list of changelog IDs.
+// Docs: N/A
+// Atlassian Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-changelog-list-post
+func (c *IssueChangelogService) List(ctx context.Context, issueKeyOrID string, changelogIDs []int) (result *models.IssueChangelogScheme,
+	response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if len(changelogIDs) == 0 {
+		return nil, nil, models.ErrNoChangelogIDsError
+	}
+
+	payload := struct {
+		ChangelogIds []int `json:"changelogIds"`
+	}{
+		ChangelogIds: changelogIDs,
+	}
+
+	payloadAsReader, _ := transformStructToReader(&payload)
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/changelog/list", issueKeyOrID)
+
+	request, err := c.client.newRequest(ctx, http.MethodPost, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = c.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Bulk returns a paginated list of the changelogs of the issues, optionally filtered by field IDs.
+// Use the NextPageToken of the result on the options to request the next page.
+// Docs: N/A
+// Atlassian Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-changelog-bulkfetch-post
+func (c *IssueChangelogService) Bulk(ctx context.Context, options *models.IssueChangelogBulkOptionsScheme) (
+	result *models.IssueChangelogBulkPageScheme, response *ResponseScheme, err error) {
+
+	if options == nil || len(options.IssueIDsOrKeys) == 0 {
+		return nil, nil, models.ErrNoIssueKeysOrIDsError
+	}
+
+	payloadAsReader, err := transformStructToReader(options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var endpoint = "rest/api/3/changelog/bulkfetch"
+
+	request, err := c.client.newRequest(ctx, http.MethodPost, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = c.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// BulkAll returns every changelog of the issues, requesting the pages until no next page token is returned.
+// The histories of an issue split across pages are merged, keeping the order returned by Jira.
+func (c *IssueChangelogService) BulkAll(ctx context.Context, options *models.IssueChangelogBulkOptionsScheme) (
+	result []*models.IssueChangelogBulkScheme, response *ResponseScheme, err error) {
+
+	if options == nil || len(options.IssueIDsOrKeys) == 0 {
+		return nil, nil, models.ErrNoIssueKeysOrIDsError
+	}
+
+	var (
+		pageOptions = *options
+		issues      = make(map[string]*models.IssueChangelogBulkScheme)
+	)
+
+	for {
+
+		page, pageResponse, err := c.Bulk(ctx, &pageOptions)
+		if err != nil {
+			return nil, pageResponse, err
+		}
+
+		response = pageResponse
+
+		for _, changelog := range page.IssueChangeLogs {
+
+			issue, ok := issues[changelog.IssueID]
+			if !ok {
+				issue = &models.IssueChangelogBulkScheme{IssueID: changelog.IssueID}
+				issues[changelog.IssueID] = issue
+				result = append(result, issue)
+			}
+
+			issue.ChangeHistories = append(issue.ChangeHistories, changelog.ChangeHistories...)
+		}
+
+		if page.NextPageToken == "" || page.NextPageToken == pageOptions.NextPageToken {
+			return result, response, nil
+		}
+
+		pageOptions.NextPageToken = page.NextPageToken
+	}
+}
@@ -0,0 +1,404 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"io"
+	"net/http"
+	"sort"
+)
+
+// Clone copies an issue with its subtasks, optionally into another project or issue type.
+// The fields of the issue are copied from the values returned by the Get method, renamed with the field mapping.
+// The issue links, the remote links and the attachments of the issue and its subtasks are copied too, the links
+// between the issues cloned are linked between their clones. The comments and the watchers are copied when
+// they're enabled on the options, the comments are added by the calling user.
+// The keys of the issues cloned are mapped to the keys of their clones, the issues cloned before a failure are
+// returned with the error.
+// Docs: N/A
+func (i *IssueService) Clone(ctx context.Context, issueKeyOrID string, options *models.IssueCloneOptionsScheme) (
+	result *models.IssueCloneScheme, response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if options == nil {
+		options = &models.IssueCloneOptionsScheme{}
+	}
+
+	issue, response, err := i.Get(ctx, issueKeyOrID, []string{"*all"}, nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if issue.Fields == nil || issue.Fields.Project == nil || issue.Fields.IssueType == nil {
+		return nil, response, fmt.Errorf("jira: the project and the issue type of the issue %v are unknown", issueKeyOrID)
+	}
+
+	cloner := &issueCloner{
+		service: i,
+		options: options,
+		result:  &models.IssueCloneScheme{Keys: make(map[string]string), DroppedFields: make(map[string][]string)},
+		screens: make(map[string]map[string]bool),
+	}
+
+	projectKey, issueTypeID := options.ProjectKey, options.IssueTypeID
+	if projectKey == "" {
+		projectKey = issue.Fields.Project.Key
+	}
+
+	if issueTypeID == "" {
+		issueTypeID = issue.Fields.IssueType.ID
+	}
+
+	// The clone of a subtask is a subtask of the same parent, unless it's cloned into another project
+	parentKey := options.ParentKey
+	if parentKey == "" && issue.Fields.Parent != nil && projectKey == issue.Fields.Project.Key {
+		parentKey = issue.Fields.Parent.Key
+	}
+
+	// A subtask without parent can't be created, it's cloned as a standard issue type or under another parent
+	if parentKey == "" && issue.Fields.IssueType.Subtask {
+
+		subtask := true
+		if options.IssueTypeID != "" {
+
+			issueType, response, err := i.Type.Get(ctx, options.IssueTypeID)
+			if err != nil {
+				return nil, response, err
+			}
+
+			subtask = issueType.Subtask
+		}
+
+		if subtask {
+			return nil, response, fmt.Errorf("jira: the subtask %v can't be cloned into the project %v without a "+
+				"ParentKey or a standard IssueTypeID", issue.Key, projectKey)
+		}
+	}
+
+	cloneKey, response, err := cloner.create(ctx, issue, projectKey, issueTypeID, parentKey)
+	if err != nil {
+		return cloner.result, response, err
+	}
+
+	issues := []*models.IssueScheme{issue}
+
+	if !options.SkipSubtasks {
+
+		for _, issueSubtask := range issue.Fields.Subtasks {
+
+			subtask, response, err := i.Get(ctx, issueSubtask.Key, []string{"*all"}, nil)
+			if err != nil {
+				return cloner.result, response, err
+			}
+
+			if subtask.Fields == nil || subtask.Fields.IssueType == nil {
+				return cloner.result, response, fmt.Errorf("jira: the issue type of the subtask %v is unknown", subtask.Key)
+			}
+
+			if _, response, err = cloner.create(ctx, subtask, projectKey, subtask.Fields.IssueType.ID, cloneKey); err != nil {
+				return cloner.result, response, err
+			}
+
+			issues = append(issues, subtask)
+		}
+	}
+
+	// The content is copied once every issue is cloned, the links between the issues cloned are linked between
+	// their clones
+	for _, issue := range issues {
+
+		if response, err = cloner.copy(ctx, issue); err != nil {
+			return cloner.result, response, err
+		}
+	}
+
+	if options.LinkTypeName != "" {
+
+		payload := &models.LinkPayloadSchemeV3{
+			Type:         &models.LinkTypeScheme{Name: options.LinkTypeName},
+			InwardIssue:  &models.LinkedIssueScheme{Key: cloneKey},
+			OutwardIssue: &models.LinkedIssueScheme{Key: issue.Key},
+		}
+
+		if response, err = i.Link.Create(ctx, payload); err != nil {
+			return cloner.result, response, err
+		}
+	}
+
+	return cloner.result, response, nil
+}
+
+// issueCloner clones the issues of a Clone call, the create screens are looked up once per project and issue type.
+type issueCloner struct {
+	service *IssueService
+	options *models.IssueCloneOptionsScheme
+	result  *models.IssueCloneScheme
+	screens map[string]map[string]bool
+}
+
+// create creates the clone of the issue with the fields of the issue on the create screen.
+func (c *issueCloner) create(ctx context.Context, issue *models.IssueScheme, projectKey, issueTypeID, parentKey string) (
+	key string, response *ResponseScheme, err error) {
+
+	fields := models.IssueCloneFields(issue.Fields.Raw, c.options.FieldMapping)
+
+	if c.options.SummaryPrefix != "" {
+		fields["summary"] = c.options.SummaryPrefix + issue.Fields.Summary
+	}
+
+	if !c.options.SkipScreenCheck {
+
+		screen, response, err := c.screen(ctx, projectKey, issueTypeID)
+		if err != nil {
+			return "", response, err
+		}
+
+		var dropped []string
+		for fieldID := range fields {
+
+			if screen != nil && !screen[fieldID] {
+				dropped = append(dropped, fieldID)
+				delete(fields, fieldID)
+			}
+		}
+
+		if len(dropped) != 0 {
+			sort.Strings(dropped)
+			c.result.DroppedFields[issue.Key] = dropped
+		}
+	}
+
+	fields["project"] = map[string]interface{}{"key": projectKey}
+	fields["issuetype"] = map[string]interface{}{"id": issueTypeID}
+
+	if parentKey != "" {
+		fields["parent"] = map[string]interface{}{"key": parentKey}
+	}
+
+	payload := map[string]interface{}{"fields": fields}
+
+	payloadAsReader, err := transformStructToReader(&payload)
+	if err != nil {
+		return "", nil, err
+	}
+
+	request, err := c.service.client.newRequest(ctx, http.MethodPost, "rest/api/3/issue", payloadAsReader)
+	if err != nil {
+		return "", nil, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	var clone *models.IssueResponseScheme
+
+	response, err = c.service.client.call(request, &clone)
+	if err != nil {
+		return "", response, err
+	}
+
+	c.result.Keys[issue.Key] = clone.Key
+
+	return clone.Key, response, nil
+}
+
+// screen returns the IDs of the fields on the create screen of the project and the issue type, the screen is nil
+// when the issue type isn't available on the project.
+func (c *issueCloner) screen(ctx context.Context, projectKey, issueTypeID string) (screen map[string]bool,
+	response *ResponseScheme, err error) {
+
+	if screen, ok := c.screens[projectKey+"/"+issueTypeID]; ok {
+		return screen, nil, nil
+	}
+
+	fields, response, err := c.service.Metadata.createMetadataFields(ctx, projectKey, issueTypeID)
+	if err != nil && (response == nil || response.Code != http.StatusNotFound) {
+		return nil, response, err
+	}
+
+	// The issue types not available on the project don't have a create screen
+	if err == nil {
+
+		screen = make(map[string]bool)
+		for fieldID := range fields {
+			screen[fieldID] = true
+		}
+	}
+
+	c.screens[projectKey+"/"+issueTypeID] = screen
+
+	return screen, response, nil
+}
+
+// copy copies the links, the attachments, the comments and the watchers of the issue to its clone.
+func (c *issueCloner) copy(ctx context.Context, issue *models.IssueScheme) (response *ResponseScheme, err error) {
+
+	cloneKey := c.result.Keys[issue.Key]
+
+	if !c.options.SkipLinks {
+
+		for _, link := range issue.Fields.IssueLinks {
+
+			if link.Type == nil || (link.OutwardIssue == nil && link.InwardIssue == nil) {
+				continue
+			}
+
+			payload := &models.LinkPayloadSchemeV3{Type: &models.LinkTypeScheme{Name: link.Type.Name}}
+
+			if link.OutwardIssue != nil {
+
+				payload.InwardIssue = &models.LinkedIssueScheme{Key: cloneKey}
+				payload.OutwardIssue = &models.LinkedIssueScheme{Key: link.OutwardIssue.Key}
+
+				if key, ok := c.result.Keys[link.OutwardIssue.Key]; ok {
+					payload.OutwardIssue.Key = key
+				}
+
+			} else {
+
+				// The links between the issues cloned are copied from the inward issue
+				if _, ok := c.result.Keys[link.InwardIssue.Key]; ok {
+					continue
+				}
+
+				payload.InwardIssue = &models.LinkedIssueScheme{Key: link.InwardIssue.Key}
+				payload.OutwardIssue = &models.LinkedIssueScheme{Key: cloneKey}
+			}
+
+			if response, err = c.service.Link.Create(ctx, payload); err != nil {
+				return response, err
+			}
+		}
+	}
+
+	if !c.options.SkipRemoteLinks {
+
+		links, response, err := c.service.RemoteLink.Gets(ctx, issue.Key)
+		if err != nil {
+			return response, err
+		}
+
+		for _, link := range links {
+
+			payload := &models.RemoteLinkScheme{
+				GlobalID:     link.GlobalID,
+				Application:  link.Application,
+				Relationship: link.Relationship,
+				Object:       link.Object,
+			}
+
+			if _, response, err = c.service.RemoteLink.Create(ctx, cloneKey, payload); err != nil {
+				return response, err
+			}
+		}
+	}
+
+	if !c.options.SkipAttachments && len(issue.Fields.Attachment) != 0 {
+
+		var (
+			files   []*models.AttachmentUploadFileScheme
+			readers []*issueCloneAttachmentReader
+		)
+
+		for _, attachment := range issue.Fields.Attachment {
+
+			reader := &issueCloneAttachmentReader{ctx: ctx, service: c.service.Attachment, attachmentID: attachment.ID}
+			readers = append(readers, reader)
+
+			files = append(files, &models.AttachmentUploadFileScheme{
+				Name:   attachment.Filename,
+				Reader: reader,
+				Size:   int64(attachment.Size),
+			})
+		}
+
+		_, response, err = c.service.Attachment.Upload(ctx, cloneKey, files, nil)
+
+		for _, reader := range readers {
+			reader.Close()
+		}
+
+		if err != nil {
+			return response, err
+		}
+	}
+
+	if c.options.Comments {
+
+		for startAt := 0; ; {
+
+			page, response, err := c.service.Comment.Gets(ctx, issue.Key, "created", nil, startAt, 50)
+			if err != nil {
+				return response, err
+			}
+
+			for _, comment := range page.Comments {
+
+				payload := &models.CommentPayloadScheme{Body: comment.Body, Visibility: comment.Visibility}
+
+				if _, response, err = c.service.Comment.Add(ctx, cloneKey, payload, nil); err != nil {
+					return response, err
+				}
+			}
+
+			startAt += len(page.Comments)
+
+			if len(page.Comments) == 0 || startAt >= page.Total {
+				break
+			}
+		}
+	}
+
+	if c.options.Watchers {
+
+		watchers, response, err := c.service.Watchers.Gets(ctx, issue.Key)
+		if err != nil {
+			return response, err
+		}
+
+		for _, watcher := range watchers.Watchers {
+
+			if response, err = c.service.Watchers.AddUser(ctx, cloneKey, watcher.AccountID); err != nil {
+				return response, err
+			}
+		}
+	}
+
+	return response, nil
+}
+
+// issueCloneAttachmentReader streams the content of an attachment, the download starts on the first read.
+type issueCloneAttachmentReader struct {
+	ctx          context.Context
+	service      *AttachmentService
+	attachmentID string
+	pipeReader   *io.PipeReader
+}
+
+func (r *issueCloneAttachmentReader) Read(p []byte) (int, error) {
+
+	if r.pipeReader == nil {
+
+		pipeReader, pipeWriter := io.Pipe()
+		r.pipeReader = pipeReader
+
+		go func() {
+			_, _, err := r.service.Download(r.ctx, r.attachmentID, nil, pipeWriter)
+			pipeWriter.CloseWithError(err)
+		}()
+	}
+
+	return r.pipeReader.Read(p)
+}
+
+// Close stops the download when the upload fails.
+func (r *issueCloneAttachmentReader) Close() {
+
+	if r.pipeReader != nil {
+		r.pipeReader.Close()
+	}
+}
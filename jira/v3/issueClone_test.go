@@ -0,0 +1,406 @@
+package v3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// mockCloneRequests contains the payloads received by the clone mock server, keyed by the method and the path.
+type mockCloneRequests struct {
+	mutex    sync.Mutex
+	payloads map[string][]interface{}
+}
+
+func (m *mockCloneRequests) add(key string, payload interface{}) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.payloads[key] = append(m.payloads[key], payload)
+}
+
+// startMockCloneServer starts a server cloning the KP-1 issue, the KP-2 issue is its subtask.
+// The customfield_10010 field isn't on the create screens, and the issues are created as KP-10, KP-11...
+func startMockCloneServer(t *testing.T, requests *mockCloneRequests) *httptest.Server {
+
+	issues := map[string]string{
+		"KP-1": `{"id":"10001","key":"KP-1","fields":{
+			"summary":"Migrate the billing service","labels":["backend"],"status":{"id":"1","name":"Open"},
+			"project":{"id":"10000","key":"KP"},"issuetype":{"id":"10001","name":"Story"},"priority":{"id":"3"},
+			"customfield_10010":"not on the screen","customfield_10020":{"value":"Team A"},"created":"2021-01-01T00:00:00.000+0000",
+			"subtasks":[{"id":"10002","key":"KP-2"}],
+			"issuelinks":[
+				{"id":"1","type":{"name":"Blocks"},"outwardIssue":{"key":"KP-9"}},
+				{"id":"2","type":{"name":"Relates"},"outwardIssue":{"key":"KP-2"}},
+				{"id":"3","type":{"name":"Duplicate"},"inwardIssue":{"key":"KP-8"}}],
+			"attachment":[{"id":"10000","filename":"notes.txt","size":18}]}}`,
+		"KP-2": `{"id":"10002","key":"KP-2","fields":{
+			"summary":"Move the invoices","status":{"id":"1","name":"Open"},"parent":{"key":"KP-1"},
+			"project":{"id":"10000","key":"KP"},"issuetype":{"id":"10003","name":"Sub-task","subtask":true},
+			"issuelinks":[{"id":"2","type":{"name":"Relates"},"inwardIssue":{"key":"KP-1"}}]}}`,
+	}
+
+	var created int
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		payload := make(map[string]interface{})
+		if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/attachments") {
+
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			for _, header := range r.MultipartForm.File["file"] {
+
+				file, _ := header.Open()
+				content, _ := ioutil.ReadAll(file)
+				payload[header.Filename] = string(content)
+			}
+
+		} else if r.Method == http.MethodPost {
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+		}
+
+		switch key := r.Method + " " + r.URL.Path; {
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/rest/api/3/issue/KP-") &&
+			strings.Count(r.URL.Path, "/") == 5:
+
+			issue, ok := issues[strings.TrimPrefix(r.URL.Path, "/rest/api/3/issue/")]
+			if !ok {
+				http.Error(w, `{"errorMessages":["Issue does not exist"]}`, http.StatusNotFound)
+				return
+			}
+
+			_, _ = w.Write([]byte(issue))
+
+		case key == "GET /rest/api/3/issuetype/10002":
+			_, _ = w.Write([]byte(`{"id":"10002","name":"Task","subtask":false}`))
+
+		case key == "GET /rest/api/3/issuetype/10003":
+			_, _ = w.Write([]byte(`{"id":"10003","name":"Sub-task","subtask":true}`))
+
+		case strings.HasPrefix(key, "GET /rest/api/3/issue/createmeta/"):
+
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":50,"total":7,"fields":[
+				{"fieldId":"summary"},{"fieldId":"labels"},{"fieldId":"priority"},{"fieldId":"customfield_10030"},
+				{"fieldId":"project"},{"fieldId":"issuetype"},{"fieldId":"parent"}]}`))
+
+		case key == "POST /rest/api/3/issue":
+
+			requests.add(key, payload)
+			created++
+			_, _ = fmt.Fprintf(w, `{"id":"%v","key":"KP-%v"}`, 10008+created, 9+created)
+
+		case key == "GET /rest/api/3/issue/KP-1/remotelink":
+			_, _ = w.Write([]byte(`[{"id":10000,"globalId":"system=https://ci.example.com&id=1","object":{"url":"https://ci.example.com/1","title":"Build 1"}}]`))
+
+		case key == "GET /rest/api/3/issue/KP-2/remotelink":
+			_, _ = w.Write([]byte(`[]`))
+
+		case key == "GET /rest/api/3/attachment/meta":
+			_, _ = w.Write([]byte(`{"enabled":true,"uploadLimit":1048576}`))
+
+		case key == "GET /rest/api/3/attachment/content/10000":
+			_, _ = w.Write([]byte("the release notes\n"))
+
+		case key == "GET /rest/api/3/issue/KP-1/comment":
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":50,"total":1,"comments":[{"id":"1","body":{"type":"doc","version":1,
+				"content":[{"type":"paragraph","content":[{"type":"text","text":"Reviewed"}]}]},"visibility":{"type":"role","value":"Developers"}}]}`))
+
+		case key == "GET /rest/api/3/issue/KP-2/comment":
+			_, _ = w.Write([]byte(`{"startAt":0,"maxResults":50,"total":0,"comments":[]}`))
+
+		case key == "GET /rest/api/3/issue/KP-1/watchers":
+			_, _ = w.Write([]byte(`{"watchCount":1,"watchers":[{"accountId":"5b10ac8d82e05b22cc7d4ef5"}]}`))
+
+		case key == "GET /rest/api/3/issue/KP-2/watchers":
+			_, _ = w.Write([]byte(`{"watchCount":0,"watchers":[]}`))
+
+		case key == "POST /rest/api/3/issueLink":
+			requests.add(key, payload)
+			w.WriteHeader(http.StatusCreated)
+
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/remotelink"):
+			requests.add(key, payload)
+			_, _ = w.Write([]byte(`{"id":10001}`))
+
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/attachments"):
+			requests.add(key, payload)
+			_, _ = w.Write([]byte(`[]`))
+
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/comment"):
+			requests.add(key, payload)
+			_, _ = w.Write([]byte(`{"id":"2"}`))
+
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/watchers"):
+
+			accountID, _ := ioutil.ReadAll(r.Body)
+			requests.add(key, string(accountID))
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			http.Error(w, fmt.Sprintf("Request: %v %v", r.Method, r.URL), http.StatusNotFound)
+		}
+	}))
+}
+
+func TestIssueService_Clone(t *testing.T) {
+
+	testCases := []struct {
+		name            string
+		issueKeyOrID    string
+		options         *models.IssueCloneOptionsScheme
+		context         context.Context
+		wantKeys        map[string]string
+		wantDropped     map[string][]string
+		wantIssues      []map[string]interface{}
+		wantLinks       []map[string]interface{}
+		wantRequests    map[string]int
+		wantAttachments map[string]interface{}
+		wantErr         bool
+	}{
+		{
+			name:         "CloneIssueWhenTheOptionsAreProvided",
+			issueKeyOrID: "KP-1",
+			options: &models.IssueCloneOptionsScheme{
+				SummaryPrefix: "CLONE - ",
+				FieldMapping:  map[string]string{"customfield_10020": "customfield_10030"},
+				Comments:      true,
+				Watchers:      true,
+				LinkTypeName:  "Cloners",
+			},
+			context:     context.Background(),
+			wantKeys:    map[string]string{"KP-1": "KP-10", "KP-2": "KP-11"},
+			wantDropped: map[string][]string{"KP-1": {"customfield_10010"}},
+			wantIssues: []map[string]interface{}{
+				{
+					"summary":           "CLONE - Migrate the billing service",
+					"labels":            []interface{}{"backend"},
+					"priority":          map[string]interface{}{"id": "3"},
+					"customfield_10030": map[string]interface{}{"value": "Team A"},
+					"project":           map[string]interface{}{"key": "KP"},
+					"issuetype":         map[string]interface{}{"id": "10001"},
+				},
+				{
+					"summary":   "CLONE - Move the invoices",
+					"project":   map[string]interface{}{"key": "KP"},
+					"issuetype": map[string]interface{}{"id": "10003"},
+					"parent":    map[string]interface{}{"key": "KP-10"},
+				},
+			},
+			wantLinks: []map[string]interface{}{
+				{"type": map[string]interface{}{"name": "Blocks"}, "inwardIssue": map[string]interface{}{"key": "KP-10"},
+					"outwardIssue": map[string]interface{}{"key": "KP-9"}},
+				{"type": map[string]interface{}{"name": "Relates"}, "inwardIssue": map[string]interface{}{"key": "KP-10"},
+					"outwardIssue": map[string]interface{}{"key": "KP-11"}},
+				{"type": map[string]interface{}{"name": "Duplicate"}, "inwardIssue": map[string]interface{}{"key": "KP-8"},
+					"outwardIssue": map[string]interface{}{"key": "KP-10"}},
+				{"type": map[string]interface{}{"name": "Cloners"}, "inwardIssue": map[string]interface{}{"key": "KP-10"},
+					"outwardIssue": map[string]interface{}{"key": "KP-1"}},
+			},
+			wantRequests: map[string]int{
+				"POST /rest/api/3/issue/KP-10/remotelink": 1,
+				"POST /rest/api/3/issue/KP-10/comment":    1,
+				"POST /rest/api/3/issue/KP-10/watchers":   1,
+			},
+			wantAttachments: map[string]interface{}{"notes.txt": "the release notes\n"},
+			wantErr:         false,
+		},
+
+		{
+			name:         "CloneIssueWhenTheContentIsSkipped",
+			issueKeyOrID: "KP-1",
+			options: &models.IssueCloneOptionsScheme{
+				ProjectKey:      "OPS",
+				IssueTypeID:     "10002",
+				SkipScreenCheck: true,
+				SkipSubtasks:    true,
+				SkipLinks:       true,
+				SkipRemoteLinks: true,
+				SkipAttachments: true,
+			},
+			context:     context.Background(),
+			wantKeys:    map[string]string{"KP-1": "KP-10"},
+			wantDropped: map[string][]string{},
+			wantIssues: []map[string]interface{}{
+				{
+					"summary":           "Migrate the billing service",
+					"labels":            []interface{}{"backend"},
+					"priority":          map[string]interface{}{"id": "3"},
+					"customfield_10010": "not on the screen",
+					"customfield_10020": map[string]interface{}{"value": "Team A"},
+					"project":           map[string]interface{}{"key": "OPS"},
+					"issuetype":         map[string]interface{}{"id": "10002"},
+				},
+			},
+			wantRequests: map[string]int{
+				"POST /rest/api/3/issue/KP-10/remotelink": 0,
+				"POST /rest/api/3/issue/KP-10/comment":    0,
+				"POST /rest/api/3/issue/KP-10/watchers":   0,
+			},
+			wantErr: false,
+		},
+
+		{
+			name:         "CloneIssueWhenTheSubtaskIsCloned",
+			issueKeyOrID: "KP-2",
+			options:      &models.IssueCloneOptionsScheme{SkipRemoteLinks: true},
+			context:      context.Background(),
+			wantKeys:     map[string]string{"KP-2": "KP-10"},
+			wantDropped:  map[string][]string{},
+			wantIssues: []map[string]interface{}{
+				{
+					"summary":   "Move the invoices",
+					"project":   map[string]interface{}{"key": "KP"},
+					"issuetype": map[string]interface{}{"id": "10003"},
+					"parent":    map[string]interface{}{"key": "KP-1"},
+				},
+			},
+			wantLinks: []map[string]interface{}{
+				{"type": map[string]interface{}{"name": "Relates"}, "inwardIssue": map[string]interface{}{"key": "KP-1"},
+					"outwardIssue": map[string]interface{}{"key": "KP-10"}},
+			},
+			wantErr: false,
+		},
+
+		{
+			name:         "CloneIssueWhenTheSubtaskIsClonedUnderAnotherParent",
+			issueKeyOrID: "KP-2",
+			options:      &models.IssueCloneOptionsScheme{ProjectKey: "OPS", ParentKey: "OPS-1", SkipLinks: true, SkipRemoteLinks: true},
+			context:      context.Background(),
+			wantKeys:     map[string]string{"KP-2": "KP-10"},
+			wantDropped:  map[string][]string{},
+			wantIssues: []map[string]interface{}{
+				{
+					"summary":   "Move the invoices",
+					"project":   map[string]interface{}{"key": "OPS"},
+					"issuetype": map[string]interface{}{"id": "10003"},
+					"parent":    map[string]interface{}{"key": "OPS-1"},
+				},
+			},
+			wantErr: false,
+		},
+
+		{
+			name:         "CloneIssueWhenTheSubtaskIsClonedAsAStandardIssue",
+			issueKeyOrID: "KP-2",
+			options:      &models.IssueCloneOptionsScheme{ProjectKey: "OPS", IssueTypeID: "10002", SkipLinks: true, SkipRemoteLinks: true},
+			context:      context.Background(),
+			wantKeys:     map[string]string{"KP-2": "KP-10"},
+			wantDropped:  map[string][]string{},
+			wantIssues: []map[string]interface{}{
+				{
+					"summary":   "Move the invoices",
+					"project":   map[string]interface{}{"key": "OPS"},
+					"issuetype": map[string]interface{}{"id": "10002"},
+				},
+			},
+			wantErr: false,
+		},
+
+		{
+			name:         "CloneIssueWhenTheSubtaskIsClonedIntoAnotherProject",
+			issueKeyOrID: "KP-2",
+			options:      &models.IssueCloneOptionsScheme{ProjectKey: "OPS"},
+			context:      context.Background(),
+			wantErr:      true,
+		},
+
+		{
+			name:         "CloneIssueWhenTheSubtaskIsClonedAsAnotherSubtaskType",
+			issueKeyOrID: "KP-2",
+			options:      &models.IssueCloneOptionsScheme{ProjectKey: "OPS", IssueTypeID: "10003"},
+			context:      context.Background(),
+			wantErr:      true,
+		},
+
+		{
+			name:         "CloneIssueWhenTheIssueDoesNotExist",
+			issueKeyOrID: "KP-404",
+			context:      context.Background(),
+			wantErr:      true,
+		},
+
+		{
+			name:         "CloneIssueWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID: "",
+			context:      context.Background(),
+			wantErr:      true,
+		},
+
+		{
+			name:         "CloneIssueWhenTheContextIsNotProvided",
+			issueKeyOrID: "KP-1",
+			context:      nil,
+			wantErr:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			requests := &mockCloneRequests{payloads: make(map[string][]interface{})}
+
+			mockServer := startMockCloneServer(t, requests)
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotResult, gotResponse, err := mockClient.Issue.Clone(testCase.context, testCase.issueKeyOrID, testCase.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEqual(t, gotResponse, nil)
+
+			assert.Equal(t, testCase.wantKeys, gotResult.Keys)
+			assert.Equal(t, testCase.wantDropped, gotResult.DroppedFields)
+
+			var gotIssues []map[string]interface{}
+			for _, payload := range requests.payloads["POST /rest/api/3/issue"] {
+				gotIssues = append(gotIssues, payload.(map[string]interface{})["fields"].(map[string]interface{}))
+			}
+
+			assert.Equal(t, testCase.wantIssues, gotIssues)
+
+			var gotLinks []map[string]interface{}
+			for _, payload := range requests.payloads["POST /rest/api/3/issueLink"] {
+				gotLinks = append(gotLinks, payload.(map[string]interface{}))
+			}
+
+			assert.Equal(t, testCase.wantLinks, gotLinks)
+
+			for key, count := range testCase.wantRequests {
+				assert.Equal(t, count, len(requests.payloads[key]), key)
+			}
+
+			if testCase.wantAttachments != nil {
+				assert.Equal(t, []interface{}{testCase.wantAttachments}, requests.payloads["POST /rest/api/3/issue/KP-10/attachments"])
+			}
+		})
+
+	}
+
+}
@@ -0,0 +1,629 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestCommentPropertyService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		commentID          string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetCommentPropertyKeysWhenTheParametersAreCorrect",
+			commentID:          "10009",
+			mockFile:           "./mocks/get-comment-properties.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/comment/10009/properties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetCommentPropertyKeysWhenTheCommentIDIsNotProvided",
+			commentID:          "",
+			mockFile:           "./mocks/get-comment-properties.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/comment/10009/properties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetCommentPropertyKeysWhenTheContextIsNotProvided",
+			commentID:          "10009",
+			mockFile:           "./mocks/get-comment-properties.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/comment/10009/properties",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetCommentPropertyKeysWhenTheRequestMethodIsIncorrect",
+			commentID:          "10009",
+			mockFile:           "./mocks/get-comment-properties.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/3/comment/10009/properties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetCommentPropertyKeysWhenTheStatusCodeIsIncorrect",
+			commentID:          "10009",
+			mockFile:           "./mocks/get-comment-properties.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/comment/10009/properties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetCommentPropertyKeysWhenTheResponseBodyIsEmpty",
+			commentID:          "10009",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/comment/10009/properties",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &CommentPropertyService{client: mockClient}
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.commentID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestCommentPropertyService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		commentID          string
+		propertyKey        string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetCommentPropertyWhenTheParametersAreCorrect",
+			commentID:          "10009",
+			propertyKey:        "bot.status",
+			mockFile:           "./mocks/get-comment-property.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetCommentPropertyWhenTheCommentIDIsNotProvided",
+			commentID:          "",
+			propertyKey:        "bot.status",
+			mockFile:           "./mocks/get-comment-property.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetCommentPropertyWhenThePropertyKeyIsNotProvided",
+			commentID:          "10009",
+			propertyKey:        "",
+			mockFile:           "./mocks/get-comment-property.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetCommentPropertyWhenTheContextIsNotProvided",
+			commentID:          "10009",
+			propertyKey:        "bot.status",
+			mockFile:           "./mocks/get-comment-property.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetCommentPropertyWhenTheRequestMethodIsIncorrect",
+			commentID:          "10009",
+			propertyKey:        "bot.status",
+			mockFile:           "./mocks/get-comment-property.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetCommentPropertyWhenTheStatusCodeIsIncorrect",
+			commentID:          "10009",
+			propertyKey:        "bot.status",
+			mockFile:           "./mocks/get-comment-property.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetCommentPropertyWhenTheResponseBodyIsEmpty",
+			commentID:          "10009",
+			propertyKey:        "bot.status",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &CommentPropertyService{client: mockClient}
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.commentID, testCase.propertyKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestCommentPropertyService_Set(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		commentID          string
+		propertyKey        string
+		payload            interface{}
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "SetCommentPropertyWhenTheParametersAreCorrect",
+			commentID:          "10009",
+			propertyKey:        "bot.status",
+			payload:            map[string]interface{}{"state": "succeeded", "run": "2021-05-08T07:04:25.376+0100"},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "SetCommentPropertyWhenTheCommentIDIsNotProvided",
+			commentID:          "",
+			propertyKey:        "bot.status",
+			payload:            map[string]interface{}{"state": "succeeded", "run": "2021-05-08T07:04:25.376+0100"},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SetCommentPropertyWhenThePropertyKeyIsNotProvided",
+			commentID:          "10009",
+			propertyKey:        "",
+			payload:            map[string]interface{}{"state": "succeeded", "run": "2021-05-08T07:04:25.376+0100"},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SetCommentPropertyWhenThePayloadIsNotProvided",
+			commentID:          "10009",
+			propertyKey:        "bot.status",
+			payload:            nil,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SetCommentPropertyWhenTheContextIsNotProvided",
+			commentID:          "10009",
+			propertyKey:        "bot.status",
+			payload:            map[string]interface{}{"state": "succeeded", "run": "2021-05-08T07:04:25.376+0100"},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SetCommentPropertyWhenTheRequestMethodIsIncorrect",
+			commentID:          "10009",
+			propertyKey:        "bot.status",
+			payload:            map[string]interface{}{"state": "succeeded", "run": "2021-05-08T07:04:25.376+0100"},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SetCommentPropertyWhenTheStatusCodeIsIncorrect",
+			commentID:          "10009",
+			propertyKey:        "bot.status",
+			payload:            map[string]interface{}{"state": "succeeded", "run": "2021-05-08T07:04:25.376+0100"},
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &CommentPropertyService{client: mockClient}
+			gotResponse, err := service.Set(testCase.context, testCase.commentID, testCase.propertyKey, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
+
+func TestCommentPropertyService_Delete(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		commentID          string
+		propertyKey        string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "DeleteCommentPropertyWhenTheParametersAreCorrect",
+			commentID:          "10009",
+			propertyKey:        "bot.status",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeleteCommentPropertyWhenTheCommentIDIsNotProvided",
+			commentID:          "",
+			propertyKey:        "bot.status",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteCommentPropertyWhenThePropertyKeyIsNotProvided",
+			commentID:          "10009",
+			propertyKey:        "",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteCommentPropertyWhenTheContextIsNotProvided",
+			commentID:          "10009",
+			propertyKey:        "bot.status",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteCommentPropertyWhenTheRequestMethodIsIncorrect",
+			commentID:          "10009",
+			propertyKey:        "bot.status",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteCommentPropertyWhenTheStatusCodeIsIncorrect",
+			commentID:          "10009",
+			propertyKey:        "bot.status",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/comment/10009/properties/bot.status",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &CommentPropertyService{client: mockClient}
+			gotResponse, err := service.Delete(testCase.context, testCase.commentID, testCase.propertyKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+			}
+		})
+
+	}
+
+}
@@ -0,0 +1,380 @@
+package v3
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Import creates an issue for every row of the CSV file, the first row is the header with the column names.
+// The columns are mapped to the fields with the mapping, the user emails, the component and version names and the
+// select options are looked up on Jira, the unknown values are reported as row errors before the issues are created. The issues are created with the BulkCreate method, and the rows with a parent on the same file,
+// e.g. the subtasks, are created in another pass once their parent is created.
+// The report contains the issue created for every row, or the mapping errors and the errors returned by Jira.
+// Docs: N/A
+func (i *IssueService) Import(ctx context.Context, reader io.Reader, mapping *models.IssueImportMappingScheme) (
+	report *models.IssueImportReportScheme, err error) {
+
+	if reader == nil {
+		return nil, models.ErrNoReaderError
+	}
+
+	if mapping == nil || len(mapping.Columns) == 0 {
+		return nil, models.ErrNoIssueImportMappingError
+	}
+
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+
+	headers, err := csvReader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := &issueImportResolver{ctx: ctx, client: i.client}
+
+	var (
+		rows      []*issueImportRow
+		rowsByID  = make(map[string]*issueImportRow)
+		parentIDs = make(map[*issueImportRow]string)
+	)
+
+	for {
+
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		values := make(map[string]string)
+		for index, header := range headers {
+
+			if index < len(record) {
+				values[header] = record[index]
+			}
+		}
+
+		row := &issueImportRow{result: &models.IssueImportRowScheme{Row: len(rows) + 1}}
+		rows = append(rows, row)
+
+		if mapping.IDColumn != "" {
+
+			row.result.ID = strings.TrimSpace(values[mapping.IDColumn])
+			if row.result.ID != "" {
+				rowsByID[row.result.ID] = row
+			}
+		}
+
+		if mapping.ParentColumn != "" {
+			parentIDs[row] = strings.TrimSpace(values[mapping.ParentColumn])
+		}
+
+		resolver.issueType = mapping.RowIssueType(values)
+
+		row.fields, err = mapping.Fields(values, true, resolver.resolve)
+		if err != nil {
+
+			var rowError *models.IssueImportRowError
+			if errors.As(err, &rowError) {
+				row.result.Errors = rowError.Messages
+			} else {
+				row.result.Errors = []string{err.Error()}
+			}
+		}
+	}
+
+	var pending []*issueImportRow
+	for _, row := range rows {
+
+		if len(row.result.Errors) != 0 {
+			continue
+		}
+
+		// The parents outside the file are existing issues
+		if parentID := parentIDs[row]; parentID != "" {
+
+			if parent, ok := rowsByID[parentID]; ok && parent != row {
+				row.parent = parent
+			} else {
+				row.setParent(parentID)
+			}
+		}
+
+		pending = append(pending, row)
+	}
+
+	for len(pending) != 0 {
+
+		var ready, waiting []*issueImportRow
+
+		for _, row := range pending {
+
+			switch {
+			case row.parent == nil:
+				ready = append(ready, row)
+
+			case row.parent.result.IssueKey != "":
+
+				row.setParent(row.parent.result.IssueKey)
+				ready = append(ready, row)
+
+			case len(row.parent.result.Errors) != 0:
+				row.result.Errors = []string{fmt.Sprintf("the parent row %v wasn't imported", row.parent.result.Row)}
+
+			default:
+				waiting = append(waiting, row)
+			}
+		}
+
+		// The rows left are parents of each other
+		if len(ready) == 0 {
+
+			for _, row := range waiting {
+				row.result.Errors = []string{fmt.Sprintf("the parent chain forms a cycle: rows %v", row.parentChain())}
+			}
+
+			break
+		}
+
+		i.importRows(ctx, ready)
+
+		pending = waiting
+	}
+
+	report = &models.IssueImportReportScheme{}
+	for _, row := range rows {
+
+		report.Rows = append(report.Rows, row.result)
+
+		if len(row.result.Errors) != 0 {
+			report.Failed++
+		} else {
+			report.Created++
+		}
+	}
+
+	return report, nil
+}
+
+// importRows creates the issues of the rows with the BulkCreate method, setting the issue or the errors on the row
+// results.
+func (i *IssueService) importRows(ctx context.Context, rows []*issueImportRow) {
+
+	payload := make([]*models.IssueBulkSchemeV3, len(rows))
+	for index, row := range rows {
+		payload[index] = &models.IssueBulkSchemeV3{Payload: &models.IssueScheme{}, CustomFields: row.fields}
+	}
+
+	report, err := i.BulkCreate(ctx, payload, nil)
+	if report == nil {
+
+		for _, row := range rows {
+			row.result.Errors = []string{err.Error()}
+		}
+
+		return
+	}
+
+	for index, result := range report.Results {
+
+		if result.Error != nil {
+			rows[index].result.Errors = result.Messages()
+			continue
+		}
+
+		rows[index].result.IssueID, rows[index].result.IssueKey = result.ID, result.Key
+	}
+}
+
+type issueImportRow struct {
+	result *models.IssueImportRowScheme
+	fields *models.CustomFields
+	parent *issueImportRow
+}
+
+func (r *issueImportRow) setParent(issueKey string) {
+	r.fields.Fields = append(r.fields.Fields, map[string]interface{}{"fields": map[string]interface{}{
+		"parent": map[string]interface{}{"key": issueKey}}})
+}
+
+// parentChain returns the rows from the row to the first parent found twice, e.g. 3 -> 1 -> 2 -> 1.
+func (r *issueImportRow) parentChain() string {
+
+	var (
+		rows    []string
+		visited = make(map[*issueImportRow]bool)
+	)
+
+	for row := r; row != nil; row = row.parent {
+
+		rows = append(rows, strconv.Itoa(row.result.Row))
+		if visited[row] {
+			break
+		}
+
+		visited[row] = true
+	}
+
+	return strings.Join(rows, " -> ")
+}
+
+// issueImportResolver looks up the user emails, the component and version names and the select options, caching
+// the results. The issueType is the issue type of the row being mapped.
+type issueImportResolver struct {
+	ctx        context.Context
+	client     *Client
+	issueType  string
+	users      map[string]string
+	components map[string]map[string]string
+	versions   map[string]map[string]string
+	fields     map[string]map[string]*models.IssueFieldMetadataScheme
+}
+
+func (r *issueImportResolver) resolve(projectKey, fieldID, hint, value string) (string, error) {
+
+	switch {
+	case (hint == models.IssueFieldHintUser || hint == models.IssueFieldHintUsers) && strings.Contains(value, "@"):
+		return r.accountID(value)
+
+	case fieldID == "components" && projectKey != "":
+
+		if r.components == nil {
+			r.components = make(map[string]map[string]string)
+		}
+
+		return r.name(r.components, projectKey, "component", value, func() (names []string, err error) {
+
+			components, _, err := r.client.Project.Component.Gets(r.ctx, projectKey)
+			for _, component := range components {
+				names = append(names, component.Name)
+			}
+
+			return names, err
+		})
+
+	case (fieldID == "fixVersions" || fieldID == "versions") && projectKey != "":
+
+		if r.versions == nil {
+			r.versions = make(map[string]map[string]string)
+		}
+
+		return r.name(r.versions, projectKey, "version", value, func() (names []string, err error) {
+
+			versions, _, err := r.client.Project.Version.Gets(r.ctx, projectKey)
+			for _, version := range versions {
+				names = append(names, version.Name)
+			}
+
+			return names, err
+		})
+
+	case (hint == models.IssueFieldHintSelect || hint == models.IssueFieldHintMultiSelect ||
+		hint == models.IssueFieldHintRadio || hint == models.IssueFieldHintCheckBox) && projectKey != "" && r.issueType != "":
+		return r.option(projectKey, fieldID, value)
+	}
+
+	return value, nil
+}
+
+// option returns the option as it's written on the allowed values of the create screen, the options are compared
+// ignoring the case. The fields without allowed values are sent as they are.
+func (r *issueImportResolver) option(projectKey, fieldID, value string) (string, error) {
+
+	key := projectKey + "/" + r.issueType
+
+	fields, ok := r.fields[key]
+	if !ok {
+
+		issueType, _, err := r.client.Issue.Metadata.createMetadataIssueType(r.ctx, projectKey, r.issueType)
+		if err != nil {
+			return "", err
+		}
+
+		if r.fields == nil {
+			r.fields = make(map[string]map[string]*models.IssueFieldMetadataScheme)
+		}
+
+		fields = issueType.Fields
+		r.fields[key] = fields
+	}
+
+	field, ok := fields[fieldID]
+	if !ok || len(field.AllowedValues) == 0 {
+		return value, nil
+	}
+
+	for _, allowedValue := range field.AllowedValues {
+
+		if option, ok := allowedValue.(map[string]interface{}); ok {
+
+			if name, ok := option["value"].(string); ok && strings.EqualFold(name, value) {
+				return name, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("the option %q isn't allowed on the field %v of the issue type %v", value, fieldID, r.issueType)
+}
+
+func (r *issueImportResolver) accountID(email string) (string, error) {
+
+	if accountID, ok := r.users[strings.ToLower(email)]; ok {
+		return accountID, nil
+	}
+
+	users, _, err := r.client.User.Search.Do(r.ctx, "", email, 0, 50)
+	if err != nil {
+		return "", err
+	}
+
+	for _, user := range users {
+
+		if strings.EqualFold(user.EmailAddress, email) || len(users) == 1 {
+
+			if r.users == nil {
+				r.users = make(map[string]string)
+			}
+
+			r.users[strings.ToLower(email)] = user.AccountID
+			return user.AccountID, nil
+		}
+	}
+
+	return "", fmt.Errorf("no user found with the email %q", email)
+}
+
+// name returns the name as it's written on the project, the names are compared ignoring the case.
+func (r *issueImportResolver) name(cache map[string]map[string]string, projectKey, kind, value string,
+	load func() ([]string, error)) (string, error) {
+
+	names, ok := cache[projectKey]
+	if !ok {
+
+		loaded, err := load()
+		if err != nil {
+			return "", err
+		}
+
+		names = make(map[string]string)
+		for _, name := range loaded {
+			names[strings.ToLower(name)] = name
+		}
+
+		cache[projectKey] = names
+	}
+
+	if name, ok := names[strings.ToLower(value)]; ok {
+		return name, nil
+	}
+
+	return "", fmt.Errorf("the %v %q doesn't exist on the project %v", kind, value, projectKey)
+}
@@ -7,11 +7,15 @@ import (
 	"github.com/tidwall/gjson"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
 type IssueMetadataService struct{ client *Client }
 
+// createMetadataPageSize is the number of issue types and fields requested per page of the create metadata.
+const createMetadataPageSize = 50
+
 // Get edit issue metadata returns the edit screen fields for an issue that are visible to and editable by the user.
 // Use the information to populate the requests in Edit issue.
 // Atlassian URL: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-editmeta-get
@@ -22,24 +26,32 @@ func (i *IssueMetadataService) Get(ctx context.Context, issueKeyOrID string, ove
 		return gjson.Result{}, nil, models.ErrNoIssueKeyOrIDError
 	}
 
-	params := url.Values{}
+	endpoint := editMetadataEndpoint(issueKeyOrID, overrideScreenSecurity, overrideEditableFlag)
 
-	if overrideEditableFlag {
-		params.Add("overrideEditableFlag", "true")
+	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
 	}
 
-	if overrideScreenSecurity {
-		params.Add("overrideScreenSecurity", "true")
+	request.Header.Set("Accept", "application/json")
+
+	response, err = i.client.call(request, nil)
+	if err != nil {
+		return
 	}
 
-	var endpoint strings.Builder
-	endpoint.WriteString(fmt.Sprintf("rest/api/3/issue/%v/editmeta", issueKeyOrID))
+	return gjson.ParseBytes(response.Bytes.Bytes()), response, nil
+}
 
-	if params.Encode() != "" {
-		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
-	}
+// Create returns details of projects, issue types within projects, and, when requested, the create screen fields for each issue type for the user.
+// The endpoint is deprecated by Atlassian, use the GetCreateIssueTypes and GetCreateFields methods instead.
+// Atlassian Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-createmeta-get
+func (i *IssueMetadataService) Create(ctx context.Context, opts *models.IssueMetadataCreateOptions) (result gjson.Result,
+	response *ResponseScheme, err error) {
+
+	endpoint := createMetadataEndpoint(opts)
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -54,11 +66,273 @@ func (i *IssueMetadataService) Get(ctx context.Context, issueKeyOrID string, ove
 	return gjson.ParseBytes(response.Bytes.Bytes()), response, nil
 }
 
-// Create returns details of projects, issue types within projects, and, when requested, the create screen fields for each issue type for the user.
-// Atlassian Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-createmeta-get
-func (i *IssueMetadataService) Create(ctx context.Context, opts *models.IssueMetadataCreateOptions) (result gjson.Result,
+// GetEditMetadata returns the edit screen fields of an issue that are visible to and editable by the user.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-editmeta-get
+func (i *IssueMetadataService) GetEditMetadata(ctx context.Context, issueKeyOrID string, overrideScreenSecurity,
+	overrideEditableFlag bool) (result *models.IssueEditMetadataScheme, response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	endpoint := editMetadataEndpoint(issueKeyOrID, overrideScreenSecurity, overrideEditableFlag)
+
+	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = i.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetCreateMetadata returns the projects and their issue types available to the user, the fields of the create
+// screens are returned with the projects.issuetypes.fields expand.
+// The endpoint is deprecated by Atlassian, use the GetCreateIssueTypes and GetCreateFields methods instead.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-createmeta-get
+func (i *IssueMetadataService) GetCreateMetadata(ctx context.Context, opts *models.IssueMetadataCreateOptions) (
+	result *models.IssueCreateMetadataScheme, response *ResponseScheme, err error) {
+
+	if opts == nil {
+		opts = &models.IssueMetadataCreateOptions{}
+	}
+
+	endpoint := createMetadataEndpoint(opts)
+
+	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = i.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetCreateIssueTypes returns a page of the issue types of the project available to create issues.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-createmeta-projectidorkey-issuetypes-get
+func (i *IssueMetadataService) GetCreateIssueTypes(ctx context.Context, projectKeyOrID string, startAt, maxResults int) (
+	result *models.IssueCreateMetadataIssueTypePageScheme, response *ResponseScheme, err error) {
+
+	if len(projectKeyOrID) == 0 {
+		return nil, nil, models.ErrNoProjectIDError
+	}
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/createmeta/%v/issuetypes?%v", projectKeyOrID, params.Encode())
+
+	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = i.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetCreateFields returns a page of the fields of the create screen of the project and the issue type.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-createmeta-projectidorkey-issuetypes-issuetypeid-get
+func (i *IssueMetadataService) GetCreateFields(ctx context.Context, projectKeyOrID, issueTypeID string, startAt,
+	maxResults int) (result *models.IssueCreateMetadataFieldPageScheme, response *ResponseScheme, err error) {
+
+	if len(projectKeyOrID) == 0 {
+		return nil, nil, models.ErrNoProjectIDError
+	}
+
+	if len(issueTypeID) == 0 {
+		return nil, nil, models.ErrNoIssueTypeIDError
+	}
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/createmeta/%v/issuetypes/%v?%v", projectKeyOrID, issueTypeID,
+		params.Encode())
+
+	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = i.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// ValidateCreate checks the payload of a new issue against the create screen of its project and issue type before
+// it's created, every problem of the payload is returned on a models.IssueFieldValidationError.
+// Docs: N/A
+func (i *IssueMetadataService) ValidateCreate(ctx context.Context, payload *models.IssueScheme, customFields *models.CustomFields) (
 	response *ResponseScheme, err error) {
 
+	var projectKeyOrID, issueTypeIDOrName string
+	if payload != nil && payload.Fields != nil {
+
+		if project := payload.Fields.Project; project != nil {
+
+			projectKeyOrID = project.Key
+			if projectKeyOrID == "" {
+				projectKeyOrID = project.ID
+			}
+		}
+
+		if issueType := payload.Fields.IssueType; issueType != nil {
+
+			issueTypeIDOrName = issueType.ID
+			if issueTypeIDOrName == "" {
+				issueTypeIDOrName = issueType.Name
+			}
+		}
+	}
+
+	if projectKeyOrID == "" || issueTypeIDOrName == "" {
+		return nil, models.ErrNoProjectOrIssueTypeError
+	}
+
+	issueType, response, err := i.createMetadataIssueType(ctx, projectKeyOrID, issueTypeIDOrName)
+	if err != nil {
+		return response, err
+	}
+
+	return response, issueType.Validate(payload, customFields)
+}
+
+// ValidateEdit checks the payload of an issue edit against the edit screen of the issue before it's edited,
+// every problem of the payload is returned on a models.IssueFieldValidationError.
+// Docs: N/A
+func (i *IssueMetadataService) ValidateEdit(ctx context.Context, issueKeyOrID string, payload *models.IssueScheme,
+	customFields *models.CustomFields) (response *ResponseScheme, err error) {
+
+	metadata, response, err := i.GetEditMetadata(ctx, issueKeyOrID, false, false)
+	if err != nil {
+		return response, err
+	}
+
+	return response, metadata.Validate(payload, customFields)
+}
+
+// createMetadataIssueType returns the create screen of the project and the issue type, the issue types of the
+// project are searched when the issue type is referenced by its name.
+func (i *IssueMetadataService) createMetadataIssueType(ctx context.Context, projectKeyOrID, issueTypeIDOrName string) (
+	*models.IssueCreateMetadataIssueTypeScheme, *ResponseScheme, error) {
+
+	issueType := &models.IssueCreateMetadataIssueTypeScheme{ID: issueTypeIDOrName}
+
+	if _, err := strconv.Atoi(issueTypeIDOrName); err != nil {
+
+		issueType = nil
+		for startAt := 0; issueType == nil; {
+
+			page, response, err := i.GetCreateIssueTypes(ctx, projectKeyOrID, startAt, createMetadataPageSize)
+			if err != nil {
+				return nil, response, err
+			}
+
+			for _, candidate := range page.IssueTypes {
+
+				if candidate.Name == issueTypeIDOrName || candidate.UntranslatedName == issueTypeIDOrName {
+					issueType = candidate
+					break
+				}
+			}
+
+			startAt += len(page.IssueTypes)
+			if issueType == nil && (len(page.IssueTypes) == 0 || startAt >= page.Total) {
+				return nil, response, fmt.Errorf("jira: the project %v doesn't have the issue type %v on the create metadata",
+					projectKeyOrID, issueTypeIDOrName)
+			}
+		}
+	}
+
+	fields, response, err := i.createMetadataFields(ctx, projectKeyOrID, issueType.ID)
+	if err != nil {
+		return nil, response, err
+	}
+
+	issueType.Fields = fields
+
+	return issueType, response, nil
+}
+
+// createMetadataFields returns every field of the create screen of the project and the issue type, keyed by the
+// field ID.
+func (i *IssueMetadataService) createMetadataFields(ctx context.Context, projectKeyOrID, issueTypeID string) (
+	fields map[string]*models.IssueFieldMetadataScheme, response *ResponseScheme, err error) {
+
+	fields = make(map[string]*models.IssueFieldMetadataScheme)
+
+	for startAt := 0; ; {
+
+		page, response, err := i.GetCreateFields(ctx, projectKeyOrID, issueTypeID, startAt, createMetadataPageSize)
+		if err != nil {
+			return nil, response, err
+		}
+
+		for _, field := range page.Fields {
+			fields[field.FieldID] = &field.IssueFieldMetadataScheme
+		}
+
+		startAt += len(page.Fields)
+		if len(page.Fields) == 0 || startAt >= page.Total {
+			return fields, response, nil
+		}
+	}
+}
+
+func editMetadataEndpoint(issueKeyOrID string, overrideScreenSecurity, overrideEditableFlag bool) string {
+
+	params := url.Values{}
+
+	if overrideEditableFlag {
+		params.Add("overrideEditableFlag", "true")
+	}
+
+	if overrideScreenSecurity {
+		params.Add("overrideScreenSecurity", "true")
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/3/issue/%v/editmeta", issueKeyOrID))
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	return endpoint.String()
+}
+
+func createMetadataEndpoint(opts *models.IssueMetadataCreateOptions) string {
+
 	params := url.Values{}
 
 	for _, id := range opts.IssueTypeIDs {
@@ -88,17 +362,5 @@ func (i *IssueMetadataService) Create(ctx context.Context, opts *models.IssueMet
 		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
 	}
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
-	if err != nil {
-		return
-	}
-
-	request.Header.Set("Accept", "application/json")
-
-	response, err = i.client.call(request, nil)
-	if err != nil {
-		return
-	}
-
-	return gjson.ParseBytes(response.Bytes.Bytes()), response, nil
+	return endpoint.String()
 }
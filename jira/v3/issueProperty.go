@@ -0,0 +1,198 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"net/http"
+)
+
+type IssuePropertyService struct{ client *Client }
+
+// Gets returns the keys of all the properties of an issue.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-properties/#api-rest-api-3-issue-issueidorkey-properties-get
+func (i *IssuePropertyService) Gets(ctx context.Context, issueKeyOrID string) (result *models.PropertyPageScheme,
+	response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/properties", issueKeyOrID)
+
+	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = i.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Get returns the value of a property of an issue, use the DecodeValue method to decode it into a struct.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-properties/#api-rest-api-3-issue-issueidorkey-properties-propertykey-get
+func (i *IssuePropertyService) Get(ctx context.Context, issueKeyOrID, propertyKey string) (result *models.EntityPropertyScheme,
+	response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if len(propertyKey) == 0 {
+		return nil, nil, models.ErrNoPropertyKeyError
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/properties/%v", issueKeyOrID, propertyKey)
+
+	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = i.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Set sets the value of a property of an issue, the property is created when it doesn't exist.
+// The payload is encoded as JSON, the maximum length is 32768 characters.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-properties/#api-rest-api-3-issue-issueidorkey-properties-propertykey-put
+func (i *IssuePropertyService) Set(ctx context.Context, issueKeyOrID, propertyKey string, payload interface{}) (
+	response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if len(propertyKey) == 0 {
+		return nil, models.ErrNoPropertyKeyError
+	}
+
+	payloadAsReader, err := transformStructToReader(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/properties/%v", issueKeyOrID, propertyKey)
+
+	request, err := i.client.newRequest(ctx, http.MethodPut, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = i.client.call(request, nil)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Delete deletes a property of an issue.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-properties/#api-rest-api-3-issue-issueidorkey-properties-propertykey-delete
+func (i *IssuePropertyService) Delete(ctx context.Context, issueKeyOrID, propertyKey string) (response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if len(propertyKey) == 0 {
+		return nil, models.ErrNoPropertyKeyError
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/properties/%v", issueKeyOrID, propertyKey)
+
+	request, err := i.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	response, err = i.client.call(request, nil)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// BulkSet sets a property on all the issues matching the filter, the user must be able to edit the issues.
+// The issues are updated asynchronously, the task is read from the redirect returned by Jira. Follow its status
+// with the TaskService.Get or TaskService.Wait methods.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-properties/#api-rest-api-3-issue-properties-propertykey-put
+func (i *IssuePropertyService) BulkSet(ctx context.Context, propertyKey string, payload *models.IssuePropertyBulkSetScheme) (
+	result *models.TaskScheme, response *ResponseScheme, err error) {
+
+	if len(propertyKey) == 0 {
+		return nil, nil, models.ErrNoPropertyKeyError
+	}
+
+	payloadAsReader, err := transformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/properties/%v", propertyKey)
+
+	request, err := i.client.newRequest(ctx, http.MethodPut, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	return i.client.callTask(request)
+}
+
+// BulkDelete deletes a property from all the issues matching the filter, the user must be able to edit the issues.
+// The issues are updated asynchronously, the task is read from the redirect returned by Jira. Follow its status
+// with the TaskService.Get or TaskService.Wait methods.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-properties/#api-rest-api-3-issue-properties-propertykey-delete
+func (i *IssuePropertyService) BulkDelete(ctx context.Context, propertyKey string, filter *models.IssuePropertyBulkDeleteScheme) (
+	result *models.TaskScheme, response *ResponseScheme, err error) {
+
+	if len(propertyKey) == 0 {
+		return nil, nil, models.ErrNoPropertyKeyError
+	}
+
+	if filter == nil {
+		filter = &models.IssuePropertyBulkDeleteScheme{}
+	}
+
+	payloadAsReader, err := transformStructToReader(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/properties/%v", propertyKey)
+
+	request, err := i.client.newRequest(ctx, http.MethodDelete, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	return i.client.callTask(request)
+}
@@ -0,0 +1,233 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"net/http"
+	"net/url"
+)
+
+type IssueRemoteLinkService struct{ client *Client }
+
+// Gets returns the remote issue links for an issue.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-remote-links/#api-rest-api-3-issue-issueidorkey-remotelink-get
+func (i *IssueRemoteLinkService) Gets(ctx context.Context, issueKeyOrID string) (result []*models.RemoteLinkScheme,
+	response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/remotelink", issueKeyOrID)
+
+	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = i.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// GetByGlobalID returns the remote issue link of an issue with the global ID.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-remote-links/#api-rest-api-3-issue-issueidorkey-remotelink-get
+func (i *IssueRemoteLinkService) GetByGlobalID(ctx context.Context, issueKeyOrID, globalID string) (result *models.RemoteLinkScheme,
+	response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if len(globalID) == 0 {
+		return nil, nil, models.ErrNoRemoteLinkGlobalIDError
+	}
+
+	params := url.Values{}
+	params.Add("globalId", globalID)
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/remotelink?%v", issueKeyOrID, params.Encode())
+
+	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = i.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Get returns a remote issue link for an issue.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-remote-links/#api-rest-api-3-issue-issueidorkey-remotelink-linkid-get
+func (i *IssueRemoteLinkService) Get(ctx context.Context, issueKeyOrID, linkID string) (result *models.RemoteLinkScheme,
+	response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if len(linkID) == 0 {
+		return nil, nil, models.ErrNoRemoteLinkIDError
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/remotelink/%v", issueKeyOrID, linkID)
+
+	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = i.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Create creates a remote issue link, or updates the remote issue link with the same global ID.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-remote-links/#api-rest-api-3-issue-issueidorkey-remotelink-post
+func (i *IssueRemoteLinkService) Create(ctx context.Context, issueKeyOrID string, payload *models.RemoteLinkScheme) (
+	result *models.RemoteLinkIdentify, response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	payloadAsReader, err := transformStructToReader(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/remotelink", issueKeyOrID)
+
+	request, err := i.client.newRequest(ctx, http.MethodPost, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = i.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Update updates a remote issue link for an issue, the fields without value are removed from the link.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-remote-links/#api-rest-api-3-issue-issueidorkey-remotelink-linkid-put
+func (i *IssueRemoteLinkService) Update(ctx context.Context, issueKeyOrID, linkID string, payload *models.RemoteLinkScheme) (
+	response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if len(linkID) == 0 {
+		return nil, models.ErrNoRemoteLinkIDError
+	}
+
+	payloadAsReader, err := transformStructToReader(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/remotelink/%v", issueKeyOrID, linkID)
+
+	request, err := i.client.newRequest(ctx, http.MethodPut, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = i.client.call(request, nil)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Delete deletes a remote issue link from an issue.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-remote-links/#api-rest-api-3-issue-issueidorkey-remotelink-linkid-delete
+func (i *IssueRemoteLinkService) Delete(ctx context.Context, issueKeyOrID, linkID string) (response *ResponseScheme, err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if len(linkID) == 0 {
+		return nil, models.ErrNoRemoteLinkIDError
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/remotelink/%v", issueKeyOrID, linkID)
+
+	request, err := i.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	response, err = i.client.call(request, nil)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// DeleteByGlobalID deletes the remote issue link of an issue with the global ID.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-remote-links/#api-rest-api-3-issue-issueidorkey-remotelink-delete
+func (i *IssueRemoteLinkService) DeleteByGlobalID(ctx context.Context, issueKeyOrID, globalID string) (response *ResponseScheme,
+	err error) {
+
+	if len(issueKeyOrID) == 0 {
+		return nil, models.ErrNoIssueKeyOrIDError
+	}
+
+	if len(globalID) == 0 {
+		return nil, models.ErrNoRemoteLinkGlobalIDError
+	}
+
+	params := url.Values{}
+	params.Add("globalId", globalID)
+
+	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/remotelink?%v", issueKeyOrID, params.Encode())
+
+	request, err := i.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	response, err = i.client.call(request, nil)
+	if err != nil {
+		return
+	}
+
+	return
+}
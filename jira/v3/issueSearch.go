@@ -10,7 +10,10 @@ import (
 	"strings"
 )
 
-type IssueSearchService struct{ client *Client }
+type IssueSearchService struct {
+	client *Client
+	JQL    *IssueSearchJQLService
+}
 
 // Get search issues using JQL query under the HTTP Method GET
 // Docs: https://docs.go-atlassian.io/jira-software-cloud/issues/search#search-for-issues-using-jql-get
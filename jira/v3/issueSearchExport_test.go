@@ -0,0 +1,375 @@
+package v3
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestIssueSearchService_ExportCSV(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		jql                string
+		options            *models.IssueExportOptionsScheme
+		writer             io.Writer
+		wantHeader         []string
+		wantRows           int
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name: "ExportIssuesAsCSVWhenTheParametersAreCorrect",
+			jql:  "project = KP",
+			options: &models.IssueExportOptionsScheme{
+				Columns: []*models.IssueExportColumnScheme{
+					{Field: "key"},
+					{Header: "Summary", Field: "summary"},
+					{Header: "Status", Field: "status"},
+					{Header: "Assignee Email", Field: "assignee.emailAddress"},
+					{Header: "Labels", Field: "labels"},
+					{Header: "Description", Field: "description"},
+					{Header: "Story Points", Field: "customfield_10016"},
+				},
+				Separator: "|",
+			},
+			writer:             &bytes.Buffer{},
+			wantHeader:         []string{"key", "Summary", "Status", "Assignee Email", "Labels", "Description", "Story Points"},
+			wantRows:           18,
+			mockFile:           "./mocks/search-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/search",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ExportIssuesAsCSVWhenTheOptionsAreNotProvided",
+			jql:                "project = KP",
+			options:            nil,
+			writer:             &bytes.Buffer{},
+			wantHeader:         []string{"key", "issuetype", "summary", "status", "priority", "assignee", "reporter", "created", "updated"},
+			wantRows:           18,
+			mockFile:           "./mocks/search-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/search",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ExportIssuesAsCSVWhenTheJQLIsNotProvided",
+			jql:                "",
+			writer:             &bytes.Buffer{},
+			mockFile:           "./mocks/search-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/search",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ExportIssuesAsCSVWhenTheWriterIsNotProvided",
+			jql:                "project = KP",
+			writer:             nil,
+			mockFile:           "./mocks/search-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/search",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ExportIssuesAsCSVWhenTheContextIsNotProvided",
+			jql:                "project = KP",
+			writer:             &bytes.Buffer{},
+			mockFile:           "./mocks/search-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/search",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ExportIssuesAsCSVWhenTheRequestMethodIsIncorrect",
+			jql:                "project = KP",
+			writer:             &bytes.Buffer{},
+			mockFile:           "./mocks/search-issues.json",
+			wantHTTPMethod:     http.MethodHead,
+			endpoint:           "/rest/api/3/search",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ExportIssuesAsCSVWhenTheStatusCodeIsIncorrect",
+			jql:                "project = KP",
+			writer:             &bytes.Buffer{},
+			mockFile:           "./mocks/search-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/search",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ExportIssuesAsCSVWhenTheResponseBodyIsEmpty",
+			jql:                "project = KP",
+			writer:             &bytes.Buffer{},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/search",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueSearchService{client: mockClient}
+			gotExported, err := service.ExportCSV(testCase.context, testCase.jql, testCase.writer, testCase.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.wantRows, gotExported)
+
+				records, err := csv.NewReader(testCase.writer.(*bytes.Buffer)).ReadAll()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				assert.Equal(t, testCase.wantRows+1, len(records))
+				assert.Equal(t, testCase.wantHeader, records[0])
+
+				for _, record := range records[1:] {
+					assert.True(t, strings.HasPrefix(record[0], "KP-"))
+				}
+			}
+		})
+
+	}
+
+}
+
+func TestIssueSearchService_ExportJSONLines(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		jql                string
+		options            *models.IssueExportOptionsScheme
+		writer             io.Writer
+		wantKeys           []string
+		wantLines          int
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "ExportIssuesAsJSONLinesWhenTheParametersAreCorrect",
+			jql:                "project = KP",
+			options:            &models.IssueExportOptionsScheme{Fields: []string{"summary", "status"}, PageSize: 50},
+			writer:             &bytes.Buffer{},
+			wantKeys:           []string{"fields", "id", "key", "self"},
+			wantLines:          18,
+			mockFile:           "./mocks/search-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/search",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name: "ExportIssuesAsJSONLinesWhenTheColumnsAreProvided",
+			jql:  "project = KP",
+			options: &models.IssueExportOptionsScheme{
+				Columns: []*models.IssueExportColumnScheme{
+					{Header: "Key", Field: "key"},
+					{Header: "Summary", Field: "summary"},
+				},
+			},
+			writer:             &bytes.Buffer{},
+			wantKeys:           []string{"Key", "Summary"},
+			wantLines:          18,
+			mockFile:           "./mocks/search-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/search",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ExportIssuesAsJSONLinesWhenTheJQLIsNotProvided",
+			jql:                "",
+			writer:             &bytes.Buffer{},
+			mockFile:           "./mocks/search-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/search",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ExportIssuesAsJSONLinesWhenTheWriterIsNotProvided",
+			jql:                "project = KP",
+			writer:             nil,
+			mockFile:           "./mocks/search-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/search",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ExportIssuesAsJSONLinesWhenTheContextIsNotProvided",
+			jql:                "project = KP",
+			writer:             &bytes.Buffer{},
+			mockFile:           "./mocks/search-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/search",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ExportIssuesAsJSONLinesWhenTheStatusCodeIsIncorrect",
+			jql:                "project = KP",
+			writer:             &bytes.Buffer{},
+			mockFile:           "./mocks/search-issues.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/search",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ExportIssuesAsJSONLinesWhenTheResponseBodyIsEmpty",
+			jql:                "project = KP",
+			writer:             &bytes.Buffer{},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/search",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IssueSearchService{client: mockClient}
+			gotExported, err := service.ExportJSONLines(testCase.context, testCase.jql, testCase.writer, testCase.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.wantLines, gotExported)
+
+				lines := strings.Split(strings.TrimSpace(testCase.writer.(*bytes.Buffer).String()), "\n")
+				assert.Equal(t, testCase.wantLines, len(lines))
+
+				for _, line := range lines {
+
+					object := make(map[string]interface{})
+					if err := json.Unmarshal([]byte(line), &object); err != nil {
+						t.Fatal(err)
+					}
+
+					for _, key := range testCase.wantKeys {
+						assert.Contains(t, object, key)
+					}
+				}
+			}
+		})
+
+	}
+
+}
@@ -0,0 +1,187 @@
+package v3
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"net/http"
+	"net/url"
+)
+
+type IssueSearchJQLService struct{ client *Client }
+
+// Parse parses and validates the JQL queries, returning the structure of the valid ones and the errors of the others.
+// The validation can be strict, warn or none, Jira uses strict when it's not provided.
+// Use models.ParseJQL to parse a query locally, without the field and value validation.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-jql/#api-rest-api-3-jql-parse-post
+func (j *IssueSearchJQLService) Parse(ctx context.Context, validation string, queries []string) (
+	result *models.ParsedJQLQueriesScheme, response *ResponseScheme, err error) {
+
+	if len(queries) == 0 {
+		return nil, nil, models.ErrNoJQLError
+	}
+
+	payload := struct {
+		Queries []string `json:"queries"`
+	}{
+		Queries: queries,
+	}
+
+	payloadAsReader, _ := transformStructToReader(&payload)
+
+	var endpoint = "rest/api/3/jql/parse"
+	if len(validation) != 0 {
+
+		params := url.Values{}
+		params.Add("validation", validation)
+
+		endpoint = fmt.Sprintf("%v?%v", endpoint, params.Encode())
+	}
+
+	request, err := j.client.newRequest(ctx, http.MethodPost, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = j.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Sanitize sanitizes the JQL queries, replacing the names of the projects and custom fields the user can't see by their IDs.
+// The account ID of each query is optional, the queries are sanitized for the current user when it's not provided.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-jql/#api-rest-api-3-jql-sanitize-post
+func (j *IssueSearchJQLService) Sanitize(ctx context.Context, queries []*models.JQLSanitizeQueryScheme) (
+	result *models.SanitizedJQLQueriesScheme, response *ResponseScheme, err error) {
+
+	if len(queries) == 0 {
+		return nil, nil, models.ErrNoJQLError
+	}
+
+	payload := struct {
+		Queries []*models.JQLSanitizeQueryScheme `json:"queries"`
+	}{
+		Queries: queries,
+	}
+
+	payloadAsReader, _ := transformStructToReader(&payload)
+
+	var endpoint = "rest/api/3/jql/sanitize"
+
+	request, err := j.client.newRequest(ctx, http.MethodPost, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = j.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// ConvertUserIdentifiers converts the usernames and user keys of the JQL queries to account IDs.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-jql/#api-rest-api-3-jql-pdcleaner-post
+func (j *IssueSearchJQLService) ConvertUserIdentifiers(ctx context.Context, queries []string) (
+	result *models.ConvertedJQLQueriesScheme, response *ResponseScheme, err error) {
+
+	if len(queries) == 0 {
+		return nil, nil, models.ErrNoJQLError
+	}
+
+	payload := struct {
+		QueryStrings []string `json:"queryStrings"`
+	}{
+		QueryStrings: queries,
+	}
+
+	payloadAsReader, _ := transformStructToReader(&payload)
+
+	var endpoint = "rest/api/3/jql/pdcleaner"
+
+	request, err := j.client.newRequest(ctx, http.MethodPost, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = j.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// AutocompleteData returns the fields, functions and reserved words available to build JQL queries.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-jql/#api-rest-api-3-jql-autocompletedata-get
+func (j *IssueSearchJQLService) AutocompleteData(ctx context.Context) (result *models.JQLAutocompleteDataScheme,
+	response *ResponseScheme, err error) {
+
+	var endpoint = "rest/api/3/jql/autocompletedata"
+
+	request, err := j.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = j.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// ProjectAutocompleteData returns the fields, functions and reserved words available to build JQL queries on the projects.
+// The collapsed fields, the fields with the same name and type, are returned as a single field when includeCollapsedFields is set.
+// Docs: N/A
+// Official Docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-jql/#api-rest-api-3-jql-autocompletedata-post
+func (j *IssueSearchJQLService) ProjectAutocompleteData(ctx context.Context, projectIDs []int, includeCollapsedFields bool) (
+	result *models.JQLAutocompleteDataScheme, response *ResponseScheme, err error) {
+
+	payload := struct {
+		ProjectIds             []int `json:"projectIds,omitempty"`
+		IncludeCollapsedFields bool  `json:"includeCollapsedFields,omitempty"`
+	}{
+		ProjectIds:             projectIDs,
+		IncludeCollapsedFields: includeCollapsedFields,
+	}
+
+	payloadAsReader, _ := transformStructToReader(&payload)
+
+	var endpoint = "rest/api/3/jql/autocompletedata"
+
+	request, err := j.client.newRequest(ctx, http.MethodPost, endpoint, payloadAsReader)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = j.client.call(request, &result)
+	if err != nil {
+		return
+	}
+
+	return
+}
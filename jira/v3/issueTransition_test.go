@@ -0,0 +1,332 @@
+package v3
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+)
+
+// startMockWorkflowServer starts a server moving the KP-1 issue through the workflow of the mock file.
+// The Approve transition requires the resolution field.
+func startMockWorkflowServer(t *testing.T, statusID string, payloads *[]map[string]interface{}) *httptest.Server {
+
+	mockWorkflows, err := ioutil.ReadFile("./mocks/get-workflow-transitions.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workflows := &models.WorkflowPageScheme{}
+	if err = json.Unmarshal(mockWorkflows, workflows); err != nil {
+		t.Fatal(err)
+	}
+
+	workflow := workflows.Values[0]
+
+	statusName := func(statusID string) string {
+
+		for _, status := range workflow.Statuses {
+			if status.ID == statusID {
+				return status.Name
+			}
+		}
+
+		return ""
+	}
+
+	transitions := func() (available []*models.IssueTransitionScheme) {
+
+		for _, transition := range workflow.Transitions {
+
+			if transition.Type == "initial" || (transition.Type != "global" && transition.From[0] != statusID) {
+				continue
+			}
+
+			issueTransition := &models.IssueTransitionScheme{
+				ID:   transition.ID,
+				Name: transition.Name,
+				To:   &models.StatusScheme{ID: transition.To, Name: statusName(transition.To)},
+			}
+
+			if transition.Name == "Approve" {
+				issueTransition.Fields = map[string]*models.IssueTransitionFieldScheme{
+					"resolution": {Required: true, Name: "Resolution", Key: "resolution"},
+					"comment":    {Required: false, Name: "Comment", Key: "comment"},
+				}
+			}
+
+			available = append(available, issueTransition)
+		}
+
+		return available
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/KP-1/transitions":
+
+			if r.URL.Query().Get("expand") != "transitions.fields" {
+				http.Error(w, "the transitions.fields expand is missing", http.StatusBadRequest)
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(&models.IssueTransitionsScheme{Transitions: transitions()})
+
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/3/issue/KP-1/transitions":
+
+			payload := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			*payloads = append(*payloads, payload)
+			transitionID := payload["transition"].(map[string]interface{})["id"]
+
+			for _, transition := range transitions() {
+
+				if transition.ID == transitionID {
+					statusID = transition.To.ID
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+			}
+
+			http.Error(w, fmt.Sprintf("the transition %v isn't available", transitionID), http.StatusBadRequest)
+
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/KP-1":
+
+			_ = json.NewEncoder(w).Encode(&models.IssueScheme{Key: "KP-1", Fields: &models.IssueFieldsScheme{
+				Status:    &models.StatusScheme{ID: statusID, Name: statusName(statusID)},
+				Project:   &models.ProjectScheme{ID: "10000", Key: "KP"},
+				IssueType: &models.IssueTypeScheme{ID: "10001", Name: "Story"},
+			}})
+
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/workflowscheme/project":
+
+			mockAssociations, _ := ioutil.ReadFile("./mocks/get-workflow-scheme-associations.json")
+			_, _ = w.Write(mockAssociations)
+
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/workflow/search":
+
+			_, _ = w.Write(mockWorkflows)
+
+		default:
+			http.Error(w, fmt.Sprintf("Request: %v %v", r.Method, r.URL), http.StatusNotFound)
+		}
+	}))
+}
+
+func TestIssueService_MoveTo(t *testing.T) {
+
+	testCases := []struct {
+		name            string
+		issueKeyOrID    string
+		status          string
+		statusID        string
+		options         *models.IssueTransitionToOptionsScheme
+		context         context.Context
+		wantTransitions []string
+		wantFields      map[string]interface{}
+		wantMissing     []string
+		wantErr         bool
+	}{
+		{
+			name:            "MoveIssueWhenTheStatusIsReachable",
+			issueKeyOrID:    "KP-1",
+			status:          "in progress",
+			statusID:        "1",
+			context:         context.Background(),
+			wantTransitions: []string{"Start Progress"},
+			wantErr:         false,
+		},
+
+		{
+			name:            "MoveIssueWhenTheTransitionNameIsProvided",
+			issueKeyOrID:    "KP-1",
+			status:          "Request Review",
+			statusID:        "3",
+			context:         context.Background(),
+			wantTransitions: []string{"Request Review"},
+			wantErr:         false,
+		},
+
+		{
+			name:         "MoveIssueWhenTheTransitionScreenFieldsAreProvided",
+			issueKeyOrID: "KP-1",
+			status:       "Done",
+			statusID:     "10002",
+			options: &models.IssueTransitionToOptionsScheme{Fields: map[string]interface{}{
+				"resolution": map[string]interface{}{"name": "Done"},
+				"summary":    "The field isn't on the transition screen",
+			}},
+			context:         context.Background(),
+			wantTransitions: []string{"Approve"},
+			wantFields:      map[string]interface{}{"resolution": map[string]interface{}{"name": "Done"}},
+			wantErr:         false,
+		},
+
+		{
+			name:         "MoveIssueWhenTheRequiredFieldsAreMissing",
+			issueKeyOrID: "KP-1",
+			status:       "Done",
+			statusID:     "10002",
+			context:      context.Background(),
+			wantMissing:  []string{"resolution"},
+			wantErr:      true,
+		},
+
+		{
+			name:         "MoveIssueWhenTheStatusNeedsMultipleHops",
+			issueKeyOrID: "KP-1",
+			status:       "Done",
+			statusID:     "1",
+			options: &models.IssueTransitionToOptionsScheme{
+				MultipleHops: true,
+				Fields:       map[string]interface{}{"resolution": map[string]interface{}{"name": "Done"}},
+			},
+			context:         context.Background(),
+			wantTransitions: []string{"Start Progress", "Request Review", "Approve"},
+			wantErr:         false,
+		},
+
+		{
+			name:         "MoveIssueWhenTheWorkflowNameIsProvided",
+			issueKeyOrID: "KP-1",
+			status:       "In Review",
+			statusID:     "1",
+			options: &models.IssueTransitionToOptionsScheme{
+				MultipleHops: true,
+				WorkflowName: "Software Simplified Workflow",
+			},
+			context:         context.Background(),
+			wantTransitions: []string{"Start Progress", "Request Review"},
+			wantErr:         false,
+		},
+
+		{
+			name:         "MoveIssueWhenTheMultipleHopsFailOnARequiredField",
+			issueKeyOrID: "KP-1",
+			status:       "Done",
+			statusID:     "3",
+			options:      &models.IssueTransitionToOptionsScheme{MultipleHops: true},
+			context:      context.Background(),
+			wantMissing:  []string{"resolution"},
+			wantErr:      true,
+		},
+
+		{
+			name:         "MoveIssueWhenTheMultipleHopsAreNotEnabled",
+			issueKeyOrID: "KP-1",
+			status:       "Done",
+			statusID:     "1",
+			context:      context.Background(),
+			wantErr:      true,
+		},
+
+		{
+			name:         "MoveIssueWhenTheStatusIsNotReachable",
+			issueKeyOrID: "KP-1",
+			status:       "Archived",
+			statusID:     "1",
+			options:      &models.IssueTransitionToOptionsScheme{MultipleHops: true},
+			context:      context.Background(),
+			wantErr:      true,
+		},
+
+		{
+			name:         "MoveIssueWhenTheIssueKeyOrIDIsNotProvided",
+			issueKeyOrID: "",
+			status:       "In Progress",
+			statusID:     "1",
+			context:      context.Background(),
+			wantErr:      true,
+		},
+
+		{
+			name:         "MoveIssueWhenTheStatusIsNotProvided",
+			issueKeyOrID: "KP-1",
+			status:       "",
+			statusID:     "1",
+			context:      context.Background(),
+			wantErr:      true,
+		},
+
+		{
+			name:         "MoveIssueWhenTheContextIsNotProvided",
+			issueKeyOrID: "KP-1",
+			status:       "In Progress",
+			statusID:     "1",
+			context:      nil,
+			wantErr:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var payloads []map[string]interface{}
+
+			mockServer := startMockWorkflowServer(t, testCase.statusID, &payloads)
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotResult, _, err := mockClient.Issue.MoveTo(testCase.context, testCase.issueKeyOrID, testCase.status, testCase.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+
+				if testCase.wantMissing != nil {
+
+					var fieldsError *models.IssueTransitionFieldsError
+					assert.True(t, errors.As(err, &fieldsError))
+
+					var missing []string
+					for fieldID := range fieldsError.Fields {
+						missing = append(missing, fieldID)
+					}
+
+					sort.Strings(missing)
+					assert.Equal(t, testCase.wantMissing, missing)
+				}
+
+			} else {
+
+				assert.NoError(t, err)
+
+				var gotTransitions []string
+				for _, transition := range gotResult {
+					gotTransitions = append(gotTransitions, transition.Name)
+				}
+
+				assert.Equal(t, testCase.wantTransitions, gotTransitions)
+				assert.Equal(t, len(testCase.wantTransitions), len(payloads))
+
+				if testCase.wantFields != nil {
+					assert.Equal(t, testCase.wantFields, payloads[len(payloads)-1]["fields"])
+				}
+			}
+		})
+
+	}
+
+}
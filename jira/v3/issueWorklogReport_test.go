@@ -0,0 +1,337 @@
+package v3
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// mockWorklogReportRequests records the requests of the mock worklog report server.
+type mockWorklogReportRequests struct {
+	mutex        sync.Mutex
+	jql          []string
+	startedAfter []string
+	worklogIDs   [][]int
+}
+
+// startMockWorklogReportServer starts a server searching the KP-1 and KP-2 issues and the KP-3 subtask, with their
+// worklogs. The parent of the subtask, KP-4, is only returned when it's searched by key. The worklogs updated are
+// returned in two pages, including a worklog of an issue not searched and a worklog started on the RFC3339 layout.
+func startMockWorklogReportServer(requests *mockWorklogReportRequests) *httptest.Server {
+
+	worklog := func(id, issueID, accountID, started string, seconds int) *models.IssueWorklogScheme {
+		return &models.IssueWorklogScheme{ID: id, IssueID: issueID, Started: started, TimeSpentSeconds: seconds,
+			Author: &models.UserDetailScheme{AccountID: accountID, DisplayName: "User " + accountID}}
+	}
+
+	worklogs := []*models.IssueWorklogScheme{
+		worklog("1", "10001", "alice", "2022-01-03T23:30:00.000+0000", 3600),
+		worklog("2", "10001", "bob", "2022-01-04T09:00:00.000+0000", 1800),
+		worklog("3", "10002", "alice", "2022-01-04T10:00:00.000+0000", 7200),
+		worklog("4", "10002", "alice", "2022-02-01T10:00:00.000+0000", 600),
+		worklog("5", "99999", "alice", "2022-01-05T10:00:00.000+0000", 900),
+		worklog("6", "10003", "bob", "2022-01-05T08:00:00Z", 900),
+	}
+
+	issueIDs := map[string]string{"KP-1": "10001", "KP-2": "10002", "KP-3": "10003"}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		requests.mutex.Lock()
+		defer requests.mutex.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/3/search":
+
+			search := struct {
+				JQL string `json:"jql"`
+			}{}
+
+			_ = json.NewDecoder(r.Body).Decode(&search)
+			requests.jql = append(requests.jql, search.JQL)
+
+			if search.JQL == `key in ("KP-4")` {
+				_, _ = fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":1,"issues":[
+					{"id":"10004","key":"KP-4","fields":{"summary":"Sessions",
+						"parent":{"key":"KP-10","fields":{"issuetype":{"name":"Epic","hierarchyLevel":1}}}}}]}`)
+				return
+			}
+
+			_, _ = fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":3,"issues":[
+				{"id":"10001","key":"KP-1","fields":{"summary":"Login page","components":[{"name":"Backend"},{"name":"API"}],
+					"parent":{"key":"KP-10","fields":{"issuetype":{"name":"Epic","hierarchyLevel":1}}}}},
+				{"id":"10002","key":"KP-2","fields":{"summary":"Logout page","customfield_10014":"KP-20"}},
+				{"id":"10003","key":"KP-3","fields":{"summary":"Session cleanup","issuetype":{"name":"Sub-task","subtask":true},
+					"parent":{"key":"KP-4","fields":{"issuetype":{"name":"Story"}}}}}]}`)
+
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/rest/api/3/issue/KP-"):
+
+			requests.startedAfter = append(requests.startedAfter, r.URL.Query().Get("startedAfter"))
+
+			issueID := issueIDs[strings.Split(r.URL.Path, "/")[5]]
+
+			page := &models.IssueWorklogPageScheme{MaxResults: 1000}
+			for _, worklog := range worklogs {
+
+				if worklog.IssueID == issueID {
+					page.Worklogs = append(page.Worklogs, worklog)
+				}
+			}
+
+			page.Total = len(page.Worklogs)
+			_ = json.NewEncoder(w).Encode(page)
+
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/worklog/updated":
+
+			if r.URL.Query().Get("since") == "100" {
+				_, _ = fmt.Fprint(w, `{"since":100,"until":200,"lastPage":true,
+					"values":[{"worklogId":3},{"worklogId":4},{"worklogId":5},{"worklogId":6}]}`)
+				return
+			}
+
+			_, _ = fmt.Fprint(w, `{"since":1,"until":100,"lastPage":false,"values":[{"worklogId":1},{"worklogId":2}]}`)
+
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/3/worklog/list":
+
+			payload := struct {
+				Ids []int `json:"ids"`
+			}{}
+
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			requests.worklogIDs = append(requests.worklogIDs, payload.Ids)
+
+			var result []*models.IssueWorklogScheme
+			for _, id := range payload.Ids {
+				result = append(result, worklogs[id-1])
+			}
+
+			_ = json.NewEncoder(w).Encode(result)
+
+		default:
+			http.Error(w, fmt.Sprintf("Request: %v %v", r.Method, r.URL), http.StatusNotFound)
+		}
+	}))
+}
+
+func TestIssueWorklogService_Report(t *testing.T) {
+
+	// The range is the January of the location, the midnights aren't the UTC ones
+	madrid := time.FixedZone("CET", 3600)
+	from, to := time.Date(2022, 1, 1, 0, 0, 0, 0, madrid), time.Date(2022, 2, 1, 0, 0, 0, 0, madrid)
+
+	testCases := []struct {
+		name             string
+		options          *models.WorklogReportOptionsScheme
+		dimensions       []string
+		wantCSV          string
+		wantJQL          string
+		wantStartedAfter []string
+		wantWorklogIDs   [][]int
+		wantErr          bool
+	}{
+		{
+			name:       "ReportWhenTheWorklogsAreAggregatedByUserAndDay",
+			options:    &models.WorklogReportOptionsScheme{ProjectKey: "KP", From: from, To: to},
+			dimensions: []string{models.WorklogReportByUser, models.WorklogReportByDay},
+			wantCSV: "Account ID,User,Day,Worklogs,Time Spent (s),Hours\n" +
+				"alice,User alice,2022-01-03,1,3600,1.00\n" +
+				"alice,User alice,2022-01-04,1,7200,2.00\n" +
+				"bob,User bob,2022-01-04,1,1800,0.50\n" +
+				"bob,User bob,2022-01-05,1,900,0.25\n",
+			wantJQL:          `project = "KP"`,
+			wantStartedAfter: []string{"1640991600000", "1640991600000", "1640991600000"},
+			wantErr:          false,
+		},
+
+		{
+			name:       "ReportWhenTheDaysAreOnTheLocation",
+			options:    &models.WorklogReportOptionsScheme{ProjectKey: "KP", From: from, To: to, Location: madrid},
+			dimensions: []string{models.WorklogReportByDay},
+			wantCSV: "Day,Worklogs,Time Spent (s),Hours\n" +
+				"2022-01-04,3,12600,3.50\n" +
+				"2022-01-05,1,900,0.25\n",
+			wantErr: false,
+		},
+
+		{
+			name:       "ReportWhenTheWorklogsAreAggregatedByIssueEpicAndComponent",
+			options:    &models.WorklogReportOptionsScheme{JQL: "sprint in openSprints()", From: from, To: to, EpicLinkField: "customfield_10014"},
+			dimensions: []string{models.WorklogReportByEpic, models.WorklogReportByIssue, models.WorklogReportByComponent},
+			wantCSV: "Epic,Issue,Summary,Component,Worklogs,Time Spent (s),Hours\n" +
+				"KP-10,KP-1,Login page,API,2,5400,1.50\n" +
+				"KP-10,KP-1,Login page,Backend,2,5400,1.50\n" +
+				"KP-10,KP-3,Session cleanup,,1,900,0.25\n" +
+				"KP-20,KP-2,Logout page,,1,7200,2.00\n",
+			wantJQL: "sprint in openSprints()",
+			wantErr: false,
+		},
+
+		{
+			name: "ReportWhenTheWorklogsAreUpdatedSinceATime",
+			options: &models.WorklogReportOptionsScheme{ProjectKey: "KP", From: from, To: to,
+				UpdatedSince: time.Unix(0, int64(time.Millisecond))},
+			dimensions: []string{models.WorklogReportByIssue},
+			wantCSV: "Issue,Summary,Worklogs,Time Spent (s),Hours\n" +
+				"KP-1,Login page,2,5400,1.50\n" +
+				"KP-2,Logout page,1,7200,2.00\n" +
+				"KP-3,Session cleanup,1,900,0.25\n",
+			wantWorklogIDs: [][]int{{1, 2, 3, 4, 5, 6}},
+			wantErr:        false,
+		},
+
+		{
+			name:       "ReportWhenTheUsersAreFiltered",
+			options:    &models.WorklogReportOptionsScheme{ProjectKey: "KP", From: from, To: to, AccountIDs: []string{"bob"}},
+			dimensions: []string{models.WorklogReportByUser},
+			wantCSV: "Account ID,User,Worklogs,Time Spent (s),Hours\n" +
+				"bob,User bob,2,2700,0.75\n",
+			wantErr: false,
+		},
+
+		{
+			name:       "ReportWhenTheProjectKeyIsQuoted",
+			options:    &models.WorklogReportOptionsScheme{ProjectKey: `K"P\`, JQL: "status = Done", From: from, To: to},
+			dimensions: []string{models.WorklogReportByDay},
+			wantCSV: "Day,Worklogs,Time Spent (s),Hours\n" +
+				"2022-01-03,1,3600,1.00\n" +
+				"2022-01-04,2,9000,2.50\n" +
+				"2022-01-05,1,900,0.25\n",
+			wantJQL: `project = "K\"P\\" AND (status = Done)`,
+			wantErr: false,
+		},
+
+		{
+			name:       "ReportWhenTheDimensionIsUnknown",
+			options:    &models.WorklogReportOptionsScheme{ProjectKey: "KP", From: from, To: to},
+			dimensions: []string{"priority"},
+			wantErr:    true,
+		},
+
+		{
+			name:    "ReportWhenTheRangeIsNotProvided",
+			options: &models.WorklogReportOptionsScheme{ProjectKey: "KP", From: from},
+			wantErr: true,
+		},
+
+		{
+			name:    "ReportWhenTheIssuesAreNotProvided",
+			options: &models.WorklogReportOptionsScheme{From: from, To: to},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			requests := &mockWorklogReportRequests{}
+
+			mockServer := startMockWorklogReportServer(requests)
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buffer bytes.Buffer
+
+			gotReport, err := mockClient.Issue.Worklog.Report(context.Background(), testCase.options)
+			if err == nil {
+				err = gotReport.WriteCSV(&buffer, testCase.dimensions...)
+			}
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantCSV, buffer.String())
+
+			// The first search is the search of the issues, the parents of the subtasks are searched by key
+			if testCase.wantJQL != "" {
+				assert.Equal(t, testCase.wantJQL, requests.jql[0])
+			}
+
+			if testCase.wantStartedAfter != nil {
+				assert.Equal(t, testCase.wantStartedAfter, requests.startedAfter)
+			}
+
+			if testCase.wantWorklogIDs != nil {
+				assert.Empty(t, requests.startedAfter)
+				assert.Equal(t, testCase.wantWorklogIDs, requests.worklogIDs)
+			}
+		})
+	}
+}
+
+func TestWorklogReportScheme_WriteJSON(t *testing.T) {
+
+	requests := &mockWorklogReportRequests{}
+
+	mockServer := startMockWorklogReportServer(requests)
+	defer mockServer.Close()
+
+	//Init the library instance
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	madrid := time.FixedZone("CET", 3600)
+
+	report, err := mockClient.Issue.Worklog.Report(context.Background(), &models.WorklogReportOptionsScheme{
+		ProjectKey: "KP",
+		From:       time.Date(2022, 1, 1, 0, 0, 0, 0, madrid),
+		To:         time.Date(2022, 2, 1, 0, 0, 0, 0, madrid),
+		Location:   madrid,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buffer bytes.Buffer
+	if err = report.WriteJSON(&buffer, models.WorklogReportByUser); err != nil {
+		t.Fatal(err)
+	}
+
+	got := struct {
+		From             string                   `json:"from"`
+		Timezone         string                   `json:"timezone"`
+		Dimensions       []string                 `json:"dimensions"`
+		Rows             []map[string]interface{} `json:"rows"`
+		TimeSpentSeconds int                      `json:"timeSpentSeconds"`
+	}{}
+
+	if err = json.Unmarshal(buffer.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "2022-01-01T00:00:00+01:00", got.From)
+	assert.Equal(t, "CET", got.Timezone)
+	assert.Equal(t, []string{"user"}, got.Dimensions)
+	assert.Equal(t, 13500, got.TimeSpentSeconds)
+	assert.Equal(t, []map[string]interface{}{
+		{"accountId": "alice", "displayName": "User alice", "worklogs": float64(2), "timeSpentSeconds": float64(10800), "hours": float64(3)},
+		{"accountId": "bob", "displayName": "User bob", "worklogs": float64(2), "timeSpentSeconds": float64(2700), "hours": 0.75},
+	}, got.Rows)
+
+	assert.Equal(t, 4, len(report.Entries))
+}
@@ -37,58 +37,58 @@ func extractEndpotintToAssert(endpoint string) (string, error) {
 }
 
 func startMockServer(opts *mockServerOptions) (*httptest.Server, error) {
+	return httptest.NewServer(mockServerHandler(opts)), nil
+}
 
-	mockServer := httptest.NewServer(
-
-		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+// mockServerHandler returns the handler of the mock servers, it only accepts the method and the endpoint of the options.
+func mockServerHandler(opts *mockServerOptions) http.HandlerFunc {
 
-			if r.Method != opts.MethodAccepted {
-				http.Error(w, fmt.Sprintf("Request method: %v, want %v", r.Method, opts.MethodAccepted), http.StatusMethodNotAllowed)
-				return
-			}
+	return func(w http.ResponseWriter, r *http.Request) {
 
-			if r.URL.Query().Encode() != "" {
+		if r.Method != opts.MethodAccepted {
+			http.Error(w, fmt.Sprintf("Request method: %v, want %v", r.Method, opts.MethodAccepted), http.StatusMethodNotAllowed)
+			return
+		}
 
-				var pathWithQueries = fmt.Sprintf("%v?%v", r.URL.Path, r.URL.Query().Encode())
+		if r.URL.Query().Encode() != "" {
 
-				if pathWithQueries != opts.Endpoint {
-					http.Error(w, fmt.Sprintf("Request URL: %v, want %v", r.URL.Path, opts.Endpoint), 400)
-					return
-				}
+			var pathWithQueries = fmt.Sprintf("%v?%v", r.URL.Path, r.URL.Query().Encode())
 
-			} else {
-				if r.URL.Path != opts.Endpoint {
-					http.Error(w, fmt.Sprintf("Request URL: %v, want %v", r.URL.Path, opts.Endpoint), 400)
-					return
-				}
+			if pathWithQueries != opts.Endpoint {
+				http.Error(w, fmt.Sprintf("Request URL: %v, want %v", r.URL.Path, opts.Endpoint), 400)
+				return
 			}
 
-			//Append the custom headers
-			for key, value := range opts.Headers {
-				w.Header().Add(key, value)
+		} else {
+			if r.URL.Path != opts.Endpoint {
+				http.Error(w, fmt.Sprintf("Request URL: %v, want %v", r.URL.Path, opts.Endpoint), 400)
+				return
 			}
+		}
 
-			//Append the Method
-			w.WriteHeader(opts.ResponseCodeWanted)
+		//Append the custom headers
+		for key, value := range opts.Headers {
+			w.Header().Add(key, value)
+		}
 
-			//Append the JSON Mock file if it's provided
-			if len(opts.MockFilePath) != 0 {
-				mockResponse, err := ioutil.ReadFile(opts.MockFilePath)
-				if err != nil {
-					http.Error(w, err.Error(), 500)
-					return
-				}
-				_, err = w.Write(mockResponse)
-				if err != nil {
-					http.Error(w, err.Error(), 500)
-					return
-				}
-			}
+		//Append the Method
+		w.WriteHeader(opts.ResponseCodeWanted)
 
-		}),
-	)
+		//Append the JSON Mock file if it's provided
+		if len(opts.MockFilePath) != 0 {
+			mockResponse, err := ioutil.ReadFile(opts.MockFilePath)
+			if err != nil {
+				http.Error(w, err.Error(), 500)
+				return
+			}
+			_, err = w.Write(mockResponse)
+			if err != nil {
+				http.Error(w, err.Error(), 500)
+				return
+			}
+		}
 
-	return mockServer, nil
+	}
 }
 
 func startMockClient(instance string) (*Client, error) {
@@ -431,3 +431,87 @@ func Test_transformTheHTTPResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_call(t *testing.T) {
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if r.URL.Path == "/reset" {
+
+			// The connection is closed without response
+			connection, _, err := w.(http.Hijacker).Hijack()
+			if err == nil {
+				_ = connection.Close()
+			}
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"key":"KP-1"}`))
+	}))
+	defer mockServer.Close()
+
+	closedServer := httptest.NewServer(http.NotFoundHandler())
+	closedServer.Close()
+
+	testCases := []struct {
+		name     string
+		endpoint string
+		wantCode int
+		wantErr  bool
+	}{
+		{
+			name:     "CallWhenTheResponseIsReturned",
+			endpoint: mockServer.URL + "/issue",
+			wantCode: http.StatusOK,
+		},
+
+		{
+			name:     "CallWhenTheConnectionIsReset",
+			endpoint: mockServer.URL + "/reset",
+			wantErr:  true,
+		},
+
+		{
+			name:     "CallWhenTheServerIsNotReachable",
+			endpoint: closedServer.URL + "/issue",
+			wantErr:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, testCase.endpoint, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			structure := make(map[string]interface{})
+			gotResponse, err := mockClient.call(request, &structure)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				// The transport error is returned instead of the missing response error
+				_, isTransportError := err.(*url.Error)
+				assert.True(t, isTransportError)
+				assert.Nil(t, gotResponse)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantCode, gotResponse.Code)
+			assert.Equal(t, "KP-1", structure["key"])
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"net/http"
+	"time"
 )
 
 type TaskService struct{ client *Client }
@@ -64,6 +65,26 @@ func (t *TaskService) Cancel(ctx context.Context, taskID string) (response *Resp
 	return
 }
 
+// Wait polls the status of a task with the Get method until the task is done, waiting the interval between requests.
+// It returns the last status of the task, check the status to know if the task was completed, failed or cancelled.
+// Docs: N/A
+func (t *TaskService) Wait(ctx context.Context, taskID string, interval time.Duration) (result *models.TaskScheme, err error) {
+
+	for {
+
+		result, _, err = t.Get(ctx, taskID)
+		if err != nil || result == nil || result.Done() {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
 var (
 	notTaskIDError = fmt.Errorf("error, please provide a valid taskID value")
 )
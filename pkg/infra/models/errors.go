@@ -55,6 +55,7 @@ var (
 	ErrNoAttachmentNameError               = errors.New("jira: no attachment filename set")
 	ErrNoReaderError                       = errors.New("jira: no reader set")
 	ErrNoCommentIDError                    = errors.New("jira: no comment id set")
+	ErrNoCommentIDsError                   = errors.New("jira: no comment id's set")
 	ErrNoProjectIDError                    = errors.New("jira: no project id set")
 	ErrNoPropertyKeyError                  = errors.New("jira: no property key set")
 	ErrNoProjectFeatureKeyError            = errors.New("jira: no project feature key set")
@@ -92,4 +93,26 @@ var (
 	ErrNoFieldConfigurationIDError         = errors.New("jira: no field configuration id set")
 	ErrNoFieldConfigurationSchemeNameError = errors.New("jira: no field configuration scheme name set")
 	ErrNoFieldConfigurationSchemeIDError   = errors.New("jira: no field configuration scheme id set")
+	ErrNoIssueStructError                  = errors.New("jira: the issue value must be a struct or a pointer to a struct")
+	ErrNoIssueTargetError                  = errors.New("jira: the issue target must be a non-nil pointer to a struct")
+	ErrNoIssueFieldsError                  = errors.New("jira: the issue does not contain fields")
+	ErrInvalidIssueTagError                = errors.New("jira: invalid jira struct tag")
+	ErrNoChangelogIDsError                 = errors.New("jira: no changelog id's set")
+	ErrNoIssueKeysOrIDsError               = errors.New("jira: no issue key/id's set")
+	ErrNoWriterError                       = errors.New("jira: no writer set")
+	ErrNoIssueImportMappingError           = errors.New("jira: no import mapping set")
+	ErrNoAttachmentFilesError              = errors.New("jira: no attachment files set")
+	ErrAttachmentsDisabledError            = errors.New("jira: the attachments are disabled")
+	ErrAttachmentTooLargeError             = errors.New("jira: the attachment exceeds the upload limit")
+	ErrNoRemoteLinkIDError                 = errors.New("jira: no remote link id set")
+	ErrNoRemoteLinkGlobalIDError           = errors.New("jira: no remote link global id set")
+	ErrNoStatusNameError                   = errors.New("jira: no status name set")
+	ErrNoTransitionPathError               = errors.New("jira: no transition path to the status")
+	ErrNoIssueBulkOperationError           = errors.New("jira: no bulk operation set")
+	ErrNoProjectOrIssueTypeError           = errors.New("jira: no project or issue type set on the payload")
+	ErrNoUpdatedSnapshotError              = errors.New("jira: no updated timestamp set on the snapshot")
+	ErrNoIssuesError                       = errors.New("jira: no issues set")
+	ErrNoWorklogReportRangeError           = errors.New("jira: no worklog report range set")
+	ErrNoWorklogReportDimensionError       = errors.New("jira: unknown worklog report dimension")
+	ErrNoContextError                      = errors.New("jira: no context set")
 )
@@ -34,3 +34,30 @@ type IssueChangelogHistoryItemScheme struct {
 	To         string `json:"to,omitempty"`
 	ToString   string `json:"toString,omitempty"`
 }
+
+type IssueChangelogPageScheme struct {
+	Self       string                         `json:"self,omitempty"`
+	NextPage   string                         `json:"nextPage,omitempty"`
+	MaxResults int                            `json:"maxResults,omitempty"`
+	StartAt    int                            `json:"startAt,omitempty"`
+	Total      int                            `json:"total,omitempty"`
+	IsLast     bool                           `json:"isLast,omitempty"`
+	Values     []*IssueChangelogHistoryScheme `json:"values,omitempty"`
+}
+
+type IssueChangelogBulkOptionsScheme struct {
+	IssueIDsOrKeys []string `json:"issueIdsOrKeys,omitempty"`
+	FieldIDs       []string `json:"fieldIds,omitempty"`
+	MaxResults     int      `json:"maxResults,omitempty"`
+	NextPageToken  string   `json:"nextPageToken,omitempty"`
+}
+
+type IssueChangelogBulkPageScheme struct {
+	IssueChangeLogs []*IssueChangelogBulkScheme `json:"issueChangeLogs,omitempty"`
+	NextPageToken   string                      `json:"nextPageToken,omitempty"`
+}
+
+type IssueChangelogBulkScheme struct {
+	IssueID         string                         `json:"issueId,omitempty"`
+	ChangeHistories []*IssueChangelogHistoryScheme `json:"changeHistories,omitempty"`
+}
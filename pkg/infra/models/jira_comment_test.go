@@ -0,0 +1,60 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCommentPayloadScheme_MarshalJSON(t *testing.T) {
+
+	var (
+		visibility = &CommentVisibilityScheme{Type: "role", Value: "Administrators"}
+		document   = &CommentNodeScheme{Version: 1, Type: ADFNodeDoc, Content: []*CommentNodeScheme{ADFParagraph(ADFText("Hi"))}}
+	)
+
+	testCases := []struct {
+		name     string
+		payload  interface{}
+		wantJSON string
+	}{
+		{
+			name:     "MarshalCommentWhenTheVisibilityIsSet",
+			payload:  &CommentPayloadScheme{Visibility: visibility, Body: document},
+			wantJSON: `{"visibility":{"type":"role","value":"Administrators"},"body":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"Hi"}]}]}}`,
+		},
+
+		{
+			name:     "MarshalCommentWhenTheVisibilityIsNotSet",
+			payload:  &CommentPayloadScheme{Body: document},
+			wantJSON: `{"body":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"Hi"}]}]}}`,
+		},
+
+		{
+			name:     "MarshalCommentWhenTheVisibilityIsCleared",
+			payload:  &CommentPayloadScheme{Visibility: visibility, ClearVisibility: true},
+			wantJSON: `{"visibility":null}`,
+		},
+
+		{
+			name:     "MarshalCommentV2WhenTheVisibilityIsNotSet",
+			payload:  &CommentPayloadSchemeV2{Body: "Hi"},
+			wantJSON: `{"body":"Hi"}`,
+		},
+
+		{
+			name:     "MarshalCommentV2WhenTheVisibilityIsCleared",
+			payload:  &CommentPayloadSchemeV2{Body: "Hi", ClearVisibility: true},
+			wantJSON: `{"visibility":null,"body":"Hi"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			gotJSON, err := json.Marshal(testCase.payload)
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.wantJSON, string(gotJSON))
+		})
+	}
+}
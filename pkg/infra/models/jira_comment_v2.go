@@ -1,5 +1,7 @@
 package models
 
+import "encoding/json"
+
 type IssueCommentPageSchemeV2 struct {
 	StartAt    int                     `json:"startAt,omitempty"`
 	MaxResults int                     `json:"maxResults,omitempty"`
@@ -7,6 +9,14 @@ type IssueCommentPageSchemeV2 struct {
 	Comments   []*IssueCommentSchemeV2 `json:"comments,omitempty"`
 }
 
+type IssueCommentListSchemeV2 struct {
+	StartAt    int                     `json:"startAt,omitempty"`
+	MaxResults int                     `json:"maxResults,omitempty"`
+	Total      int                     `json:"total,omitempty"`
+	IsLast     bool                    `json:"isLast,omitempty"`
+	Values     []*IssueCommentSchemeV2 `json:"values,omitempty"`
+}
+
 type IssueCommentSchemeV2 struct {
 	Self         string                   `json:"self,omitempty"`
 	ID           string                   `json:"id,omitempty"`
@@ -23,4 +33,22 @@ type IssueCommentSchemeV2 struct {
 type CommentPayloadSchemeV2 struct {
 	Visibility *CommentVisibilityScheme `json:"visibility,omitempty"`
 	Body       string                   `json:"body,omitempty"`
+
+	// ClearVisibility sends a null visibility, so an updated comment is visible to everyone again.
+	// The nil Visibility is omitted, and the comment keeps its visibility.
+	ClearVisibility bool `json:"-"`
+}
+
+// MarshalJSON encodes the payload, the visibility is null when ClearVisibility is set.
+func (c *CommentPayloadSchemeV2) MarshalJSON() ([]byte, error) {
+
+	type alias CommentPayloadSchemeV2
+	if !c.ClearVisibility {
+		return json.Marshal((*alias)(c))
+	}
+
+	return json.Marshal(&struct {
+		*alias
+		Visibility *CommentVisibilityScheme `json:"visibility"`
+	}{alias: (*alias)(c)})
 }
@@ -1,5 +1,7 @@
 package models
 
+import "encoding/json"
+
 type CommentNodeScheme struct {
 	Version int                    `json:"version,omitempty"`
 	Type    string                 `json:"type,omitempty"`
@@ -13,6 +15,20 @@ func (n *CommentNodeScheme) AppendNode(node *CommentNodeScheme) {
 	n.Content = append(n.Content, node)
 }
 
+// MarshalJSON encodes the node, the doc node always has the content array, as the document schema requires it.
+func (n *CommentNodeScheme) MarshalJSON() ([]byte, error) {
+
+	type alias CommentNodeScheme
+	if n.Type != ADFNodeDoc || len(n.Content) != 0 {
+		return json.Marshal((*alias)(n))
+	}
+
+	return json.Marshal(&struct {
+		*alias
+		Content []*CommentNodeScheme `json:"content"`
+	}{alias: (*alias)(n), Content: []*CommentNodeScheme{}})
+}
+
 type MarkScheme struct {
 	Type  string                 `json:"type,omitempty"`
 	Attrs map[string]interface{} `json:"attrs,omitempty"`
@@ -21,6 +37,24 @@ type MarkScheme struct {
 type CommentPayloadScheme struct {
 	Visibility *CommentVisibilityScheme `json:"visibility,omitempty"`
 	Body       *CommentNodeScheme       `json:"body,omitempty"`
+
+	// ClearVisibility sends a null visibility, so an updated comment is visible to everyone again.
+	// The nil Visibility is omitted, and the comment keeps its visibility.
+	ClearVisibility bool `json:"-"`
+}
+
+// MarshalJSON encodes the payload, the visibility is null when ClearVisibility is set.
+func (c *CommentPayloadScheme) MarshalJSON() ([]byte, error) {
+
+	type alias CommentPayloadScheme
+	if !c.ClearVisibility {
+		return json.Marshal((*alias)(c))
+	}
+
+	return json.Marshal(&struct {
+		*alias
+		Visibility *CommentVisibilityScheme `json:"visibility"`
+	}{alias: (*alias)(c)})
 }
 
 type IssueCommentPageScheme struct {
@@ -30,6 +64,14 @@ type IssueCommentPageScheme struct {
 	Comments   []*IssueCommentScheme `json:"comments,omitempty"`
 }
 
+type IssueCommentListScheme struct {
+	StartAt    int                   `json:"startAt,omitempty"`
+	MaxResults int                   `json:"maxResults,omitempty"`
+	Total      int                   `json:"total,omitempty"`
+	IsLast     bool                  `json:"isLast,omitempty"`
+	Values     []*IssueCommentScheme `json:"values,omitempty"`
+}
+
 type IssueCommentScheme struct {
 	Self         string                   `json:"self,omitempty"`
 	ID           string                   `json:"id,omitempty"`
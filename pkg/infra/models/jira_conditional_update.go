@@ -0,0 +1,31 @@
+package models
+
+import "fmt"
+
+// ConditionalUpdateOptionsScheme customizes the conditional updates, e.g. the IssueService.UpdateIfUnchanged method.
+// The resource is read again right before it's written, and the write is aborted with an UpdateConflictError when
+// the resource has been modified since the snapshot of the caller. Jira doesn't lock the resource between the read
+// and the write, the guard narrows the window of the lost updates without closing it.
+type ConditionalUpdateOptionsScheme struct {
+
+	// Merges is the number of times the merge function is called with the modified resource before the conflict
+	// is returned, 3 by default. The merged payload is written when the resource isn't modified again.
+	Merges int
+}
+
+// UpdateConflictError is returned by the conditional updates when the resource has been modified since the snapshot.
+type UpdateConflictError struct {
+
+	// Resource describes the resource modified, e.g. "issue KP-1".
+	Resource string
+
+	// Snapshot and Current are the version of the snapshot and the current version of the resource, e.g. the updated
+	// timestamp of an issue or the value of a property.
+	Snapshot string
+	Current  string
+}
+
+func (e *UpdateConflictError) Error() string {
+	return fmt.Sprintf("jira: the %v has been modified, the snapshot version is %v and the current version is %v",
+		e.Resource, e.Snapshot, e.Current)
+}
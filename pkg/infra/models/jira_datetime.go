@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+const (
+	DateFormatJira    = "2006-01-02T15:04:05.999-0700"
+	DateFormatJiraDay = "2006-01-02"
+)
+
+// ParseDateTime parses a date-time of the Jira layout, e.g. the created and updated fields of the issues.
+// The empty value is the zero time.
+func ParseDateTime(value string) (time.Time, error) {
+
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	parsed, err := time.Parse(DateFormatJira, value)
+	if err != nil {
+
+		// Some endpoints return the RFC3339 layout instead
+		if parsed, err = time.Parse(time.RFC3339Nano, value); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return parsed, nil
+}
+
+// ParseDate parses a date without the time, e.g. the due date of the issues. The empty value is the zero time.
+func ParseDate(value string) (time.Time, error) {
+
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	parsed, err := time.Parse(DateFormatJiraDay, value)
+	if err != nil {
+
+		// The service management endpoints return the due dates with the time
+		if parsed, err = time.Parse(DateFormatJira, value); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return parsed, nil
+}
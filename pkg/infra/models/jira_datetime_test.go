@@ -0,0 +1,160 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseDateTime(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:  "ParseDateTimeWhenTheLayoutIsJira",
+			value: "2022-01-07T10:09:09.123+0100",
+			want:  time.Date(2022, 1, 7, 9, 9, 9, 123000000, time.UTC),
+		},
+
+		{
+			name:  "ParseDateTimeWhenTheLayoutIsRFC3339",
+			value: "2022-01-07T10:09:09.123Z",
+			want:  time.Date(2022, 1, 7, 10, 9, 9, 123000000, time.UTC),
+		},
+
+		{
+			name:  "ParseDateTimeWhenTheValueIsEmpty",
+			value: "",
+			want:  time.Time{},
+		},
+
+		{
+			name:    "ParseDateTimeWhenTheValueIsADate",
+			value:   "2022-01-07",
+			wantErr: true,
+		},
+
+		{
+			name:    "ParseDateTimeWhenTheValueIsMalformed",
+			value:   "yesterday",
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := ParseDateTime(testCase.value)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, testCase.want.Equal(got), "got %v", got)
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:  "ParseDateWhenTheValueIsADay",
+			value: "2022-01-07",
+			want:  time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC),
+		},
+
+		{
+			name:  "ParseDateWhenTheValueHasTheTime",
+			value: "2022-01-07T00:00:00.000+0000",
+			want:  time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC),
+		},
+
+		{
+			name:  "ParseDateWhenTheValueIsEmpty",
+			value: "",
+			want:  time.Time{},
+		},
+
+		{
+			name:    "ParseDateWhenTheValueIsMalformed",
+			value:   "07/01/2022",
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := ParseDate(testCase.value)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, testCase.want.Equal(got), "got %v", got)
+		})
+	}
+}
+
+func TestIssueFieldsScheme_Times(t *testing.T) {
+
+	data := `{"created":"2022-01-07T10:09:09.000+0000","updated":"2022-01-08T11:00:00.000+0100",
+		"resolutiondate":"2022-01-09T12:00:00.000+0000","duedate":"2022-01-10"}`
+
+	fields := &IssueFieldsScheme{}
+	assert.NoError(t, json.Unmarshal([]byte(data), fields))
+
+	// The string fields are kept as returned by Jira
+	assert.Equal(t, "2022-01-08T11:00:00.000+0100", fields.Updated)
+	assert.Equal(t, "2022-01-10", fields.DueDate)
+
+	created, err := fields.CreatedTime()
+	assert.NoError(t, err)
+	assert.True(t, created.Equal(time.Date(2022, 1, 7, 10, 9, 9, 0, time.UTC)))
+
+	updated, err := fields.UpdatedTime()
+	assert.NoError(t, err)
+	assert.True(t, updated.Equal(time.Date(2022, 1, 8, 10, 0, 0, 0, time.UTC)))
+
+	resolved, err := fields.ResolutionDateTime()
+	assert.NoError(t, err)
+	assert.True(t, resolved.Equal(time.Date(2022, 1, 9, 12, 0, 0, 0, time.UTC)))
+
+	dueDate, err := fields.DueDateTime()
+	assert.NoError(t, err)
+	assert.True(t, dueDate.Equal(time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC)))
+
+	// The fields not set are the zero time
+	lastViewed, err := fields.LastViewedTime()
+	assert.NoError(t, err)
+	assert.True(t, lastViewed.IsZero())
+
+	fields.StatusCategoryChangeDate = "yesterday"
+	_, err = fields.StatusCategoryChangeTime()
+	assert.Error(t, err)
+}
@@ -0,0 +1,771 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The Atlassian Document Format node types.
+// Docs: https://developer.atlassian.com/cloud/jira/platform/apis/document/structure/
+const (
+	ADFNodeDoc          = "doc"
+	ADFNodeParagraph    = "paragraph"
+	ADFNodeHeading      = "heading"
+	ADFNodeBulletList   = "bulletList"
+	ADFNodeOrderedList  = "orderedList"
+	ADFNodeListItem     = "listItem"
+	ADFNodeCodeBlock    = "codeBlock"
+	ADFNodeBlockquote   = "blockquote"
+	ADFNodePanel        = "panel"
+	ADFNodeRule         = "rule"
+	ADFNodeTable        = "table"
+	ADFNodeTableRow     = "tableRow"
+	ADFNodeTableHeader  = "tableHeader"
+	ADFNodeTableCell    = "tableCell"
+	ADFNodeMediaSingle  = "mediaSingle"
+	ADFNodeMediaGroup   = "mediaGroup"
+	ADFNodeMedia        = "media"
+	ADFNodeExpand       = "expand"
+	ADFNodeNestedExpand = "nestedExpand"
+	ADFNodeTaskList     = "taskList"
+	ADFNodeTaskItem     = "taskItem"
+	ADFNodeDecisionList = "decisionList"
+	ADFNodeDecisionItem = "decisionItem"
+	ADFNodeBlockCard    = "blockCard"
+	ADFNodeEmbedCard    = "embedCard"
+	ADFNodeText         = "text"
+	ADFNodeHardBreak    = "hardBreak"
+	ADFNodeMention      = "mention"
+	ADFNodeEmoji        = "emoji"
+	ADFNodeInlineCard   = "inlineCard"
+	ADFNodeStatus       = "status"
+	ADFNodeDate         = "date"
+	ADFNodePlaceholder  = "placeholder"
+)
+
+// The Atlassian Document Format mark types.
+const (
+	ADFMarkStrong      = "strong"
+	ADFMarkEm          = "em"
+	ADFMarkCode        = "code"
+	ADFMarkStrike      = "strike"
+	ADFMarkUnderline   = "underline"
+	ADFMarkLink        = "link"
+	ADFMarkTextColor   = "textColor"
+	ADFMarkSubSup      = "subsup"
+	ADFMarkAlignment   = "alignment"
+	ADFMarkIndentation = "indentation"
+	ADFMarkBreakout    = "breakout"
+)
+
+// The panel types and status colors accepted by the ADFPanel and ADFStatus nodes.
+const (
+	ADFPanelInfo    = "info"
+	ADFPanelNote    = "note"
+	ADFPanelWarning = "warning"
+	ADFPanelSuccess = "success"
+	ADFPanelError   = "error"
+
+	ADFStatusNeutral = "neutral"
+	ADFStatusPurple  = "purple"
+	ADFStatusBlue    = "blue"
+	ADFStatusRed     = "red"
+	ADFStatusYellow  = "yellow"
+	ADFStatusGreen   = "green"
+)
+
+const (
+	adfDocumentVersion = 1
+	adfMaxHeadingLevel = 6
+)
+
+// ADFText creates a text node, use the mark methods to format it, e.g. ADFText("docs").Strong().Link(url).
+func ADFText(text string) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: ADFNodeText, Text: text}
+}
+
+// ADFParagraph creates a paragraph with inline nodes.
+func ADFParagraph(nodes ...*CommentNodeScheme) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: ADFNodeParagraph, Content: nodes}
+}
+
+// ADFHeading creates a heading from level 1 to 6 with inline nodes.
+func ADFHeading(level int, nodes ...*CommentNodeScheme) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: ADFNodeHeading, Attrs: map[string]interface{}{"level": level}, Content: nodes}
+}
+
+// ADFBulletList creates a bullet list, the items must be created with ADFListItem.
+func ADFBulletList(items ...*CommentNodeScheme) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: ADFNodeBulletList, Content: items}
+}
+
+// ADFOrderedList creates an ordered list starting on the order number, the items must be created with ADFListItem.
+func ADFOrderedList(order int, items ...*CommentNodeScheme) *CommentNodeScheme {
+
+	node := &CommentNodeScheme{Type: ADFNodeOrderedList, Content: items}
+	if order > 1 {
+		node.Attrs = map[string]interface{}{"order": order}
+	}
+
+	return node
+}
+
+// ADFListItem creates a list item, plain inline nodes are wrapped into a paragraph.
+func ADFListItem(nodes ...*CommentNodeScheme) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: ADFNodeListItem, Content: wrapInlineNodes(nodes)}
+}
+
+// ADFCodeBlock creates a code block, the language is optional.
+func ADFCodeBlock(language, code string) *CommentNodeScheme {
+
+	node := &CommentNodeScheme{Type: ADFNodeCodeBlock}
+	if language != "" {
+		node.Attrs = map[string]interface{}{"language": language}
+	}
+
+	if code != "" {
+		node.AppendNode(ADFText(code))
+	}
+
+	return node
+}
+
+// ADFBlockquote creates a quote, plain inline nodes are wrapped into a paragraph.
+func ADFBlockquote(nodes ...*CommentNodeScheme) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: ADFNodeBlockquote, Content: wrapInlineNodes(nodes)}
+}
+
+// ADFPanel creates a panel of the given type (see the ADFPanel constants), plain inline nodes are wrapped into a paragraph.
+func ADFPanel(panelType string, nodes ...*CommentNodeScheme) *CommentNodeScheme {
+
+	return &CommentNodeScheme{
+		Type:    ADFNodePanel,
+		Attrs:   map[string]interface{}{"panelType": panelType},
+		Content: wrapInlineNodes(nodes),
+	}
+}
+
+// ADFRule creates a horizontal rule.
+func ADFRule() *CommentNodeScheme {
+	return &CommentNodeScheme{Type: ADFNodeRule}
+}
+
+// ADFTable creates a table, the rows must be created with ADFTableRow.
+func ADFTable(rows ...*CommentNodeScheme) *CommentNodeScheme {
+
+	return &CommentNodeScheme{
+		Type:    ADFNodeTable,
+		Attrs:   map[string]interface{}{"isNumberColumnEnabled": false, "layout": "default"},
+		Content: rows,
+	}
+}
+
+// ADFTableRow creates a table row with header or regular cells.
+func ADFTableRow(cells ...*CommentNodeScheme) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: ADFNodeTableRow, Content: cells}
+}
+
+// ADFTableHeader creates a header cell, plain inline nodes are wrapped into a paragraph.
+func ADFTableHeader(nodes ...*CommentNodeScheme) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: ADFNodeTableHeader, Attrs: map[string]interface{}{}, Content: wrapInlineNodes(nodes)}
+}
+
+// ADFTableCell creates a regular cell, plain inline nodes are wrapped into a paragraph.
+func ADFTableCell(nodes ...*CommentNodeScheme) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: ADFNodeTableCell, Attrs: map[string]interface{}{}, Content: wrapInlineNodes(nodes)}
+}
+
+// ADFMention creates a user mention, the text is displayed when the user can't be resolved, e.g. @Mia Krystof.
+func ADFMention(accountID, text string) *CommentNodeScheme {
+
+	attributes := map[string]interface{}{"id": accountID}
+	if text != "" {
+		attributes["text"] = text
+	}
+
+	return &CommentNodeScheme{Type: ADFNodeMention, Attrs: attributes}
+}
+
+// ADFEmoji creates an emoji from its short name, e.g. :grinning:, the text is the fallback representation.
+func ADFEmoji(shortName, text string) *CommentNodeScheme {
+
+	attributes := map[string]interface{}{"shortName": shortName}
+	if text != "" {
+		attributes["text"] = text
+	}
+
+	return &CommentNodeScheme{Type: ADFNodeEmoji, Attrs: attributes}
+}
+
+// ADFInlineCard creates a smart link rendered inline.
+func ADFInlineCard(url string) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: ADFNodeInlineCard, Attrs: map[string]interface{}{"url": url}}
+}
+
+// ADFStatus creates a status lozenge with one of the ADFStatus colors.
+func ADFStatus(text, color string) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: ADFNodeStatus, Attrs: map[string]interface{}{"text": text, "color": color}}
+}
+
+// ADFDate creates a date node, only the day of the timestamp is displayed.
+func ADFDate(date time.Time) *CommentNodeScheme {
+
+	timestamp := strconv.FormatInt(date.UnixNano()/int64(time.Millisecond), 10)
+	return &CommentNodeScheme{Type: ADFNodeDate, Attrs: map[string]interface{}{"timestamp": timestamp}}
+}
+
+// ADFHardBreak creates a line break inside a paragraph.
+func ADFHardBreak() *CommentNodeScheme {
+	return &CommentNodeScheme{Type: ADFNodeHardBreak}
+}
+
+// ADFMediaSingle creates a single media node, e.g. an attachment of the issue displayed as an image.
+func ADFMediaSingle(layout string, media *CommentNodeScheme) *CommentNodeScheme {
+
+	if layout == "" {
+		layout = "center"
+	}
+
+	return &CommentNodeScheme{
+		Type:    ADFNodeMediaSingle,
+		Attrs:   map[string]interface{}{"layout": layout},
+		Content: []*CommentNodeScheme{media},
+	}
+}
+
+// ADFMedia creates a media node of type file or link, stored on the collection.
+func ADFMedia(id, mediaType, collection string) *CommentNodeScheme {
+
+	return &CommentNodeScheme{
+		Type:  ADFNodeMedia,
+		Attrs: map[string]interface{}{"id": id, "type": mediaType, "collection": collection},
+	}
+}
+
+// wrapInlineNodes wraps the consecutive inline nodes into paragraphs, so the containers accept them.
+func wrapInlineNodes(nodes []*CommentNodeScheme) []*CommentNodeScheme {
+
+	var (
+		content   []*CommentNodeScheme
+		paragraph *CommentNodeScheme
+	)
+
+	for _, node := range nodes {
+
+		if node != nil && adfInlineTypes[node.Type] {
+
+			if paragraph == nil {
+				paragraph = ADFParagraph()
+				content = append(content, paragraph)
+			}
+
+			paragraph.AppendNode(node)
+			continue
+		}
+
+		paragraph = nil
+		content = append(content, node)
+	}
+
+	return content
+}
+
+func (n *CommentNodeScheme) addMark(mark *MarkScheme) *CommentNodeScheme {
+	n.Marks = append(n.Marks, mark)
+	return n
+}
+
+// Strong marks the text as bold.
+func (n *CommentNodeScheme) Strong() *CommentNodeScheme {
+	return n.addMark(&MarkScheme{Type: ADFMarkStrong})
+}
+
+// Em marks the text as italic.
+func (n *CommentNodeScheme) Em() *CommentNodeScheme {
+	return n.addMark(&MarkScheme{Type: ADFMarkEm})
+}
+
+// Code marks the text as inline code, it can only be combined with a link.
+func (n *CommentNodeScheme) Code() *CommentNodeScheme {
+	return n.addMark(&MarkScheme{Type: ADFMarkCode})
+}
+
+// Strike marks the text as struck through.
+func (n *CommentNodeScheme) Strike() *CommentNodeScheme {
+	return n.addMark(&MarkScheme{Type: ADFMarkStrike})
+}
+
+// Underline marks the text as underlined.
+func (n *CommentNodeScheme) Underline() *CommentNodeScheme {
+	return n.addMark(&MarkScheme{Type: ADFMarkUnderline})
+}
+
+// Link marks the text as a hyperlink to the given URL.
+func (n *CommentNodeScheme) Link(href string) *CommentNodeScheme {
+	return n.addMark(&MarkScheme{Type: ADFMarkLink, Attrs: map[string]interface{}{"href": href}})
+}
+
+// TextColor sets the color of the text using the #rrggbb format.
+func (n *CommentNodeScheme) TextColor(color string) *CommentNodeScheme {
+	return n.addMark(&MarkScheme{Type: ADFMarkTextColor, Attrs: map[string]interface{}{"color": color}})
+}
+
+// Subscript marks the text as subscript.
+func (n *CommentNodeScheme) Subscript() *CommentNodeScheme {
+	return n.addMark(&MarkScheme{Type: ADFMarkSubSup, Attrs: map[string]interface{}{"type": "sub"}})
+}
+
+// Superscript marks the text as superscript.
+func (n *CommentNodeScheme) Superscript() *CommentNodeScheme {
+	return n.addMark(&MarkScheme{Type: ADFMarkSubSup, Attrs: map[string]interface{}{"type": "sup"}})
+}
+
+// DocumentBuilder builds an Atlassian Document one block at a time.
+//
+//	document, err := models.NewDocumentBuilder().
+//		Heading(2, models.ADFText("Release notes")).
+//		Paragraph(models.ADFText("Deployed by "), models.ADFMention(accountID, "@Mia")).
+//		BulletList(models.ADFListItem(models.ADFText("Fixed the login").Strong())).
+//		Build()
+type DocumentBuilder struct {
+	document *CommentNodeScheme
+}
+
+// NewDocumentBuilder creates an empty document builder.
+func NewDocumentBuilder() *DocumentBuilder {
+	return &DocumentBuilder{document: &CommentNodeScheme{Version: adfDocumentVersion, Type: ADFNodeDoc}}
+}
+
+// Node appends any block node to the document.
+func (b *DocumentBuilder) Node(nodes ...*CommentNodeScheme) *DocumentBuilder {
+
+	for _, node := range nodes {
+		b.document.AppendNode(node)
+	}
+
+	return b
+}
+
+// Paragraph appends a paragraph with inline nodes.
+func (b *DocumentBuilder) Paragraph(nodes ...*CommentNodeScheme) *DocumentBuilder {
+	return b.Node(ADFParagraph(nodes...))
+}
+
+// Text appends a paragraph with a single plain text node.
+func (b *DocumentBuilder) Text(text string) *DocumentBuilder {
+	return b.Node(ADFParagraph(ADFText(text)))
+}
+
+// Heading appends a heading from level 1 to 6.
+func (b *DocumentBuilder) Heading(level int, nodes ...*CommentNodeScheme) *DocumentBuilder {
+	return b.Node(ADFHeading(level, nodes...))
+}
+
+// BulletList appends a bullet list.
+func (b *DocumentBuilder) BulletList(items ...*CommentNodeScheme) *DocumentBuilder {
+	return b.Node(ADFBulletList(items...))
+}
+
+// OrderedList appends an ordered list starting at 1.
+func (b *DocumentBuilder) OrderedList(items ...*CommentNodeScheme) *DocumentBuilder {
+	return b.Node(ADFOrderedList(1, items...))
+}
+
+// CodeBlock appends a code block.
+func (b *DocumentBuilder) CodeBlock(language, code string) *DocumentBuilder {
+	return b.Node(ADFCodeBlock(language, code))
+}
+
+// Blockquote appends a quote.
+func (b *DocumentBuilder) Blockquote(nodes ...*CommentNodeScheme) *DocumentBuilder {
+	return b.Node(ADFBlockquote(nodes...))
+}
+
+// Panel appends a panel of the given type.
+func (b *DocumentBuilder) Panel(panelType string, nodes ...*CommentNodeScheme) *DocumentBuilder {
+	return b.Node(ADFPanel(panelType, nodes...))
+}
+
+// Rule appends a horizontal rule.
+func (b *DocumentBuilder) Rule() *DocumentBuilder {
+	return b.Node(ADFRule())
+}
+
+// Table appends a table.
+func (b *DocumentBuilder) Table(rows ...*CommentNodeScheme) *DocumentBuilder {
+	return b.Node(ADFTable(rows...))
+}
+
+// Build validates and returns the document.
+func (b *DocumentBuilder) Build() (*CommentNodeScheme, error) {
+
+	if err := b.document.Validate(); err != nil {
+		return nil, err
+	}
+
+	return b.document, nil
+}
+
+// DocumentProblemScheme describes a node that doesn't follow the Atlassian Document Format schema.
+// The path uses the content indexes from the root node, e.g. doc.content[1].content[0].
+type DocumentProblemScheme struct {
+	Path    string
+	Message string
+}
+
+// DocumentValidationError contains every problem found on a document.
+type DocumentValidationError struct {
+	Problems []*DocumentProblemScheme
+}
+
+func (e *DocumentValidationError) Error() string {
+
+	var problems []string
+	for _, problem := range e.Problems {
+		problems = append(problems, fmt.Sprintf("%v: %v", problem.Path, problem.Message))
+	}
+
+	return fmt.Sprintf("jira: invalid document, %v", strings.Join(problems, "; "))
+}
+
+func adfTypes(types ...string) map[string]bool {
+
+	allowed := make(map[string]bool, len(types))
+	for _, nodeType := range types {
+		allowed[nodeType] = true
+	}
+
+	return allowed
+}
+
+var (
+	adfInlineTypes = adfTypes(ADFNodeText, ADFNodeHardBreak, ADFNodeMention, ADFNodeEmoji, ADFNodeInlineCard,
+		ADFNodeStatus, ADFNodeDate, ADFNodePlaceholder)
+
+	adfDocumentTypes = adfTypes(ADFNodeParagraph, ADFNodeHeading, ADFNodeBulletList, ADFNodeOrderedList,
+		ADFNodeCodeBlock, ADFNodeBlockquote, ADFNodePanel, ADFNodeRule, ADFNodeTable, ADFNodeMediaSingle,
+		ADFNodeMediaGroup, ADFNodeExpand, ADFNodeTaskList, ADFNodeDecisionList, ADFNodeBlockCard, ADFNodeEmbedCard)
+
+	adfCellTypes = adfTypes(ADFNodeParagraph, ADFNodeHeading, ADFNodeBulletList, ADFNodeOrderedList,
+		ADFNodeCodeBlock, ADFNodeBlockquote, ADFNodePanel, ADFNodeRule, ADFNodeMediaSingle, ADFNodeMediaGroup,
+		ADFNodeNestedExpand, ADFNodeTaskList, ADFNodeDecisionList, ADFNodeBlockCard, ADFNodeEmbedCard)
+
+	// adfChildren contains the node types allowed as the content of each node, the leaf nodes have no entry.
+	adfChildren = map[string]map[string]bool{
+		ADFNodeDoc:          adfDocumentTypes,
+		ADFNodeParagraph:    adfInlineTypes,
+		ADFNodeHeading:      adfInlineTypes,
+		ADFNodeTaskItem:     adfInlineTypes,
+		ADFNodeDecisionItem: adfInlineTypes,
+		ADFNodeBulletList:   adfTypes(ADFNodeListItem),
+		ADFNodeOrderedList:  adfTypes(ADFNodeListItem),
+		ADFNodeListItem: adfTypes(ADFNodeParagraph, ADFNodeBulletList, ADFNodeOrderedList, ADFNodeCodeBlock,
+			ADFNodeMediaSingle),
+		ADFNodeCodeBlock: adfTypes(ADFNodeText),
+		ADFNodeBlockquote: adfTypes(ADFNodeParagraph, ADFNodeBulletList, ADFNodeOrderedList, ADFNodeCodeBlock,
+			ADFNodeMediaGroup, ADFNodeMediaSingle),
+		ADFNodePanel: adfTypes(ADFNodeParagraph, ADFNodeHeading, ADFNodeBulletList, ADFNodeOrderedList,
+			ADFNodeBlockCard, ADFNodeMediaGroup, ADFNodeMediaSingle, ADFNodeCodeBlock, ADFNodeTaskList, ADFNodeRule,
+			ADFNodeDecisionList),
+		ADFNodeTable:       adfTypes(ADFNodeTableRow),
+		ADFNodeTableRow:    adfTypes(ADFNodeTableHeader, ADFNodeTableCell),
+		ADFNodeTableHeader: adfCellTypes,
+		ADFNodeTableCell:   adfCellTypes,
+		ADFNodeMediaSingle: adfTypes(ADFNodeMedia),
+		ADFNodeMediaGroup:  adfTypes(ADFNodeMedia),
+		ADFNodeExpand: adfTypes(ADFNodeParagraph, ADFNodeHeading, ADFNodeBulletList, ADFNodeOrderedList,
+			ADFNodeCodeBlock, ADFNodeBlockquote, ADFNodePanel, ADFNodeRule, ADFNodeTable, ADFNodeMediaSingle,
+			ADFNodeMediaGroup, ADFNodeNestedExpand, ADFNodeTaskList, ADFNodeDecisionList, ADFNodeBlockCard,
+			ADFNodeEmbedCard),
+		ADFNodeNestedExpand: adfTypes(ADFNodeParagraph, ADFNodeHeading, ADFNodeBulletList, ADFNodeOrderedList,
+			ADFNodeCodeBlock, ADFNodeBlockquote, ADFNodePanel, ADFNodeRule, ADFNodeMediaSingle, ADFNodeMediaGroup,
+			ADFNodeTaskList, ADFNodeDecisionList),
+		ADFNodeTaskList:     adfTypes(ADFNodeTaskItem, ADFNodeTaskList),
+		ADFNodeDecisionList: adfTypes(ADFNodeDecisionItem),
+	}
+
+	// adfRequiredChildren contains the nodes that must have at least one child.
+	adfRequiredChildren = adfTypes(ADFNodeBulletList, ADFNodeOrderedList, ADFNodeListItem, ADFNodeBlockquote,
+		ADFNodePanel, ADFNodeTable, ADFNodeTableRow, ADFNodeTableHeader, ADFNodeTableCell, ADFNodeMediaSingle,
+		ADFNodeMediaGroup, ADFNodeExpand, ADFNodeNestedExpand, ADFNodeTaskList, ADFNodeDecisionList)
+
+	adfTextMarks  = adfTypes(ADFMarkStrong, ADFMarkEm, ADFMarkCode, ADFMarkStrike, ADFMarkUnderline, ADFMarkLink, ADFMarkTextColor, ADFMarkSubSup)
+	adfBlockMarks = map[string]map[string]bool{
+		ADFNodeParagraph:   adfTypes(ADFMarkAlignment, ADFMarkIndentation),
+		ADFNodeHeading:     adfTypes(ADFMarkAlignment, ADFMarkIndentation),
+		ADFNodeCodeBlock:   adfTypes(ADFMarkBreakout),
+		ADFNodeExpand:      adfTypes(ADFMarkBreakout),
+		ADFNodeMediaSingle: adfTypes(ADFMarkLink),
+	}
+
+	adfKnownTypes = adfTypes(ADFNodeDoc, ADFNodeParagraph, ADFNodeHeading, ADFNodeBulletList, ADFNodeOrderedList,
+		ADFNodeListItem, ADFNodeCodeBlock, ADFNodeBlockquote, ADFNodePanel, ADFNodeRule, ADFNodeTable, ADFNodeTableRow,
+		ADFNodeTableHeader, ADFNodeTableCell, ADFNodeMediaSingle, ADFNodeMediaGroup, ADFNodeMedia, ADFNodeExpand,
+		ADFNodeNestedExpand, ADFNodeTaskList, ADFNodeTaskItem, ADFNodeDecisionList, ADFNodeDecisionItem,
+		ADFNodeBlockCard, ADFNodeEmbedCard, ADFNodeText, ADFNodeHardBreak, ADFNodeMention, ADFNodeEmoji,
+		ADFNodeInlineCard, ADFNodeStatus, ADFNodeDate, ADFNodePlaceholder)
+
+	adfPanelTypes   = adfTypes(ADFPanelInfo, ADFPanelNote, ADFPanelWarning, ADFPanelSuccess, ADFPanelError)
+	adfStatusColors = adfTypes(ADFStatusNeutral, ADFStatusPurple, ADFStatusBlue, ADFStatusRed, ADFStatusYellow, ADFStatusGreen)
+	adfMediaTypes   = adfTypes("file", "link", "external")
+	adfColorRegexp  = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+)
+
+// Validate checks the document against the Atlassian Document Format schema rules: the root node, the children
+// allowed by each node, the required attributes and the marks. Every problem is returned on a *DocumentValidationError.
+func (n *CommentNodeScheme) Validate() error {
+
+	validation := &DocumentValidationError{}
+
+	if n.Type != ADFNodeDoc {
+		validation.add(n.Type, fmt.Sprintf("the root node must be a %v node", ADFNodeDoc))
+	} else if n.Version != adfDocumentVersion {
+		validation.add(ADFNodeDoc, fmt.Sprintf("the document version must be %v", adfDocumentVersion))
+	}
+
+	validation.node(n, n.Type, "")
+
+	if len(validation.Problems) != 0 {
+		return validation
+	}
+
+	return nil
+}
+
+func (e *DocumentValidationError) add(path, message string) {
+	e.Problems = append(e.Problems, &DocumentProblemScheme{Path: path, Message: message})
+}
+
+func (e *DocumentValidationError) node(node *CommentNodeScheme, path, parentType string) {
+
+	if node == nil {
+		e.add(path, "the node is nil")
+		return
+	}
+
+	if !adfKnownTypes[node.Type] {
+		e.add(path, fmt.Sprintf("unknown node type %q", node.Type))
+		return
+	}
+
+	allowedChildren, hasChildren := adfChildren[node.Type]
+
+	if !hasChildren && len(node.Content) != 0 {
+		e.add(path, fmt.Sprintf("the %v node can't contain other nodes", node.Type))
+	}
+
+	if adfRequiredChildren[node.Type] && len(node.Content) == 0 {
+		e.add(path, fmt.Sprintf("the %v node must contain at least one node", node.Type))
+	}
+
+	if node.Type == ADFNodeMediaSingle && len(node.Content) > 1 {
+		e.add(path, "the mediaSingle node must contain a single media node")
+	}
+
+	e.attributes(node, path)
+	e.marks(node, path, parentType)
+
+	for index, child := range node.Content {
+
+		childPath := fmt.Sprintf("%v.content[%v]", path, index)
+
+		if child != nil && hasChildren && !allowedChildren[child.Type] {
+			if adfKnownTypes[child.Type] {
+				e.add(childPath, fmt.Sprintf("the %v node is not allowed inside a %v node", child.Type, node.Type))
+				continue
+			}
+		}
+
+		e.node(child, childPath, node.Type)
+	}
+}
+
+func (e *DocumentValidationError) attributes(node *CommentNodeScheme, path string) {
+
+	switch node.Type {
+	case ADFNodeText:
+
+		if node.Text == "" {
+			e.add(path, "the text node can't be empty")
+		}
+
+	case ADFNodeHeading:
+
+		level, ok := adfNumber(node.Attrs["level"])
+		if !ok || level < 1 || level > adfMaxHeadingLevel {
+			e.add(path, fmt.Sprintf("the heading level must be between 1 and %v", adfMaxHeadingLevel))
+		}
+
+	case ADFNodeOrderedList:
+
+		if order, ok := node.Attrs["order"]; ok {
+			if number, ok := adfNumber(order); !ok || number < 0 {
+				e.add(path, "the ordered list order must be a positive number")
+			}
+		}
+
+	case ADFNodePanel:
+
+		if !adfPanelTypes[adfString(node.Attrs["panelType"])] {
+			e.add(path, "the panel type must be info, note, warning, success or error")
+		}
+
+	case ADFNodeMention:
+
+		if adfString(node.Attrs["id"]) == "" {
+			e.add(path, "the mention node requires the account id")
+		}
+
+	case ADFNodeEmoji:
+
+		if adfString(node.Attrs["shortName"]) == "" {
+			e.add(path, "the emoji node requires the short name")
+		}
+
+	case ADFNodeInlineCard, ADFNodeBlockCard:
+
+		if adfString(node.Attrs["url"]) == "" && node.Attrs["data"] == nil {
+			e.add(path, fmt.Sprintf("the %v node requires the url or data attribute", node.Type))
+		}
+
+	case ADFNodeStatus:
+
+		if adfString(node.Attrs["text"]) == "" {
+			e.add(path, "the status node requires the text")
+		}
+
+		if !adfStatusColors[adfString(node.Attrs["color"])] {
+			e.add(path, "the status color must be neutral, purple, blue, red, yellow or green")
+		}
+
+	case ADFNodeDate:
+
+		if adfString(node.Attrs["timestamp"]) == "" {
+			e.add(path, "the date node requires the timestamp in milliseconds")
+		}
+
+	case ADFNodeMedia:
+
+		if !adfMediaTypes[adfString(node.Attrs["type"])] {
+			e.add(path, "the media type must be file, link or external")
+		}
+
+		if node.Attrs["type"] == "external" {
+			if adfString(node.Attrs["url"]) == "" {
+				e.add(path, "the external media node requires the url")
+			}
+		} else if adfString(node.Attrs["id"]) == "" {
+			e.add(path, "the media node requires the id")
+		}
+
+	case ADFNodeCodeBlock:
+
+		for index, child := range node.Content {
+			if child != nil && len(child.Marks) != 0 {
+				e.add(fmt.Sprintf("%v.content[%v]", path, index), "the code block text can't have marks")
+			}
+		}
+	}
+}
+
+func (e *DocumentValidationError) marks(node *CommentNodeScheme, path, parentType string) {
+
+	if len(node.Marks) == 0 {
+		return
+	}
+
+	if node.Type != ADFNodeText {
+
+		for _, mark := range node.Marks {
+			if mark == nil || !adfBlockMarks[node.Type][mark.Type] {
+				e.add(path, fmt.Sprintf("the %v node doesn't allow the %v mark", node.Type, adfMarkType(mark)))
+			}
+		}
+
+		return
+	}
+
+	if parentType == ADFNodeCodeBlock {
+		return
+	}
+
+	var (
+		hasCode    bool
+		otherMarks []string
+		seen       = make(map[string]bool)
+	)
+
+	for _, mark := range node.Marks {
+
+		if mark == nil || !adfTextMarks[mark.Type] {
+			e.add(path, fmt.Sprintf("unknown text mark %q", adfMarkType(mark)))
+			continue
+		}
+
+		if seen[mark.Type] {
+			e.add(path, fmt.Sprintf("the %v mark is duplicated", mark.Type))
+		}
+
+		seen[mark.Type] = true
+
+		switch mark.Type {
+		case ADFMarkCode:
+			hasCode = true
+		case ADFMarkLink:
+
+			if adfString(mark.Attrs["href"]) == "" {
+				e.add(path, "the link mark requires the href")
+			}
+		case ADFMarkTextColor:
+
+			if !adfColorRegexp.MatchString(adfString(mark.Attrs["color"])) {
+				e.add(path, "the text color must use the #rrggbb format")
+			}
+		case ADFMarkSubSup:
+
+			if subSup := adfString(mark.Attrs["type"]); subSup != "sub" && subSup != "sup" {
+				e.add(path, "the subsup mark type must be sub or sup")
+			}
+		}
+
+		if mark.Type != ADFMarkCode && mark.Type != ADFMarkLink {
+			otherMarks = append(otherMarks, mark.Type)
+		}
+	}
+
+	if hasCode && len(otherMarks) != 0 {
+		e.add(path, fmt.Sprintf("the code mark can only be combined with the link mark, found %v", strings.Join(otherMarks, ", ")))
+	}
+}
+
+func adfMarkType(mark *MarkScheme) string {
+
+	if mark == nil {
+		return "nil"
+	}
+
+	return mark.Type
+}
+
+func adfString(value interface{}) string {
+
+	text, _ := value.(string)
+	return text
+}
+
+func adfNumber(value interface{}) (float64, bool) {
+
+	switch number := value.(type) {
+	case int:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	case float64:
+		return number, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,1335 @@
+package models
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MarkdownToDocument converts CommonMark text to an Atlassian Document, ready to be used on the v3 issue
+// descriptions, comments and worklogs. The GitHub Flavored Markdown tables, strikethrough text, task lists
+// and bare URLs are supported as well, the entity references are decoded, the reference links and the raw HTML
+// are kept as text. The code spans inside the emphasis keep the emphasis marks only, the schema doesn't allow both.
+//
+// The blocks not allowed by the document schema inside other blocks, e.g. a heading inside a list, are converted
+// to the closest supported nodes, so the result always passes the Validate checks.
+func MarkdownToDocument(markdown string) (*CommentNodeScheme, error) {
+
+	parser := &markdownParser{}
+
+	lines := strings.Split(strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(markdown), "\n")
+	for index, line := range lines {
+		lines[index] = expandMarkdownTabs(line)
+	}
+
+	document := &CommentNodeScheme{Type: ADFNodeDoc, Version: adfDocumentVersion}
+	for _, node := range parser.blocks(lines) {
+		document.AppendNode(node)
+	}
+
+	if err := document.Validate(); err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+var (
+	markdownATXHeadingRegexp = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+|$)(.*)$`)
+	markdownClosingRegexp    = regexp.MustCompile(`(?:^|[ \t]+)#+[ \t]*$`)
+	markdownFenceRegexp      = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})[ \t]*([^ \t`]*)")
+	markdownBreakRegexp      = regexp.MustCompile(`^ {0,3}(?:(?:\*[ \t]*){3,}|(?:-[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	markdownSetextRegexp     = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	markdownListRegexp       = regexp.MustCompile(`^( {0,3})([-+*]|[0-9]{1,9}[.)])( *)(.*)$`)
+	markdownTaskRegexp       = regexp.MustCompile(`^\[([ xX])\](?:[ \t]+|$)`)
+	markdownDelimiterRegexp  = regexp.MustCompile(`^ *\|? *:?-+:? *(?:\| *:?-+:? *)*\|? *$`)
+	markdownAutolinkRegexp   = regexp.MustCompile(`^<([A-Za-z][A-Za-z0-9+.-]{1,31}:[^<>\s]*)>`)
+	markdownEmailRegexp      = regexp.MustCompile(`^<([A-Za-z0-9.!#$%&'*+/=?^_{|}~-]+@[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?(?:\.[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?)*)>`)
+	markdownBreakTagRegexp   = regexp.MustCompile(`^<br ?/?>`)
+	markdownBareURLRegexp    = regexp.MustCompile(`(?:https?://|www\.)[^\s<]*[^\s<?!.,:*_~'")\]]`)
+	markdownEntityRegexp     = regexp.MustCompile(`^&(?:#[0-9]{1,7}|#[xX][0-9A-Fa-f]{1,6}|[A-Za-z][A-Za-z0-9]{1,31});`)
+)
+
+type markdownParser struct {
+	tasks int
+}
+
+func expandMarkdownTabs(line string) string {
+
+	var (
+		builder strings.Builder
+		column  int
+	)
+
+	for index, character := range line {
+
+		switch character {
+		case '\t':
+
+			spaces := 4 - column%4
+			builder.WriteString(strings.Repeat(" ", spaces))
+			column += spaces
+		case ' ':
+
+			builder.WriteRune(character)
+			column++
+		default:
+
+			// Only the indentation is expanded, the tabs of the content are kept
+			builder.WriteString(line[index:])
+			return builder.String()
+		}
+	}
+
+	return builder.String()
+}
+
+func isBlankLine(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+func lineIndent(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// removeIndent removes up to width leading spaces of the line.
+func removeIndent(line string, width int) string {
+
+	indent := lineIndent(line)
+	if indent > width {
+		indent = width
+	}
+
+	return line[indent:]
+}
+
+type markdownListMarker struct {
+	bullet  byte
+	ordered bool
+	order   int
+	width   int
+	content string
+}
+
+func parseMarkdownListMarker(line string) (*markdownListMarker, bool) {
+
+	matches := markdownListRegexp.FindStringSubmatch(line)
+	if matches == nil || (matches[3] == "" && matches[4] != "") {
+		return nil, false
+	}
+
+	marker := &markdownListMarker{content: matches[4]}
+
+	if last := matches[2][len(matches[2])-1]; last == '.' || last == ')' {
+		marker.ordered = true
+		marker.bullet = last
+		marker.order, _ = strconv.Atoi(matches[2][:len(matches[2])-1])
+	} else {
+		marker.bullet = last
+	}
+
+	spaces := len(matches[3])
+	switch {
+	case matches[4] == "":
+		spaces = 1
+	case spaces > 4:
+
+		// The content indented by more than four spaces is an indented code block
+		marker.content = matches[3][1:] + matches[4]
+		spaces = 1
+	}
+
+	marker.width = len(matches[1]) + len(matches[2]) + spaces
+
+	return marker, true
+}
+
+// startsMarkdownBlock checks if the line starts a block that interrupts a paragraph.
+func startsMarkdownBlock(line string) bool {
+
+	if lineIndent(line) > 3 {
+		return false
+	}
+
+	trimmed := strings.TrimLeft(line, " ")
+
+	if markdownATXHeadingRegexp.MatchString(line) || markdownFenceRegexp.MatchString(line) ||
+		markdownBreakRegexp.MatchString(line) || strings.HasPrefix(trimmed, ">") {
+		return true
+	}
+
+	// Only the non-empty bullet items and the ordered lists starting on one interrupt a paragraph
+	marker, ok := parseMarkdownListMarker(line)
+	return ok && !isBlankLine(marker.content) && (!marker.ordered || marker.order == 1)
+}
+
+func (p *markdownParser) blocks(lines []string) (nodes []*CommentNodeScheme) {
+
+	for index := 0; index < len(lines); {
+
+		var node []*CommentNodeScheme
+		node, index = p.block(lines, index)
+
+		nodes = append(nodes, node...)
+	}
+
+	return nodes
+}
+
+func (p *markdownParser) block(lines []string, index int) ([]*CommentNodeScheme, int) {
+
+	line := lines[index]
+
+	if isBlankLine(line) {
+		return nil, index + 1
+	}
+
+	if lineIndent(line) >= 4 {
+		return p.indentedCode(lines, index)
+	}
+
+	if matches := markdownFenceRegexp.FindStringSubmatch(line); matches != nil {
+		return p.fencedCode(lines, index, matches)
+	}
+
+	if matches := markdownATXHeadingRegexp.FindStringSubmatch(line); matches != nil {
+
+		text := markdownClosingRegexp.ReplaceAllString(strings.TrimSpace(matches[2]), "")
+		return []*CommentNodeScheme{ADFHeading(len(matches[1]), p.headingContent(text)...)}, index + 1
+	}
+
+	if markdownBreakRegexp.MatchString(line) {
+		return []*CommentNodeScheme{ADFRule()}, index + 1
+	}
+
+	if strings.HasPrefix(strings.TrimLeft(line, " "), ">") {
+		return p.blockquote(lines, index)
+	}
+
+	if _, ok := parseMarkdownListMarker(line); ok {
+		return p.list(lines, index)
+	}
+
+	if index+1 < len(lines) && strings.Contains(line, "|") && markdownDelimiterRegexp.MatchString(lines[index+1]) &&
+		len(splitMarkdownTableRow(line)) == len(splitMarkdownTableRow(lines[index+1])) {
+		return p.table(lines, index)
+	}
+
+	return p.paragraph(lines, index)
+}
+
+func (p *markdownParser) indentedCode(lines []string, index int) ([]*CommentNodeScheme, int) {
+
+	var code []string
+	for ; index < len(lines) && (isBlankLine(lines[index]) || lineIndent(lines[index]) >= 4); index++ {
+		code = append(code, removeIndent(lines[index], 4))
+	}
+
+	for len(code) != 0 && isBlankLine(code[len(code)-1]) {
+		code = code[:len(code)-1]
+	}
+
+	return []*CommentNodeScheme{ADFCodeBlock("", strings.Join(code, "\n"))}, index
+}
+
+func (p *markdownParser) fencedCode(lines []string, index int, matches []string) ([]*CommentNodeScheme, int) {
+
+	var (
+		indent = len(matches[1])
+		fence  = matches[2]
+		code   []string
+	)
+
+	for index++; index < len(lines); index++ {
+
+		line := lines[index]
+		trimmed := strings.TrimSpace(line)
+
+		if lineIndent(line) <= 3 && strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+			index++
+			break
+		}
+
+		code = append(code, removeIndent(line, indent))
+	}
+
+	return []*CommentNodeScheme{ADFCodeBlock(matches[3], strings.Join(code, "\n"))}, index
+}
+
+func (p *markdownParser) blockquote(lines []string, index int) ([]*CommentNodeScheme, int) {
+
+	var quoted []string
+
+	for ; index < len(lines); index++ {
+
+		line := lines[index]
+		trimmed := strings.TrimLeft(line, " ")
+
+		if lineIndent(line) <= 3 && strings.HasPrefix(trimmed, ">") {
+			quoted = append(quoted, strings.TrimPrefix(trimmed[1:], " "))
+			continue
+		}
+
+		// The lazy continuation lines belong to the quoted paragraph
+		if isBlankLine(line) || isBlankLine(quoted[len(quoted)-1]) || startsMarkdownBlock(line) {
+			break
+		}
+
+		quoted = append(quoted, line)
+	}
+
+	content := fitDocumentNodes(p.blocks(quoted), adfChildren[ADFNodeBlockquote])
+	if len(content) == 0 {
+		return nil, index
+	}
+
+	return []*CommentNodeScheme{ADFBlockquote(content...)}, index
+}
+
+func (p *markdownParser) list(lines []string, index int) ([]*CommentNodeScheme, int) {
+
+	first, _ := parseMarkdownListMarker(lines[index])
+
+	var items [][]string
+
+	for index < len(lines) && !markdownBreakRegexp.MatchString(lines[index]) {
+
+		marker, ok := parseMarkdownListMarker(lines[index])
+		if !ok || marker.ordered != first.ordered || marker.bullet != first.bullet {
+			break
+		}
+
+		item := []string{marker.content}
+
+		for index++; index < len(lines); index++ {
+
+			line := lines[index]
+
+			if isBlankLine(line) {
+				item = append(item, "")
+				continue
+			}
+
+			if lineIndent(line) >= marker.width {
+				item = append(item, line[marker.width:])
+				continue
+			}
+
+			if isBlankLine(item[len(item)-1]) || startsMarkdownBlock(line) {
+				break
+			}
+
+			if _, ok := parseMarkdownListMarker(line); ok {
+				break
+			}
+
+			// The lazy continuation lines belong to the last paragraph of the item
+			item = append(item, strings.TrimLeft(line, " "))
+		}
+
+		for len(item) > 1 && isBlankLine(item[len(item)-1]) {
+			item = item[:len(item)-1]
+		}
+
+		items = append(items, item)
+	}
+
+	if !first.ordered && p.isTaskList(items) {
+		return []*CommentNodeScheme{p.taskList(items)}, index
+	}
+
+	var list *CommentNodeScheme
+	if first.ordered {
+		list = ADFOrderedList(first.order)
+	} else {
+		list = ADFBulletList()
+	}
+
+	for _, item := range items {
+
+		content := fitDocumentNodes(p.blocks(item), adfChildren[ADFNodeListItem])
+		if len(content) == 0 {
+			content = []*CommentNodeScheme{ADFParagraph()}
+		}
+
+		list.AppendNode(ADFListItem(content...))
+	}
+
+	return []*CommentNodeScheme{list}, index
+}
+
+func (p *markdownParser) isTaskList(items [][]string) bool {
+
+	for _, item := range items {
+		if !markdownTaskRegexp.MatchString(item[0]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *markdownParser) taskList(items [][]string) *CommentNodeScheme {
+
+	p.tasks++
+	list := &CommentNodeScheme{Type: ADFNodeTaskList, Attrs: map[string]interface{}{"localId": fmt.Sprintf("task-list-%d", p.tasks)}}
+
+	for _, item := range items {
+
+		state := "TODO"
+		if marker := markdownTaskRegexp.FindStringSubmatch(item[0]); marker[1] != " " {
+			state = "DONE"
+		}
+
+		item[0] = markdownTaskRegexp.ReplaceAllString(item[0], "")
+
+		p.tasks++
+		task := &CommentNodeScheme{
+			Type:  ADFNodeTaskItem,
+			Attrs: map[string]interface{}{"localId": fmt.Sprintf("task-%d", p.tasks), "state": state},
+		}
+
+		list.AppendNode(task)
+
+		// The task items only accept inline nodes, the nested task lists are added to the list
+		for _, block := range p.blocks(item) {
+
+			if block.Type == ADFNodeTaskList {
+				list.AppendNode(block)
+				continue
+			}
+
+			if len(task.Content) != 0 {
+				task.AppendNode(ADFHardBreak())
+			}
+
+			task.Content = append(task.Content, inlineDocumentNodes(block)...)
+		}
+	}
+
+	return list
+}
+
+func (p *markdownParser) table(lines []string, index int) ([]*CommentNodeScheme, int) {
+
+	var (
+		header  = splitMarkdownTableRow(lines[index])
+		columns = len(header)
+		table   = ADFTable()
+	)
+
+	table.AppendNode(p.tableRow(header, columns, ADFTableHeader))
+
+	for index += 2; index < len(lines) && !isBlankLine(lines[index]) && !startsMarkdownBlock(lines[index]); index++ {
+		table.AppendNode(p.tableRow(splitMarkdownTableRow(lines[index]), columns, ADFTableCell))
+	}
+
+	return []*CommentNodeScheme{table}, index
+}
+
+func (p *markdownParser) tableRow(cells []string, columns int, cell func(...*CommentNodeScheme) *CommentNodeScheme) *CommentNodeScheme {
+
+	row := ADFTableRow()
+
+	for column := 0; column < columns; column++ {
+
+		var text string
+		if column < len(cells) {
+			text = cells[column]
+		}
+
+		content := documentParagraphs(markdownInlineNodes(text))
+		if len(content) == 0 {
+			content = []*CommentNodeScheme{ADFParagraph()}
+		}
+
+		row.AppendNode(cell(content...))
+	}
+
+	return row
+}
+
+// splitMarkdownTableRow returns the cells of a table row, the escaped pipes are part of the cell.
+func splitMarkdownTableRow(line string) []string {
+
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+
+	var (
+		cells []string
+		cell  strings.Builder
+	)
+
+	for index := 0; index < len(line); index++ {
+
+		switch {
+		case line[index] == '\\' && index+1 < len(line) && line[index+1] == '|':
+
+			cell.WriteByte('|')
+			index++
+		case line[index] == '|':
+
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[index])
+		}
+	}
+
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+func (p *markdownParser) paragraph(lines []string, index int) ([]*CommentNodeScheme, int) {
+
+	text := []string{strings.TrimLeft(lines[index], " ")}
+
+	for index++; index < len(lines); index++ {
+
+		line := lines[index]
+		if isBlankLine(line) {
+			break
+		}
+
+		if matches := markdownSetextRegexp.FindStringSubmatch(line); matches != nil && lineIndent(line) <= 3 {
+
+			level := 2
+			if matches[1][0] == '=' {
+				level = 1
+			}
+
+			heading := p.headingContent(strings.Join(text, "\n"))
+			return []*CommentNodeScheme{ADFHeading(level, heading...)}, index + 1
+		}
+
+		if startsMarkdownBlock(line) {
+			break
+		}
+
+		text = append(text, strings.TrimLeft(line, " "))
+	}
+
+	return documentParagraphs(markdownInlineNodes(strings.Join(text, "\n"))), index
+}
+
+// documentParagraphs wraps the inline nodes into paragraphs, the images are block nodes placed between them.
+// The spaces next to the images are trimmed, and the paragraphs left empty are dropped.
+func documentParagraphs(nodes []*CommentNodeScheme) (blocks []*CommentNodeScheme) {
+
+	var paragraph *CommentNodeScheme
+
+	for _, node := range nodes {
+
+		if node.Type == ADFNodeMediaSingle {
+
+			if paragraph != nil && !trimParagraph(paragraph) {
+				blocks = blocks[:len(blocks)-1]
+			}
+
+			blocks = append(blocks, node)
+			paragraph = nil
+			continue
+		}
+
+		if paragraph == nil {
+
+			// The line breaks next to the images are not needed
+			if node.Type == ADFNodeHardBreak {
+				continue
+			}
+
+			paragraph = ADFParagraph()
+			blocks = append(blocks, paragraph)
+		}
+
+		paragraph.AppendNode(node)
+	}
+
+	if paragraph != nil && !trimParagraph(paragraph) {
+		blocks = blocks[:len(blocks)-1]
+	}
+
+	return blocks
+}
+
+// trimParagraph removes the spaces and the line breaks at the edges of a paragraph, it returns false when
+// nothing is left. The code text is kept as it is.
+func trimParagraph(paragraph *CommentNodeScheme) bool {
+
+	content := paragraph.Content
+
+	for len(content) != 0 {
+
+		first := content[0]
+		if first.Type == ADFNodeText && hasMark(first, ADFMarkCode) == nil {
+			first.Text = strings.TrimLeftFunc(first.Text, unicode.IsSpace)
+		}
+
+		if first.Type != ADFNodeHardBreak && (first.Type != ADFNodeText || first.Text != "") {
+			break
+		}
+
+		content = content[1:]
+	}
+
+	for len(content) != 0 {
+
+		last := content[len(content)-1]
+		if last.Type == ADFNodeText && hasMark(last, ADFMarkCode) == nil {
+			last.Text = strings.TrimRightFunc(last.Text, unicode.IsSpace)
+		}
+
+		if last.Type != ADFNodeHardBreak && (last.Type != ADFNodeText || last.Text != "") {
+			break
+		}
+
+		content = content[:len(content)-1]
+	}
+
+	paragraph.Content = content
+
+	return len(content) != 0
+}
+
+func (p *markdownParser) headingContent(text string) []*CommentNodeScheme {
+
+	var content []*CommentNodeScheme
+	for _, node := range markdownInlineNodes(text) {
+		content = append(content, inlineDocumentNodes(node)...)
+	}
+
+	return content
+}
+
+// inlineDocumentNodes returns the inline nodes of a block, e.g. the text of a heading moved to a list item.
+func inlineDocumentNodes(node *CommentNodeScheme) []*CommentNodeScheme {
+
+	if node == nil {
+		return nil
+	}
+
+	if adfInlineTypes[node.Type] {
+		return []*CommentNodeScheme{node}
+	}
+
+	if node.Type == ADFNodeMedia {
+
+		_, name := (&DocumentRenderOptions{}).media(node)
+		if link := adfString(node.Attrs["url"]); link != "" {
+			return []*CommentNodeScheme{ADFText(firstNonEmpty(name, link)).Link(link)}
+		}
+
+		return nil
+	}
+
+	if node.Type == ADFNodeCodeBlock {
+
+		if code := textContent(node); code != "" {
+			return []*CommentNodeScheme{ADFText(code).Code()}
+		}
+
+		return nil
+	}
+
+	var nodes []*CommentNodeScheme
+	for _, child := range node.Content {
+
+		childNodes := inlineDocumentNodes(child)
+		if len(nodes) != 0 && len(childNodes) != 0 && child != nil && !adfInlineTypes[child.Type] {
+			nodes = append(nodes, ADFHardBreak())
+		}
+
+		nodes = append(nodes, childNodes...)
+	}
+
+	return nodes
+}
+
+func firstNonEmpty(values ...string) string {
+
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// fitDocumentNodes converts the blocks not allowed by a container to the closest allowed nodes.
+func fitDocumentNodes(nodes []*CommentNodeScheme, allowed map[string]bool) (fitted []*CommentNodeScheme) {
+
+	for _, node := range nodes {
+
+		if allowed[node.Type] {
+			fitted = append(fitted, node)
+			continue
+		}
+
+		switch node.Type {
+		case ADFNodeHeading:
+
+			var content []*CommentNodeScheme
+			for _, child := range node.Content {
+
+				if child.Type == ADFNodeText && hasMark(child, ADFMarkStrong) == nil && hasMark(child, ADFMarkCode) == nil {
+					child.Strong()
+				}
+
+				content = append(content, child)
+			}
+
+			fitted = append(fitted, fitDocumentNodes([]*CommentNodeScheme{ADFParagraph(content...)}, allowed)...)
+
+		case ADFNodeBlockquote, ADFNodePanel:
+			fitted = append(fitted, fitDocumentNodes(node.Content, allowed)...)
+
+		case ADFNodeTable:
+
+			for _, row := range node.Content {
+
+				var cells []*CommentNodeScheme
+				for _, cell := range row.Content {
+
+					if content := inlineDocumentNodes(cell); len(content) != 0 {
+
+						if len(cells) != 0 {
+							cells = append(cells, ADFText(" | "))
+						}
+
+						cells = append(cells, content...)
+					}
+				}
+
+				if len(cells) != 0 {
+					fitted = append(fitted, ADFParagraph(cells...))
+				}
+			}
+
+		case ADFNodeTaskList:
+
+			list := ADFBulletList()
+			for _, task := range node.Content {
+
+				if task.Type == ADFNodeTaskList {
+					list.AppendNode(ADFListItem(fitDocumentNodes([]*CommentNodeScheme{task}, adfChildren[ADFNodeListItem])...))
+					continue
+				}
+
+				checkbox := "[ ] "
+				if adfString(task.Attrs["state"]) == "DONE" {
+					checkbox = "[x] "
+				}
+
+				list.AppendNode(ADFListItem(ADFParagraph(append([]*CommentNodeScheme{ADFText(checkbox)}, task.Content...)...)))
+			}
+
+			fitted = append(fitted, fitDocumentNodes([]*CommentNodeScheme{list}, allowed)...)
+
+		case ADFNodeRule:
+			// The rules are only allowed on the document and the panels
+		default:
+
+			if content := inlineDocumentNodes(node); len(content) != 0 && allowed[ADFNodeParagraph] {
+				fitted = append(fitted, ADFParagraph(content...))
+			}
+		}
+	}
+
+	return fitted
+}
+
+// markdownInline is an inline element parsed from Markdown, before it's converted to the document nodes.
+type markdownInline struct {
+	text      string
+	code      bool
+	hardBreak bool
+	card      string
+	image     *CommentNodeScheme
+
+	// The delimiter runs of *, _ and ~ characters, matched by the emphasis rules
+	delimiter         byte
+	count             int
+	canOpen, canClose bool
+
+	// The link and image openers, matched by the closing brackets
+	bracket  string
+	inactive bool
+
+	mark     *MarkScheme
+	children []*markdownInline
+}
+
+func markdownInlineNodes(text string) []*CommentNodeScheme {
+
+	elements := parseMarkdownInline(strings.TrimSpace(text))
+	return mergeTextNodes(flattenMarkdownInline(elements, nil))
+}
+
+func isMarkdownPunctuation(character rune) bool {
+	return unicode.IsPunct(character) || unicode.IsSymbol(character)
+}
+
+func parseMarkdownInline(text string) []*markdownInline {
+
+	var (
+		elements []*markdownInline
+		plain    strings.Builder
+	)
+
+	flush := func() {
+
+		if plain.Len() != 0 {
+			elements = append(elements, &markdownInline{text: plain.String()})
+			plain.Reset()
+		}
+	}
+
+	for index := 0; index < len(text); {
+
+		character := text[index]
+		rest := text[index:]
+
+		switch {
+		case character == '\\' && index+1 < len(text) && text[index+1] == '\n':
+
+			flush()
+			elements = append(elements, &markdownInline{hardBreak: true})
+			index += 2
+
+		case character == '\\' && index+1 < len(text) && text[index+1] < utf8.RuneSelf &&
+			isMarkdownPunctuation(rune(text[index+1])):
+
+			plain.WriteByte(text[index+1])
+			index += 2
+
+		case character == '\n':
+
+			// Two or more trailing spaces make a hard line break, otherwise it's a soft break
+			content := plain.String()
+			trimmed := strings.TrimRight(content, " ")
+
+			plain.Reset()
+			plain.WriteString(trimmed)
+
+			if len(content)-len(trimmed) >= 2 {
+				flush()
+				elements = append(elements, &markdownInline{hardBreak: true})
+			} else {
+				plain.WriteByte(' ')
+			}
+
+			index++
+			for index < len(text) && text[index] == ' ' {
+				index++
+			}
+
+		case character == '`':
+
+			run := len(rest) - len(strings.TrimLeft(rest, "`"))
+			closing := findMarkdownCodeClosing(text, index+run, run)
+			if closing < 0 {
+				plain.WriteString(rest[:run])
+				index += run
+				continue
+			}
+
+			code := strings.ReplaceAll(text[index+run:closing], "\n", " ")
+			if len(code) >= 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.Trim(code, " ") != "" {
+				code = code[1 : len(code)-1]
+			}
+
+			flush()
+			elements = append(elements, &markdownInline{text: code, code: true})
+			index = closing + run
+
+		case character == '<':
+
+			if matches := markdownAutolinkRegexp.FindStringSubmatch(rest); matches != nil {
+
+				flush()
+				elements = append(elements, &markdownInline{card: matches[1]})
+				index += len(matches[0])
+				continue
+			}
+
+			if matches := markdownEmailRegexp.FindStringSubmatch(rest); matches != nil {
+
+				flush()
+				elements = append(elements, &markdownInline{
+					mark:     &MarkScheme{Type: ADFMarkLink, Attrs: map[string]interface{}{"href": "mailto:" + matches[1]}},
+					children: []*markdownInline{{text: matches[1]}},
+				})
+				index += len(matches[0])
+				continue
+			}
+
+			if matches := markdownBreakTagRegexp.FindString(rest); matches != "" {
+
+				flush()
+				elements = append(elements, &markdownInline{hardBreak: true})
+				index += len(matches)
+				continue
+			}
+
+			plain.WriteByte(character)
+			index++
+
+		case character == '&':
+
+			// The entity references are decoded, the unknown ones are kept as text
+			entity := markdownEntityRegexp.FindString(rest)
+			if entity == "" {
+				entity = "&"
+			}
+
+			plain.WriteString(html.UnescapeString(entity))
+			index += len(entity)
+
+		case character == '*' || character == '_' || character == '~':
+
+			run := len(rest) - len(strings.TrimLeft(rest, string(character)))
+
+			flush()
+			elements = append(elements, newMarkdownDelimiter(text, index, run))
+			index += run
+
+		case character == '[' || (character == '!' && strings.HasPrefix(rest, "![")):
+
+			bracket := "["
+			if character == '!' {
+				bracket = "!["
+			}
+
+			flush()
+			elements = append(elements, &markdownInline{bracket: bracket})
+			index += len(bracket)
+
+		case character == ']':
+
+			flush()
+
+			var ok bool
+			if elements, index, ok = closeMarkdownBracket(elements, text, index); !ok {
+				plain.WriteByte(character)
+				index++
+			}
+
+		default:
+			plain.WriteByte(character)
+			index++
+		}
+	}
+
+	flush()
+
+	return processMarkdownEmphasis(elements)
+}
+
+func findMarkdownCodeClosing(text string, from, run int) int {
+
+	for index := from; index < len(text); {
+
+		if text[index] != '`' {
+			index++
+			continue
+		}
+
+		length := len(text[index:]) - len(strings.TrimLeft(text[index:], "`"))
+		if length == run {
+			return index
+		}
+
+		index += length
+	}
+
+	return -1
+}
+
+func newMarkdownDelimiter(text string, index, run int) *markdownInline {
+
+	before, after := ' ', ' '
+	if index > 0 {
+		before, _ = utf8.DecodeLastRuneInString(text[:index])
+	}
+
+	if index+run < len(text) {
+		after, _ = utf8.DecodeRuneInString(text[index+run:])
+	}
+
+	var (
+		leftFlanking = !unicode.IsSpace(after) &&
+			(!isMarkdownPunctuation(after) || unicode.IsSpace(before) || isMarkdownPunctuation(before))
+		rightFlanking = !unicode.IsSpace(before) &&
+			(!isMarkdownPunctuation(before) || unicode.IsSpace(after) || isMarkdownPunctuation(after))
+		element = &markdownInline{delimiter: text[index], count: run, canOpen: leftFlanking, canClose: rightFlanking}
+	)
+
+	// The underscores can't be used for intraword emphasis
+	if element.delimiter == '_' {
+		element.canOpen = leftFlanking && (!rightFlanking || isMarkdownPunctuation(before))
+		element.canClose = rightFlanking && (!leftFlanking || isMarkdownPunctuation(after))
+	}
+
+	return element
+}
+
+// closeMarkdownBracket matches the closing bracket with the last opening bracket and parses the inline link
+// destination, replacing the elements between them with a link or an image.
+func closeMarkdownBracket(elements []*markdownInline, text string, index int) ([]*markdownInline, int, bool) {
+
+	opener := -1
+	for position := len(elements) - 1; position >= 0; position-- {
+
+		if elements[position].bracket != "" && !elements[position].inactive {
+			opener = position
+			break
+		}
+	}
+
+	if opener < 0 {
+		return elements, index, false
+	}
+
+	destination, length, ok := parseMarkdownLinkDestination(text[index+1:])
+	if !ok {
+
+		// The opener is kept as text
+		elements[opener] = &markdownInline{text: elements[opener].bracket}
+		return elements, index, false
+	}
+
+	var (
+		children = processMarkdownEmphasis(append([]*markdownInline(nil), elements[opener+1:]...))
+		element  *markdownInline
+	)
+
+	if elements[opener].bracket == "![" {
+
+		media := &CommentNodeScheme{Type: ADFNodeMedia, Attrs: map[string]interface{}{"type": "external", "url": destination}}
+		if alternateText := markdownInlineText(children); alternateText != "" {
+			media.Attrs["alt"] = alternateText
+		}
+
+		element = &markdownInline{image: ADFMediaSingle("", media)}
+	} else {
+
+		element = &markdownInline{
+			mark:     &MarkScheme{Type: ADFMarkLink, Attrs: map[string]interface{}{"href": destination}},
+			children: children,
+		}
+
+		// The links can't contain other links
+		for _, previous := range elements[:opener] {
+			if previous.bracket == "[" {
+				previous.inactive = true
+			}
+		}
+	}
+
+	elements = append(elements[:opener], element)
+
+	return elements, index + 1 + length, true
+}
+
+// parseMarkdownLinkDestination parses the (destination "title") part of an inline link, the title is not used.
+func parseMarkdownLinkDestination(text string) (destination string, length int, ok bool) {
+
+	if !strings.HasPrefix(text, "(") {
+		return "", 0, false
+	}
+
+	index := 1
+	skipSpaces := func() {
+		for index < len(text) && (text[index] == ' ' || text[index] == '\n') {
+			index++
+		}
+	}
+
+	skipSpaces()
+
+	var builder strings.Builder
+
+	if index < len(text) && text[index] == '<' {
+
+		end := strings.IndexAny(text[index+1:], ">\n")
+		if end < 0 || text[index+1+end] != '>' {
+			return "", 0, false
+		}
+
+		builder.WriteString(text[index+1 : index+1+end])
+		index += end + 2
+	} else {
+
+		depth := 0
+		for ; index < len(text); index++ {
+
+			character := text[index]
+
+			if character == '\\' && index+1 < len(text) && isMarkdownPunctuation(rune(text[index+1])) {
+				builder.WriteByte(text[index+1])
+				index++
+				continue
+			}
+
+			if entity := markdownEntityRegexp.FindString(text[index:]); character == '&' && entity != "" {
+				builder.WriteString(html.UnescapeString(entity))
+				index += len(entity) - 1
+				continue
+			}
+
+			if character == ' ' || character == '\n' || (character == ')' && depth == 0) {
+				break
+			}
+
+			switch character {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+
+			builder.WriteByte(character)
+		}
+	}
+
+	skipSpaces()
+
+	if index < len(text) && (text[index] == '"' || text[index] == '\'' || text[index] == '(') {
+
+		closing := text[index]
+		if closing == '(' {
+			closing = ')'
+		}
+
+		end := strings.IndexByte(text[index+1:], closing)
+		if end < 0 {
+			return "", 0, false
+		}
+
+		index += end + 2
+		skipSpaces()
+	}
+
+	if index >= len(text) || text[index] != ')' {
+		return "", 0, false
+	}
+
+	return builder.String(), index + 1, true
+}
+
+// processMarkdownEmphasis matches the delimiter runs, wrapping the elements between them with the emphasis marks.
+func processMarkdownEmphasis(elements []*markdownInline) []*markdownInline {
+
+	for closer := 0; closer < len(elements); closer++ {
+
+		closing := elements[closer]
+		if closing.delimiter == 0 || !closing.canClose || closing.count == 0 {
+			continue
+		}
+
+		opener := -1
+		for position := closer - 1; position >= 0; position-- {
+
+			opening := elements[position]
+			if opening.delimiter != closing.delimiter || !opening.canOpen || opening.count == 0 {
+				continue
+			}
+
+			if closing.delimiter == '~' && opening.count != closing.count {
+				continue
+			}
+
+			// The rule of three avoids matching the runs that could be both opening and closing
+			if closing.delimiter != '~' && (opening.canClose || closing.canOpen) &&
+				(opening.count+closing.count)%3 == 0 && (opening.count%3 != 0 || closing.count%3 != 0) {
+				continue
+			}
+
+			opener = position
+			break
+		}
+
+		if opener < 0 {
+			continue
+		}
+
+		opening := elements[opener]
+
+		var mark *MarkScheme
+		use := 1
+
+		switch {
+		case closing.delimiter == '~':
+
+			if opening.count > 2 {
+				continue
+			}
+
+			use = opening.count
+			mark = &MarkScheme{Type: ADFMarkStrike}
+		case opening.count >= 2 && closing.count >= 2:
+
+			use = 2
+			mark = &MarkScheme{Type: ADFMarkStrong}
+		default:
+			mark = &MarkScheme{Type: ADFMarkEm}
+		}
+
+		opening.count -= use
+		closing.count -= use
+
+		element := &markdownInline{mark: mark, children: append([]*markdownInline(nil), elements[opener+1:closer]...)}
+
+		remaining := append([]*markdownInline{element}, elements[closer:]...)
+		elements = append(elements[:opener+1], remaining...)
+
+		// The closer is processed again if it still has delimiters
+		closer = opener + 1
+	}
+
+	return elements
+}
+
+func markdownInlineText(elements []*markdownInline) string {
+
+	var builder strings.Builder
+
+	for _, element := range elements {
+
+		switch {
+		case element.delimiter != 0:
+			builder.WriteString(strings.Repeat(string(element.delimiter), element.count))
+		case element.bracket != "":
+			builder.WriteString(element.bracket)
+		case element.card != "":
+			builder.WriteString(element.card)
+		case element.hardBreak:
+			builder.WriteString(" ")
+		default:
+			builder.WriteString(element.text)
+			builder.WriteString(markdownInlineText(element.children))
+		}
+	}
+
+	return builder.String()
+}
+
+func withDocumentMark(marks []*MarkScheme, mark *MarkScheme) []*MarkScheme {
+
+	for _, existing := range marks {
+		if existing.Type == mark.Type {
+			return marks
+		}
+	}
+
+	if mark.Type == ADFMarkLink && adfString(mark.Attrs["href"]) == "" {
+		return marks
+	}
+
+	return append(append([]*MarkScheme(nil), marks...), mark)
+}
+
+func flattenMarkdownInline(elements []*markdownInline, marks []*MarkScheme) (nodes []*CommentNodeScheme) {
+
+	text := func(value string, marks []*MarkScheme) {
+
+		if value != "" {
+			nodes = append(nodes, &CommentNodeScheme{Type: ADFNodeText, Text: value, Marks: marks})
+		}
+	}
+
+	for _, element := range elements {
+
+		switch {
+		case element.delimiter != 0:
+			text(strings.Repeat(string(element.delimiter), element.count), marks)
+
+		case element.bracket != "":
+			text(element.bracket, marks)
+
+		case element.hardBreak:
+			nodes = append(nodes, ADFHardBreak())
+
+		case element.card != "":
+			nodes = append(nodes, ADFInlineCard(element.card))
+
+		case element.image != nil:
+			nodes = append(nodes, element.image)
+
+		case element.code:
+
+			// The code mark can only be combined with the links, the code spans inside the emphasis keep
+			// the emphasis marks instead, e.g. **`code`** is a strong text
+			if len(marks) != 0 && !(len(marks) == 1 && hasLinkMark(marks)) {
+				text(element.text, marks)
+				continue
+			}
+
+			text(element.text, append([]*MarkScheme{{Type: ADFMarkCode}}, marks...))
+
+		case element.mark != nil:
+			nodes = append(nodes, flattenMarkdownInline(element.children, withDocumentMark(marks, element.mark))...)
+
+		default:
+
+			// The bare URLs are linked, as the GitHub Flavored Markdown does
+			nodes = append(nodes, linkBareURLs(element.text, marks)...)
+		}
+	}
+
+	return nodes
+}
+
+// linkBareURLs creates the text nodes of a text, adding the link mark to the URLs found on it.
+func linkBareURLs(value string, marks []*MarkScheme) (nodes []*CommentNodeScheme) {
+
+	text := func(value string, marks []*MarkScheme) {
+
+		if value != "" {
+			nodes = append(nodes, &CommentNodeScheme{Type: ADFNodeText, Text: value, Marks: marks})
+		}
+	}
+
+	if hasLinkMark(marks) {
+		text(value, marks)
+		return nodes
+	}
+
+	last := 0
+	for _, match := range markdownBareURLRegexp.FindAllStringIndex(value, -1) {
+
+		text(value[last:match[0]], marks)
+
+		link := value[match[0]:match[1]]
+		href := link
+		if strings.HasPrefix(href, "www.") {
+			href = "http://" + href
+		}
+
+		text(link, withDocumentMark(marks, &MarkScheme{Type: ADFMarkLink, Attrs: map[string]interface{}{"href": href}}))
+		last = match[1]
+	}
+
+	text(value[last:], marks)
+
+	return nodes
+}
+
+func hasLinkMark(marks []*MarkScheme) bool {
+
+	for _, mark := range marks {
+		if mark.Type == ADFMarkLink {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,261 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMarkdownToDocument(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		markdown string
+		wantJSON string
+	}{
+		{
+			name:     "MarkdownToDocumentWhenTheTextIsMarked",
+			markdown: "# Title\n\nHello **bold** and *it* `x()` ~~old~~ [docs](https://example.com)",
+			wantJSON: `{"version":1,"type":"doc","content":[
+				{"type":"heading","attrs":{"level":1},"content":[{"type":"text","text":"Title"}]},
+				{"type":"paragraph","content":[
+					{"type":"text","text":"Hello "},
+					{"type":"text","text":"bold","marks":[{"type":"strong"}]},
+					{"type":"text","text":" and "},
+					{"type":"text","text":"it","marks":[{"type":"em"}]},
+					{"type":"text","text":" "},
+					{"type":"text","text":"x()","marks":[{"type":"code"}]},
+					{"type":"text","text":" "},
+					{"type":"text","text":"old","marks":[{"type":"strike"}]},
+					{"type":"text","text":" "},
+					{"type":"text","text":"docs","marks":[{"type":"link","attrs":{"href":"https://example.com"}}]}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheListsAreNested",
+			markdown: "- one\n- two\n\n  3. three",
+			wantJSON: `{"version":1,"type":"doc","content":[{"type":"bulletList","content":[
+				{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"one"}]}]},
+				{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"two"}]},
+					{"type":"orderedList","attrs":{"order":3},"content":[{"type":"listItem","content":[
+						{"type":"paragraph","content":[{"type":"text","text":"three"}]}]}]}]}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheOrderedListFollowsAParagraph",
+			markdown: "two\n3. three\n1. one",
+			wantJSON: `{"version":1,"type":"doc","content":[
+				{"type":"paragraph","content":[{"type":"text","text":"two 3. three"}]},
+				{"type":"orderedList","content":[{"type":"listItem","content":[
+					{"type":"paragraph","content":[{"type":"text","text":"one"}]}]}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheListHasTasks",
+			markdown: "- [x] done\n- [ ] todo",
+			wantJSON: `{"version":1,"type":"doc","content":[{"type":"taskList","attrs":{"localId":"task-list-1"},"content":[
+				{"type":"taskItem","attrs":{"localId":"task-2","state":"DONE"},"content":[{"type":"text","text":"done"}]},
+				{"type":"taskItem","attrs":{"localId":"task-3","state":"TODO"},"content":[{"type":"text","text":"todo"}]}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheDocumentHasATable",
+			markdown: "| Key | Value |\n| --- | --- |\n| a | b |",
+			wantJSON: `{"version":1,"type":"doc","content":[{"type":"table","attrs":{"isNumberColumnEnabled":false,"layout":"default"},"content":[
+				{"type":"tableRow","content":[
+					{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Key"}]}]},
+					{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Value"}]}]}]},
+				{"type":"tableRow","content":[
+					{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"a"}]}]},
+					{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"b"}]}]}]}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheBlocksAreQuotedAndFenced",
+			markdown: "> quoted\n> more\n\n```go\nfmt.Println()\n```\n\n---\n\n    indented code",
+			wantJSON: `{"version":1,"type":"doc","content":[
+				{"type":"blockquote","content":[{"type":"paragraph","content":[{"type":"text","text":"quoted more"}]}]},
+				{"type":"codeBlock","attrs":{"language":"go"},"content":[{"type":"text","text":"fmt.Println()"}]},
+				{"type":"rule"},
+				{"type":"codeBlock","content":[{"type":"text","text":"indented code"}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheLinesAreBroken",
+			markdown: "Title\r\n=====\r\nline one  \nline two\\\nline three",
+			wantJSON: `{"version":1,"type":"doc","content":[
+				{"type":"heading","attrs":{"level":1},"content":[{"type":"text","text":"Title"}]},
+				{"type":"paragraph","content":[{"type":"text","text":"line one"},{"type":"hardBreak"},
+					{"type":"text","text":"line two"},{"type":"hardBreak"},{"type":"text","text":"line three"}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheURLsAreBare",
+			markdown: "see https://example.com/a, and <mailto:a@b.c>",
+			wantJSON: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[
+				{"type":"text","text":"see "},
+				{"type":"text","text":"https://example.com/a","marks":[{"type":"link","attrs":{"href":"https://example.com/a"}}]},
+				{"type":"text","text":", and "},
+				{"type":"inlineCard","attrs":{"url":"mailto:a@b.c"}}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheHeadingIsInsideAList",
+			markdown: "- # heading in list",
+			wantJSON: `{"version":1,"type":"doc","content":[{"type":"bulletList","content":[{"type":"listItem","content":[
+				{"type":"paragraph","content":[{"type":"text","text":"heading in list","marks":[{"type":"strong"}]}]}]}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheEmphasisIsNotClosed",
+			markdown: "**unclosed *emphasis",
+			wantJSON: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[
+				{"type":"text","text":"**unclosed *emphasis"}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheFenceIsNotClosed",
+			markdown: "```\nunclosed fence",
+			wantJSON: `{"version":1,"type":"doc","content":[{"type":"codeBlock","content":[{"type":"text","text":"unclosed fence"}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheLinkIsNotClosed",
+			markdown: "[unclosed link](https://example.com",
+			wantJSON: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[
+				{"type":"text","text":"[unclosed link]("},
+				{"type":"text","text":"https://example.com","marks":[{"type":"link","attrs":{"href":"https://example.com"}}]}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheTextIsEscaped",
+			markdown: `<b>raw</b> \*not em\*`,
+			wantJSON: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[
+				{"type":"text","text":"<b>raw</b> *not em*"}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheTextHasEntities",
+			markdown: "Tom &amp; Jerry &copy; &#35;1 &#x41; &bogus; `&amp;` [docs](https://example.com/?a=1&amp;b=2)",
+			wantJSON: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[
+				{"type":"text","text":"Tom & Jerry © #1 A &bogus; "},
+				{"type":"text","text":"&amp;","marks":[{"type":"code"}]},
+				{"type":"text","text":" "},
+				{"type":"text","text":"docs","marks":[{"type":"link","attrs":{"href":"https://example.com/?a=1&b=2"}}]}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheCodeIsEmphasized",
+			markdown: "**`strong`** *`em`* [`linked`](https://example.com)",
+			wantJSON: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[
+				{"type":"text","text":"strong","marks":[{"type":"strong"}]},
+				{"type":"text","text":" "},
+				{"type":"text","text":"em","marks":[{"type":"em"}]},
+				{"type":"text","text":" "},
+				{"type":"text","text":"linked","marks":[{"type":"code"},{"type":"link","attrs":{"href":"https://example.com"}}]}]}]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheTextIsBlank",
+			markdown: "  \n\n  ",
+			wantJSON: `{"version":1,"type":"doc","content":[]}`,
+		},
+
+		{
+			name:     "MarkdownToDocumentWhenTheTextIsEmpty",
+			markdown: "",
+			wantJSON: `{"version":1,"type":"doc","content":[]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			gotDocument, err := MarkdownToDocument(testCase.markdown)
+			assert.NoError(t, err)
+
+			gotJSON, err := json.Marshal(gotDocument)
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.wantJSON, string(gotJSON))
+		})
+	}
+}
+
+func TestMarkdownToDocument_RoundTrip(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{
+			name:     "RoundTripWhenTheTextIsMarked",
+			markdown: "# Title\n\nHello **bold** and *it* `x()` ~~old~~ [docs](https://example.com)",
+			want:     "# Title\n\nHello **bold** and *it* `x()` ~~old~~ [docs](https://example.com)",
+		},
+
+		{
+			name:     "RoundTripWhenTheDocumentHasBlocks",
+			markdown: "- one\n- two\n  1. three\n\n```go\nfmt.Println()\n```\n\n---\n\n| Key | Value |\n| --- | --- |\n| a | b |",
+			want:     "- one\n- two\n  1. three\n\n```go\nfmt.Println()\n```\n\n---\n\n| Key | Value |\n| --- | --- |\n| a | b |",
+		},
+
+		{
+			name:     "RoundTripWhenTheNestedListStartsAfterOne",
+			markdown: "- two\n\n  3. three",
+			want:     "- two\n\n  3. three",
+		},
+
+		{
+			name:     "RoundTripWhenTheListHasTasks",
+			markdown: "- [x] done\n- [ ] todo",
+			want:     "- [x] done\n- [ ] todo",
+		},
+
+		{
+			name:     "RoundTripWhenTheSyntaxIsNormalized",
+			markdown: "Title\n=====\n\n    indented code\n\nline one  \nline two",
+			want:     "# Title\n\n```\nindented code\n```\n\nline one\\\nline two",
+		},
+
+		{
+			name:     "RoundTripWhenTheURLIsBare",
+			markdown: "see https://example.com/a",
+			want:     "see [https://example.com/a](https://example.com/a)",
+		},
+
+		{
+			name:     "RoundTripWhenTheEmphasisIsNotClosed",
+			markdown: "**unclosed *emphasis",
+			want:     `\*\*unclosed \*emphasis`,
+		},
+
+		{
+			name:     "RoundTripWhenTheLinkIsNotClosed",
+			markdown: "[unclosed link](https://example.com",
+			want:     `\[unclosed link\]([https://example.com](https://example.com)`,
+		},
+
+		{
+			name:     "RoundTripWhenTheTextHasEntities",
+			markdown: "Tom &amp; Jerry \\&amp; [docs](https://example.com/?a=1\\&amp;b=2)",
+			want:     `Tom & Jerry \&amp; [docs](https://example.com/?a=1\&amp;b=2)`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			document, err := MarkdownToDocument(testCase.markdown)
+			assert.NoError(t, err)
+
+			gotMarkdown := document.Markdown(nil)
+			assert.Equal(t, testCase.want, gotMarkdown)
+
+			// The rendered Markdown is converted to the same document
+			gotDocument, err := MarkdownToDocument(gotMarkdown)
+			assert.NoError(t, err)
+			assert.Equal(t, document, gotDocument)
+		})
+	}
+}
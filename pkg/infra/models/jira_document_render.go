@@ -0,0 +1,938 @@
+package models
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DocumentRenderOptions customizes how the Atlassian Documents are rendered as Markdown, plain text or HTML.
+type DocumentRenderOptions struct {
+
+	// MentionResolver returns the display name of an account ID.
+	// The text stored on the mention is used when the resolver is nil or returns an empty name.
+	MentionResolver func(accountID string) string
+
+	// MediaResolver returns the URL of a media node, e.g. the content URL of the attachment.
+	// The media nodes without URL are rendered as a placeholder with the file name or ID.
+	MediaResolver func(media *CommentNodeScheme) string
+}
+
+// Markdown renders the document as CommonMark, using the GitHub Flavored Markdown syntax for the tables,
+// the strikethrough text and the task lists. The unknown node types are rendered through their children.
+func (n *CommentNodeScheme) Markdown(options *DocumentRenderOptions) string {
+
+	renderer := &markdownRenderer{options: renderOptions(options)}
+	return strings.TrimRight(renderer.block(n), "\n")
+}
+
+// PlainText renders the text of the document, keeping the paragraphs, the list markers and the table cells readable.
+func (n *CommentNodeScheme) PlainText(options *DocumentRenderOptions) string {
+
+	renderer := &plainTextRenderer{options: renderOptions(options)}
+	return strings.TrimRight(renderer.block(n), "\n")
+}
+
+// HTML renders the document as HTML. Every text and attribute is escaped and only the http, https and mailto
+// links are kept, so the result can be embedded on a page without further sanitization.
+func (n *CommentNodeScheme) HTML(options *DocumentRenderOptions) string {
+
+	renderer := &htmlRenderer{options: renderOptions(options)}
+
+	var builder strings.Builder
+	renderer.node(&builder, n)
+
+	return builder.String()
+}
+
+func renderOptions(options *DocumentRenderOptions) *DocumentRenderOptions {
+
+	if options == nil {
+		return &DocumentRenderOptions{}
+	}
+
+	return options
+}
+
+func (o *DocumentRenderOptions) mention(node *CommentNodeScheme) string {
+
+	var name string
+	if o.MentionResolver != nil {
+		name = o.MentionResolver(adfString(node.Attrs["id"]))
+	}
+
+	if name == "" {
+		name = adfString(node.Attrs["text"])
+	}
+
+	if name == "" {
+		name = adfString(node.Attrs["id"])
+	}
+
+	return "@" + strings.TrimPrefix(name, "@")
+}
+
+func (o *DocumentRenderOptions) media(node *CommentNodeScheme) (link, name string) {
+
+	if o.MediaResolver != nil {
+		link = o.MediaResolver(node)
+	}
+
+	if link == "" && adfString(node.Attrs["type"]) == "external" {
+		link = adfString(node.Attrs["url"])
+	}
+
+	for _, attribute := range []string{"alt", "name", "id"} {
+		if name = adfString(node.Attrs[attribute]); name != "" {
+			break
+		}
+	}
+
+	return link, name
+}
+
+func emojiText(node *CommentNodeScheme) string {
+
+	if text := adfString(node.Attrs["text"]); text != "" {
+		return text
+	}
+
+	return adfString(node.Attrs["shortName"])
+}
+
+// dateText returns the day of the date node, or the raw timestamp and false when it isn't a Unix time in milliseconds.
+// The raw timestamp comes from the document, it must be escaped by the renderers.
+func dateText(node *CommentNodeScheme) (string, bool) {
+
+	timestamp, err := strconv.ParseInt(adfString(node.Attrs["timestamp"]), 10, 64)
+	if err != nil {
+		return adfString(node.Attrs["timestamp"]), false
+	}
+
+	return time.Unix(0, timestamp*int64(time.Millisecond)).UTC().Format(DateFormatJiraDay), true
+}
+
+func hasMark(node *CommentNodeScheme, markType string) *MarkScheme {
+
+	for _, mark := range node.Marks {
+		if mark != nil && mark.Type == markType {
+			return mark
+		}
+	}
+
+	return nil
+}
+
+// mergeTextNodes joins the consecutive text nodes with the same marks, so the delimiters are not repeated.
+func mergeTextNodes(nodes []*CommentNodeScheme) []*CommentNodeScheme {
+
+	var merged []*CommentNodeScheme
+
+	for _, node := range nodes {
+
+		if node == nil {
+			continue
+		}
+
+		if last := len(merged) - 1; last >= 0 && node.Type == ADFNodeText && merged[last].Type == ADFNodeText &&
+			sameMarks(merged[last].Marks, node.Marks) {
+
+			joined := *merged[last]
+			joined.Text += node.Text
+			merged[last] = &joined
+			continue
+		}
+
+		merged = append(merged, node)
+	}
+
+	return merged
+}
+
+func sameMarks(marks, others []*MarkScheme) bool {
+
+	if len(marks) != len(others) {
+		return false
+	}
+
+	for index := range marks {
+
+		if marks[index] == nil || others[index] == nil || marks[index].Type != others[index].Type ||
+			fmt.Sprint(marks[index].Attrs) != fmt.Sprint(others[index].Attrs) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// splitSpaces separates the leading and trailing spaces of a text, the emphasis delimiters can't be next to them.
+func splitSpaces(text string) (leading, trimmed, trailing string) {
+
+	trimmed = strings.TrimLeft(text, " \t")
+	leading = text[:len(text)-len(trimmed)]
+
+	trimmed = strings.TrimRight(trimmed, " \t")
+	trailing = text[len(leading)+len(trimmed):]
+
+	return leading, trimmed, trailing
+}
+
+func indentLines(text, firstPrefix, prefix string) string {
+
+	lines := strings.Split(text, "\n")
+	for index, line := range lines {
+
+		switch {
+		case index == 0:
+			lines[index] = firstPrefix + line
+		case line == "":
+			lines[index] = strings.TrimRight(prefix, " ")
+		default:
+			lines[index] = prefix + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func listStart(node *CommentNodeScheme) int {
+
+	if order, ok := adfNumber(node.Attrs["order"]); ok && order > 0 {
+		return int(order)
+	}
+
+	return 1
+}
+
+type markdownRenderer struct {
+	options *DocumentRenderOptions
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "~", `\~`, "<", `\<`,
+)
+
+var markdownEntityTextRegexp = regexp.MustCompile(`&(?:#[0-9]{1,7}|#[xX][0-9A-Fa-f]{1,6}|[A-Za-z][A-Za-z0-9]{1,31});`)
+
+// escapeMarkdown escapes the characters of the text read as Markdown, the entity references as well.
+func escapeMarkdown(text string) string {
+	return markdownEntityTextRegexp.ReplaceAllString(markdownEscaper.Replace(text), `\$0`)
+}
+
+func (r *markdownRenderer) blocks(nodes []*CommentNodeScheme, separator string) string {
+
+	var blocks []string
+	for _, node := range nodes {
+
+		if block := r.block(node); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return strings.Join(blocks, separator)
+}
+
+func (r *markdownRenderer) block(node *CommentNodeScheme) string {
+
+	if node == nil {
+		return ""
+	}
+
+	switch node.Type {
+	case ADFNodeParagraph, ADFNodeTaskItem, ADFNodeDecisionItem:
+		return escapeMarkdownBlockStart(r.inline(node.Content))
+
+	case ADFNodeHeading:
+
+		level, _ := adfNumber(node.Attrs["level"])
+		if level < 1 || level > adfMaxHeadingLevel {
+			level = 1
+		}
+
+		return strings.Repeat("#", int(level)) + " " + r.inline(node.Content)
+
+	case ADFNodeBulletList, ADFNodeOrderedList, ADFNodeTaskList, ADFNodeDecisionList:
+		return r.list(node)
+
+	case ADFNodeCodeBlock:
+
+		code := strings.TrimRight(textContent(node), "\n")
+
+		fence := "```"
+		for strings.Contains(code, fence) {
+			fence += "`"
+		}
+
+		return fence + adfString(node.Attrs["language"]) + "\n" + code + "\n" + fence
+
+	case ADFNodeBlockquote:
+		return indentLines(r.blocks(node.Content, "\n\n"), "> ", "> ")
+
+	case ADFNodePanel:
+
+		content := r.blocks(node.Content, "\n\n")
+		if panelType := adfString(node.Attrs["panelType"]); panelType != "" {
+			content = "**" + strings.ToUpper(panelType[:1]) + panelType[1:] + ":**\n" + content
+		}
+
+		return indentLines(content, "> ", "> ")
+
+	case ADFNodeExpand, ADFNodeNestedExpand:
+
+		content := r.blocks(node.Content, "\n\n")
+		if title := adfString(node.Attrs["title"]); title != "" {
+			return "**" + escapeMarkdown(title) + "**\n\n" + content
+		}
+
+		return content
+
+	case ADFNodeRule:
+		return "---"
+
+	case ADFNodeTable:
+		return r.table(node)
+
+	case ADFNodeMediaSingle, ADFNodeMediaGroup:
+		return r.inline(node.Content)
+
+	case ADFNodeBlockCard, ADFNodeEmbedCard:
+		return r.inline([]*CommentNodeScheme{{Type: ADFNodeInlineCard, Attrs: node.Attrs}})
+	}
+
+	if adfInlineTypes[node.Type] || node.Type == ADFNodeMedia {
+		return r.inline([]*CommentNodeScheme{node})
+	}
+
+	// The document and the unknown nodes are rendered through their children
+	return r.blocks(node.Content, "\n\n")
+}
+
+// escapeMarkdownBlockStart escapes the characters that would turn a paragraph into another block.
+func escapeMarkdownBlockStart(text string) string {
+
+	trimmed := strings.TrimLeft(text, " ")
+
+	switch {
+	case strings.HasPrefix(trimmed, "#"), strings.HasPrefix(trimmed, ">"), strings.HasPrefix(trimmed, "- "),
+		strings.HasPrefix(trimmed, "+ "), trimmed == "-", trimmed == "---":
+		return `\` + trimmed
+	}
+
+	if digits := len(trimmed) - len(strings.TrimLeft(trimmed, "0123456789")); digits != 0 && digits < len(trimmed) &&
+		(trimmed[digits] == '.' || trimmed[digits] == ')') {
+		return trimmed[:digits] + `\` + trimmed[digits:]
+	}
+
+	return text
+}
+
+func (r *markdownRenderer) list(node *CommentNodeScheme) string {
+
+	var (
+		items []string
+		start = listStart(node)
+	)
+
+	for index, item := range node.Content {
+
+		if item == nil {
+			continue
+		}
+
+		marker := "- "
+		switch {
+		case node.Type == ADFNodeOrderedList:
+			marker = strconv.Itoa(start+index) + ". "
+		case item.Type == ADFNodeTaskItem && adfString(item.Attrs["state"]) == "DONE":
+			marker = "- [x] "
+		case item.Type == ADFNodeTaskItem:
+			marker = "- [ ] "
+		}
+
+		var content string
+		switch item.Type {
+		case ADFNodeListItem:
+			content = r.listItem(item)
+		case ADFNodeTaskList, ADFNodeBulletList, ADFNodeOrderedList:
+
+			// The nested task lists are direct children of the list
+			items = append(items, indentLines(r.block(item), "  ", "  "))
+			continue
+		default:
+			content = r.block(item)
+		}
+
+		items = append(items, indentLines(content, marker, strings.Repeat(" ", len(marker))))
+	}
+
+	return strings.Join(items, "\n")
+}
+
+func (r *markdownRenderer) listItem(item *CommentNodeScheme) string {
+
+	var content strings.Builder
+
+	for index, child := range item.Content {
+
+		block := r.block(child)
+		if block == "" {
+			continue
+		}
+
+		if index != 0 {
+
+			// The nested lists keep the list tight, other blocks need a blank line. An ordered list starting
+			// after 1 can't interrupt a paragraph, it would be read as the text of the paragraph.
+			interrupts := child.Type != ADFNodeOrderedList || listStart(child) == 1 ||
+				item.Content[index-1] == nil || item.Content[index-1].Type != ADFNodeParagraph
+
+			if interrupts && (child.Type == ADFNodeBulletList || child.Type == ADFNodeOrderedList || child.Type == ADFNodeTaskList) {
+				content.WriteString("\n")
+			} else {
+				content.WriteString("\n\n")
+			}
+		}
+
+		content.WriteString(block)
+	}
+
+	return content.String()
+}
+
+func (r *markdownRenderer) table(node *CommentNodeScheme) string {
+
+	var (
+		rows    [][]string
+		columns int
+	)
+
+	for _, row := range node.Content {
+
+		if row == nil {
+			continue
+		}
+
+		var cells []string
+		for _, cell := range row.Content {
+
+			text := strings.ReplaceAll(r.blocks(cellContent(cell), "\n"), "\n", "<br>")
+			cells = append(cells, strings.ReplaceAll(text, "|", `\|`))
+		}
+
+		if len(cells) > columns {
+			columns = len(cells)
+		}
+
+		rows = append(rows, cells)
+	}
+
+	if len(rows) == 0 {
+		return ""
+	}
+
+	var lines []string
+	for index, cells := range rows {
+
+		for len(cells) < columns {
+			cells = append(cells, "")
+		}
+
+		lines = append(lines, "| "+strings.Join(cells, " | ")+" |")
+
+		// The first row is always the header on Markdown
+		if index == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", columns))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func cellContent(cell *CommentNodeScheme) []*CommentNodeScheme {
+
+	if cell == nil {
+		return nil
+	}
+
+	return cell.Content
+}
+
+func (r *markdownRenderer) inline(nodes []*CommentNodeScheme) string {
+
+	var builder strings.Builder
+
+	for _, node := range mergeTextNodes(nodes) {
+
+		switch node.Type {
+		case ADFNodeText:
+			builder.WriteString(r.text(node))
+		case ADFNodeHardBreak:
+			builder.WriteString("\\\n")
+		case ADFNodeMention:
+			builder.WriteString(escapeMarkdown(r.options.mention(node)))
+		case ADFNodeEmoji:
+			builder.WriteString(emojiText(node))
+		case ADFNodeInlineCard:
+			builder.WriteString("<" + adfString(node.Attrs["url"]) + ">")
+		case ADFNodeStatus:
+			builder.WriteString("`" + strings.ToUpper(adfString(node.Attrs["text"])) + "`")
+		case ADFNodeDate:
+			text, _ := dateText(node)
+			builder.WriteString(escapeMarkdown(text))
+		case ADFNodePlaceholder:
+			builder.WriteString(escapeMarkdown(adfString(node.Attrs["text"])))
+		case ADFNodeMedia:
+
+			link, name := r.options.media(node)
+			if link != "" {
+				builder.WriteString("![" + escapeMarkdown(name) + "](" + markdownDestination(link) + ")")
+			} else {
+				builder.WriteString(escapeMarkdown("[" + name + "]"))
+			}
+		default:
+
+			if node.Text != "" {
+				builder.WriteString(escapeMarkdown(node.Text))
+			}
+
+			builder.WriteString(r.inline(node.Content))
+		}
+	}
+
+	return builder.String()
+}
+
+func (r *markdownRenderer) text(node *CommentNodeScheme) string {
+
+	leading, text, trailing := splitSpaces(node.Text)
+	if text == "" {
+		return node.Text
+	}
+
+	if hasMark(node, ADFMarkCode) != nil {
+
+		fence := "`"
+		for strings.Contains(text, fence) {
+			fence += "`"
+		}
+
+		if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+			text = " " + text + " "
+		}
+
+		text = fence + text + fence
+	} else {
+		text = escapeMarkdown(text)
+	}
+
+	if hasMark(node, ADFMarkStrike) != nil {
+		text = "~~" + text + "~~"
+	}
+
+	if hasMark(node, ADFMarkEm) != nil {
+		text = "*" + text + "*"
+	}
+
+	if hasMark(node, ADFMarkStrong) != nil {
+		text = "**" + text + "**"
+	}
+
+	if link := hasMark(node, ADFMarkLink); link != nil {
+		text = "[" + text + "](" + markdownDestination(adfString(link.Attrs["href"])) + ")"
+	}
+
+	return leading + text + trailing
+}
+
+// markdownDestination encloses the link destinations with spaces or parentheses in angle brackets.
+func markdownDestination(link string) string {
+
+	if strings.ContainsAny(link, " ()<>") {
+		return "<" + strings.NewReplacer("<", "%3C", ">", "%3E").Replace(link) + ">"
+	}
+
+	return markdownEntityTextRegexp.ReplaceAllString(link, `\$0`)
+}
+
+// textContent returns the text nodes of a node without formatting, e.g. the code of a code block.
+func textContent(node *CommentNodeScheme) string {
+
+	var builder strings.Builder
+
+	builder.WriteString(node.Text)
+	for _, child := range node.Content {
+		if child != nil {
+			builder.WriteString(textContent(child))
+		}
+	}
+
+	return builder.String()
+}
+
+type plainTextRenderer struct {
+	options *DocumentRenderOptions
+}
+
+func (r *plainTextRenderer) blocks(nodes []*CommentNodeScheme, separator string) string {
+
+	var blocks []string
+	for _, node := range nodes {
+
+		if block := r.block(node); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return strings.Join(blocks, separator)
+}
+
+func (r *plainTextRenderer) block(node *CommentNodeScheme) string {
+
+	if node == nil {
+		return ""
+	}
+
+	switch node.Type {
+	case ADFNodeParagraph, ADFNodeHeading, ADFNodeTaskItem, ADFNodeDecisionItem:
+		return r.inline(node.Content)
+
+	case ADFNodeBulletList, ADFNodeOrderedList, ADFNodeTaskList, ADFNodeDecisionList:
+
+		var (
+			items []string
+			start = listStart(node)
+		)
+
+		for index, item := range node.Content {
+
+			if item == nil {
+				continue
+			}
+
+			marker := "- "
+			if node.Type == ADFNodeOrderedList {
+				marker = strconv.Itoa(start+index) + ". "
+			}
+
+			items = append(items, indentLines(r.blocks(item.Content, "\n"), marker, strings.Repeat(" ", len(marker))))
+		}
+
+		return strings.Join(items, "\n")
+
+	case ADFNodeCodeBlock:
+		return strings.TrimRight(textContent(node), "\n")
+
+	case ADFNodeBlockquote, ADFNodePanel:
+		return indentLines(r.blocks(node.Content, "\n\n"), "  ", "  ")
+
+	case ADFNodeExpand, ADFNodeNestedExpand:
+
+		content := r.blocks(node.Content, "\n\n")
+		if title := adfString(node.Attrs["title"]); title != "" {
+			return title + "\n" + content
+		}
+
+		return content
+
+	case ADFNodeRule:
+		return "----"
+
+	case ADFNodeTable:
+
+		var rows []string
+		for _, row := range node.Content {
+
+			if row == nil {
+				continue
+			}
+
+			var cells []string
+			for _, cell := range row.Content {
+				cells = append(cells, strings.ReplaceAll(r.blocks(cellContent(cell), " "), "\n", " "))
+			}
+
+			rows = append(rows, strings.Join(cells, " | "))
+		}
+
+		return strings.Join(rows, "\n")
+
+	case ADFNodeMediaSingle, ADFNodeMediaGroup:
+		return r.inline(node.Content)
+
+	case ADFNodeBlockCard, ADFNodeEmbedCard:
+		return adfString(node.Attrs["url"])
+	}
+
+	if adfInlineTypes[node.Type] || node.Type == ADFNodeMedia {
+		return r.inline([]*CommentNodeScheme{node})
+	}
+
+	return r.blocks(node.Content, "\n\n")
+}
+
+func (r *plainTextRenderer) inline(nodes []*CommentNodeScheme) string {
+
+	var builder strings.Builder
+
+	for _, node := range nodes {
+
+		if node == nil {
+			continue
+		}
+
+		switch node.Type {
+		case ADFNodeText:
+			builder.WriteString(node.Text)
+		case ADFNodeHardBreak:
+			builder.WriteString("\n")
+		case ADFNodeMention:
+			builder.WriteString(r.options.mention(node))
+		case ADFNodeEmoji:
+			builder.WriteString(emojiText(node))
+		case ADFNodeInlineCard:
+			builder.WriteString(adfString(node.Attrs["url"]))
+		case ADFNodeStatus, ADFNodePlaceholder:
+			builder.WriteString(adfString(node.Attrs["text"]))
+		case ADFNodeDate:
+			text, _ := dateText(node)
+			builder.WriteString(text)
+		case ADFNodeMedia:
+
+			link, name := r.options.media(node)
+			if link != "" {
+				builder.WriteString(link)
+			} else {
+				builder.WriteString("[" + name + "]")
+			}
+		default:
+			builder.WriteString(node.Text)
+			builder.WriteString(r.inline(node.Content))
+		}
+	}
+
+	return builder.String()
+}
+
+type htmlRenderer struct {
+	options *DocumentRenderOptions
+}
+
+var htmlBlockTags = map[string]string{
+	ADFNodeParagraph:    "p",
+	ADFNodeBulletList:   "ul",
+	ADFNodeOrderedList:  "ol",
+	ADFNodeListItem:     "li",
+	ADFNodeBlockquote:   "blockquote",
+	ADFNodeTable:        "table",
+	ADFNodeTableRow:     "tr",
+	ADFNodeTableHeader:  "th",
+	ADFNodeTableCell:    "td",
+	ADFNodeTaskList:     "ul",
+	ADFNodeTaskItem:     "li",
+	ADFNodeDecisionList: "ul",
+	ADFNodeDecisionItem: "li",
+	ADFNodeMediaSingle:  "figure",
+	ADFNodeMediaGroup:   "div",
+}
+
+// safeURL returns the escaped URL when it uses an allowed scheme, or an empty string otherwise.
+func safeURL(link string) string {
+
+	parsed, err := url.Parse(strings.TrimSpace(link))
+	if err != nil {
+		return ""
+	}
+
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https", "mailto":
+		return html.EscapeString(parsed.String())
+	}
+
+	return ""
+}
+
+func (r *htmlRenderer) children(builder *strings.Builder, node *CommentNodeScheme) {
+
+	for _, child := range mergeTextNodes(node.Content) {
+		r.node(builder, child)
+	}
+}
+
+func (r *htmlRenderer) node(builder *strings.Builder, node *CommentNodeScheme) {
+
+	if node == nil {
+		return
+	}
+
+	if tag, ok := htmlBlockTags[node.Type]; ok {
+
+		builder.WriteString("<" + tag)
+
+		switch node.Type {
+		case ADFNodeOrderedList:
+
+			if start := listStart(node); start != 1 {
+				builder.WriteString(fmt.Sprintf(` start="%d"`, start))
+			}
+		case ADFNodeTaskItem:
+
+			checked := ""
+			if adfString(node.Attrs["state"]) == "DONE" {
+				checked = " checked"
+			}
+
+			builder.WriteString(`><input type="checkbox" disabled` + checked)
+		}
+
+		builder.WriteString(">")
+		r.children(builder, node)
+		builder.WriteString("</" + tag + ">")
+		return
+	}
+
+	switch node.Type {
+	case ADFNodeHeading:
+
+		level, _ := adfNumber(node.Attrs["level"])
+		if level < 1 || level > adfMaxHeadingLevel {
+			level = 1
+		}
+
+		builder.WriteString(fmt.Sprintf("<h%d>", int(level)))
+		r.children(builder, node)
+		builder.WriteString(fmt.Sprintf("</h%d>", int(level)))
+
+	case ADFNodeCodeBlock:
+
+		builder.WriteString("<pre><code")
+		if language := adfString(node.Attrs["language"]); language != "" {
+			builder.WriteString(` class="language-` + html.EscapeString(language) + `"`)
+		}
+
+		builder.WriteString(">" + html.EscapeString(textContent(node)) + "</code></pre>")
+
+	case ADFNodePanel:
+
+		builder.WriteString(`<div class="panel panel-` + html.EscapeString(adfString(node.Attrs["panelType"])) + `">`)
+		r.children(builder, node)
+		builder.WriteString("</div>")
+
+	case ADFNodeExpand, ADFNodeNestedExpand:
+
+		builder.WriteString("<details><summary>" + html.EscapeString(adfString(node.Attrs["title"])) + "</summary>")
+		r.children(builder, node)
+		builder.WriteString("</details>")
+
+	case ADFNodeRule:
+		builder.WriteString("<hr>")
+
+	case ADFNodeText:
+		builder.WriteString(r.text(node))
+
+	case ADFNodeHardBreak:
+		builder.WriteString("<br>")
+
+	case ADFNodeMention:
+		builder.WriteString(`<span class="mention" data-account-id="` + html.EscapeString(adfString(node.Attrs["id"])) + `">` +
+			html.EscapeString(r.options.mention(node)) + "</span>")
+
+	case ADFNodeEmoji:
+		builder.WriteString(`<span class="emoji">` + html.EscapeString(emojiText(node)) + "</span>")
+
+	case ADFNodeInlineCard, ADFNodeBlockCard, ADFNodeEmbedCard:
+
+		link := safeURL(adfString(node.Attrs["url"]))
+		if link == "" {
+			builder.WriteString(html.EscapeString(adfString(node.Attrs["url"])))
+			return
+		}
+
+		builder.WriteString(`<a href="` + link + `" rel="nofollow noopener">` + link + "</a>")
+
+	case ADFNodeStatus:
+		builder.WriteString(`<span class="status status-` + html.EscapeString(adfString(node.Attrs["color"])) + `">` +
+			html.EscapeString(adfString(node.Attrs["text"])) + "</span>")
+
+	case ADFNodeDate:
+
+		// Only the parsed dates are rendered as a time element, the malformed timestamps are escaped as text
+		text, ok := dateText(node)
+		if !ok {
+			builder.WriteString(html.EscapeString(text))
+			return
+		}
+
+		builder.WriteString(`<time datetime="` + text + `">` + text + "</time>")
+
+	case ADFNodePlaceholder:
+		builder.WriteString(html.EscapeString(adfString(node.Attrs["text"])))
+
+	case ADFNodeMedia:
+
+		link, name := r.options.media(node)
+		if link = safeURL(link); link != "" {
+			builder.WriteString(`<img src="` + link + `" alt="` + html.EscapeString(name) + `">`)
+		} else {
+			builder.WriteString(`<span class="media">` + html.EscapeString("["+name+"]") + "</span>")
+		}
+
+	default:
+
+		// The document and the unknown nodes are rendered through their children
+		builder.WriteString(html.EscapeString(node.Text))
+		r.children(builder, node)
+	}
+}
+
+func (r *htmlRenderer) text(node *CommentNodeScheme) string {
+
+	text := html.EscapeString(node.Text)
+
+	if hasMark(node, ADFMarkCode) != nil {
+		text = "<code>" + text + "</code>"
+	}
+
+	if hasMark(node, ADFMarkStrike) != nil {
+		text = "<s>" + text + "</s>"
+	}
+
+	if hasMark(node, ADFMarkUnderline) != nil {
+		text = "<u>" + text + "</u>"
+	}
+
+	if hasMark(node, ADFMarkEm) != nil {
+		text = "<em>" + text + "</em>"
+	}
+
+	if hasMark(node, ADFMarkStrong) != nil {
+		text = "<strong>" + text + "</strong>"
+	}
+
+	if mark := hasMark(node, ADFMarkSubSup); mark != nil {
+
+		if tag := adfString(mark.Attrs["type"]); tag == "sub" || tag == "sup" {
+			text = "<" + tag + ">" + text + "</" + tag + ">"
+		}
+	}
+
+	if mark := hasMark(node, ADFMarkTextColor); mark != nil {
+
+		if color := adfString(mark.Attrs["color"]); adfColorRegexp.MatchString(color) {
+			text = `<span style="color: ` + color + `">` + text + "</span>"
+		}
+	}
+
+	if mark := hasMark(node, ADFMarkLink); mark != nil {
+
+		if link := safeURL(adfString(mark.Attrs["href"])); link != "" {
+			text = `<a href="` + link + `" rel="nofollow noopener">` + text + "</a>"
+		}
+	}
+
+	return text
+}
@@ -0,0 +1,334 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// mockRenderDocuments returns the documents rendered by the tests, keyed by the case of their content.
+func mockRenderDocuments() map[string]*CommentNodeScheme {
+
+	document := func(nodes ...*CommentNodeScheme) *CommentNodeScheme {
+		return &CommentNodeScheme{Version: 1, Type: ADFNodeDoc, Content: nodes}
+	}
+
+	return map[string]*CommentNodeScheme{
+		"Marks": document(ADFParagraph(ADFText("Hello "), ADFText("bo").Strong(), ADFText("ld").Strong(), ADFText(" and "),
+			ADFText("it").Em(), ADFText(" "), ADFText("x()").Code(), ADFText(" "), ADFText("old").Strike(), ADFText(" "),
+			ADFText("docs").Link("https://example.com/a b"))),
+
+		"Blocks": document(
+			ADFHeading(2, ADFText("Title")),
+			ADFBulletList(ADFListItem(ADFText("one")), ADFListItem(ADFText("two"), ADFOrderedList(3, ADFListItem(ADFText("three"))))),
+			ADFCodeBlock("go", `fmt.Println("<x>")`),
+			ADFBlockquote(ADFText("quoted")),
+			ADFRule(),
+			ADFPanel(ADFPanelInfo, ADFText("Heads up")),
+		),
+
+		"Table": document(ADFTable(
+			ADFTableRow(ADFTableHeader(ADFText("Key")), ADFTableHeader(ADFText("Value"))),
+			ADFTableRow(ADFTableCell(ADFText("a|b")), ADFTableCell(ADFDate(time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC)))),
+		)),
+
+		"Inline": document(ADFParagraph(ADFMention("acc-1", "@Mia"), ADFText(" "), ADFMention("acc-2", ""), ADFText(" "),
+			ADFEmoji(":smile:", "😄"), ADFText(" "), ADFEmoji(":wave:", ""), ADFText(" "), ADFStatus("DONE", ADFStatusGreen),
+			ADFHardBreak(), ADFInlineCard("https://example.com"))),
+
+		"Media": document(
+			ADFMediaSingle("", &CommentNodeScheme{Type: ADFNodeMedia, Attrs: map[string]interface{}{"id": "6e7c", "type": "file", "alt": "screen.png"}}),
+			ADFMediaSingle("", &CommentNodeScheme{Type: ADFNodeMedia, Attrs: map[string]interface{}{"type": "external", "url": "https://example.com/a.png"}}),
+		),
+
+		"Escaped": document(ADFParagraph(ADFText("# not a heading *star* <b>")), ADFParagraph(ADFText("1. not a list"))),
+
+		"Unsafe": document(ADFParagraph(ADFText("evil").Link("javascript:alert(1)"), ADFText(`"><script>`))),
+
+		"HostileDate": document(ADFParagraph(&CommentNodeScheme{Type: ADFNodeDate,
+			Attrs: map[string]interface{}{"timestamp": `"><script>alert(1)</script>`}})),
+
+		"Tasks": document(&CommentNodeScheme{Type: ADFNodeTaskList, Content: []*CommentNodeScheme{
+			{Type: ADFNodeTaskItem, Attrs: map[string]interface{}{"state": "DONE"}, Content: []*CommentNodeScheme{ADFText("done")}},
+			{Type: ADFNodeTaskItem, Attrs: map[string]interface{}{"state": "TODO"}, Content: []*CommentNodeScheme{ADFText("todo")}},
+		}}),
+
+		"Unknown": document(&CommentNodeScheme{Type: "widget", Content: []*CommentNodeScheme{ADFParagraph(ADFText("inside"))}}, nil),
+
+		"Empty": document(),
+	}
+}
+
+var mockRenderOptions = &DocumentRenderOptions{
+	MentionResolver: func(accountID string) string {
+
+		if accountID == "acc-2" {
+			return "Bob"
+		}
+
+		return ""
+	},
+	MediaResolver: func(media *CommentNodeScheme) string {
+
+		if adfString(media.Attrs["id"]) == "6e7c" {
+			return "https://example.com/attachment/6e7c"
+		}
+
+		return ""
+	},
+}
+
+func TestCommentNodeScheme_Markdown(t *testing.T) {
+
+	documents := mockRenderDocuments()
+
+	testCases := []struct {
+		name     string
+		document string
+		options  *DocumentRenderOptions
+		want     string
+	}{
+		{
+			name:     "MarkdownWhenTheTextIsMarked",
+			document: "Marks",
+			want:     "Hello **bold** and *it* `x()` ~~old~~ [docs](<https://example.com/a b>)",
+		},
+
+		{
+			name:     "MarkdownWhenTheDocumentHasBlocks",
+			document: "Blocks",
+			want: "## Title\n\n- one\n- two\n\n  3. three\n\n```go\nfmt.Println(\"<x>\")\n```\n\n> quoted\n\n---\n\n" +
+				"> **Info:**\n> Heads up",
+		},
+
+		{
+			name:     "MarkdownWhenTheDocumentHasATable",
+			document: "Table",
+			want:     "| Key | Value |\n| --- | --- |\n| a\\|b | 2022-01-07 |",
+		},
+
+		{
+			name:     "MarkdownWhenTheInlineNodesAreNotResolved",
+			document: "Inline",
+			want:     "@Mia @acc-2 😄 :wave: `DONE`\\\n<https://example.com>",
+		},
+
+		{
+			name:     "MarkdownWhenTheInlineNodesAreResolved",
+			document: "Inline",
+			options:  mockRenderOptions,
+			want:     "@Mia @Bob 😄 :wave: `DONE`\\\n<https://example.com>",
+		},
+
+		{
+			name:     "MarkdownWhenTheMediaIsNotResolved",
+			document: "Media",
+			want:     "\\[screen.png\\]\n\n![](https://example.com/a.png)",
+		},
+
+		{
+			name:     "MarkdownWhenTheMediaIsResolved",
+			document: "Media",
+			options:  mockRenderOptions,
+			want:     "![screen.png](https://example.com/attachment/6e7c)\n\n![](https://example.com/a.png)",
+		},
+
+		{
+			name:     "MarkdownWhenTheTextLooksLikeMarkdown",
+			document: "Escaped",
+			want:     "\\# not a heading \\*star\\* \\<b>\n\n1\\. not a list",
+		},
+
+		{
+			name:     "MarkdownWhenTheDateIsHostile",
+			document: "HostileDate",
+			want:     `">\<script>alert(1)\</script>`,
+		},
+
+		{
+			name:     "MarkdownWhenTheDocumentHasTasks",
+			document: "Tasks",
+			want:     "- [x] done\n- [ ] todo",
+		},
+
+		{
+			name:     "MarkdownWhenTheNodeTypeIsUnknown",
+			document: "Unknown",
+			want:     "inside",
+		},
+
+		{
+			name:     "MarkdownWhenTheDocumentIsEmpty",
+			document: "Empty",
+			want:     "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, documents[testCase.document].Markdown(testCase.options))
+		})
+	}
+}
+
+func TestCommentNodeScheme_PlainText(t *testing.T) {
+
+	documents := mockRenderDocuments()
+
+	testCases := []struct {
+		name     string
+		document string
+		options  *DocumentRenderOptions
+		want     string
+	}{
+		{
+			name:     "PlainTextWhenTheTextIsMarked",
+			document: "Marks",
+			want:     "Hello bold and it x() old docs",
+		},
+
+		{
+			name:     "PlainTextWhenTheDocumentHasBlocks",
+			document: "Blocks",
+			want:     "Title\n\n- one\n- two\n  3. three\n\nfmt.Println(\"<x>\")\n\n  quoted\n\n----\n\n  Heads up",
+		},
+
+		{
+			name:     "PlainTextWhenTheDocumentHasATable",
+			document: "Table",
+			want:     "Key | Value\na|b | 2022-01-07",
+		},
+
+		{
+			name:     "PlainTextWhenTheInlineNodesAreResolved",
+			document: "Inline",
+			options:  mockRenderOptions,
+			want:     "@Mia @Bob 😄 :wave: DONE\nhttps://example.com",
+		},
+
+		{
+			name:     "PlainTextWhenTheMediaIsNotResolved",
+			document: "Media",
+			want:     "[screen.png]\n\nhttps://example.com/a.png",
+		},
+
+		{
+			name:     "PlainTextWhenTheTextLooksLikeMarkdown",
+			document: "Escaped",
+			want:     "# not a heading *star* <b>\n\n1. not a list",
+		},
+
+		{
+			name:     "PlainTextWhenTheDocumentHasTasks",
+			document: "Tasks",
+			want:     "- done\n- todo",
+		},
+
+		{
+			name:     "PlainTextWhenTheNodeTypeIsUnknown",
+			document: "Unknown",
+			want:     "inside",
+		},
+
+		{
+			name:     "PlainTextWhenTheDocumentIsEmpty",
+			document: "Empty",
+			want:     "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, documents[testCase.document].PlainText(testCase.options))
+		})
+	}
+}
+
+func TestCommentNodeScheme_HTML(t *testing.T) {
+
+	documents := mockRenderDocuments()
+
+	testCases := []struct {
+		name     string
+		document string
+		options  *DocumentRenderOptions
+		want     string
+	}{
+		{
+			name:     "HTMLWhenTheTextIsMarked",
+			document: "Marks",
+			want: `<p>Hello <strong>bold</strong> and <em>it</em> <code>x()</code> <s>old</s> ` +
+				`<a href="https://example.com/a%20b" rel="nofollow noopener">docs</a></p>`,
+		},
+
+		{
+			name:     "HTMLWhenTheDocumentHasBlocks",
+			document: "Blocks",
+			want: `<h2>Title</h2><ul><li><p>one</p></li><li><p>two</p><ol start="3"><li><p>three</p></li></ol></li></ul>` +
+				`<pre><code class="language-go">fmt.Println(&#34;&lt;x&gt;&#34;)</code></pre><blockquote><p>quoted</p></blockquote>` +
+				`<hr><div class="panel panel-info"><p>Heads up</p></div>`,
+		},
+
+		{
+			name:     "HTMLWhenTheDocumentHasATable",
+			document: "Table",
+			want: `<table><tr><th><p>Key</p></th><th><p>Value</p></th></tr>` +
+				`<tr><td><p>a|b</p></td><td><p><time datetime="2022-01-07">2022-01-07</time></p></td></tr></table>`,
+		},
+
+		{
+			name:     "HTMLWhenTheInlineNodesAreResolved",
+			document: "Inline",
+			options:  mockRenderOptions,
+			want: `<p><span class="mention" data-account-id="acc-1">@Mia</span> ` +
+				`<span class="mention" data-account-id="acc-2">@Bob</span> <span class="emoji">😄</span> ` +
+				`<span class="emoji">:wave:</span> <span class="status status-green">DONE</span><br>` +
+				`<a href="https://example.com" rel="nofollow noopener">https://example.com</a></p>`,
+		},
+
+		{
+			name:     "HTMLWhenTheMediaIsResolved",
+			document: "Media",
+			options:  mockRenderOptions,
+			want: `<figure><img src="https://example.com/attachment/6e7c" alt="screen.png"></figure>` +
+				`<figure><img src="https://example.com/a.png" alt=""></figure>`,
+		},
+
+		{
+			name:     "HTMLWhenTheLinkIsUnsafe",
+			document: "Unsafe",
+			want:     `<p>evil&#34;&gt;&lt;script&gt;</p>`,
+		},
+
+		{
+			name:     "HTMLWhenTheDateIsHostile",
+			document: "HostileDate",
+			want:     `<p>&#34;&gt;&lt;script&gt;alert(1)&lt;/script&gt;</p>`,
+		},
+
+		{
+			name:     "HTMLWhenTheDocumentHasTasks",
+			document: "Tasks",
+			want: `<ul><li><input type="checkbox" disabled checked>done</li>` +
+				`<li><input type="checkbox" disabled>todo</li></ul>`,
+		},
+
+		{
+			name:     "HTMLWhenTheNodeTypeIsUnknown",
+			document: "Unknown",
+			want:     "<p>inside</p>",
+		},
+
+		{
+			name:     "HTMLWhenTheDocumentIsEmpty",
+			document: "Empty",
+			want:     "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, documents[testCase.document].HTML(testCase.options))
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestDocumentBuilder_Build(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		builder  *DocumentBuilder
+		wantJSON string
+		wantErr  bool
+	}{
+		{
+			name:     "BuildWhenTheDocumentIsEmpty",
+			builder:  NewDocumentBuilder(),
+			wantJSON: `{"version":1,"type":"doc","content":[]}`,
+		},
+
+		{
+			name: "BuildWhenTheTextIsMarked",
+			builder: NewDocumentBuilder().
+				Heading(2, ADFText("Release notes")).
+				Paragraph(ADFText("Deployed by "), ADFMention("5b10ac8d82e05b22cc7d4ef5", "@Mia"), ADFHardBreak(),
+					ADFText("docs").Strong().Link("https://example.com"), ADFText("2").Superscript()),
+			wantJSON: `{"version":1,"type":"doc","content":[
+				{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"Release notes"}]},
+				{"type":"paragraph","content":[
+					{"type":"text","text":"Deployed by "},
+					{"type":"mention","attrs":{"id":"5b10ac8d82e05b22cc7d4ef5","text":"@Mia"}},
+					{"type":"hardBreak"},
+					{"type":"text","text":"docs","marks":[{"type":"strong"},{"type":"link","attrs":{"href":"https://example.com"}}]},
+					{"type":"text","text":"2","marks":[{"type":"subsup","attrs":{"type":"sup"}}]}]}]}`,
+		},
+
+		{
+			name: "BuildWhenTheInlineNodesAreWrapped",
+			builder: NewDocumentBuilder().
+				BulletList(ADFListItem(ADFText("one"), ADFStatus("DONE", ADFStatusGreen),
+					ADFBulletList(ADFListItem(ADFText("nested"))), ADFText("two"))).
+				Panel(ADFPanelInfo, ADFText("Heads up")),
+			wantJSON: `{"version":1,"type":"doc","content":[
+				{"type":"bulletList","content":[{"type":"listItem","content":[
+					{"type":"paragraph","content":[{"type":"text","text":"one"},{"type":"status","attrs":{"text":"DONE","color":"green"}}]},
+					{"type":"bulletList","content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"nested"}]}]}]},
+					{"type":"paragraph","content":[{"type":"text","text":"two"}]}]}]},
+				{"type":"panel","attrs":{"panelType":"info"},"content":[{"type":"paragraph","content":[{"type":"text","text":"Heads up"}]}]}]}`,
+		},
+
+		{
+			name: "BuildWhenTheBlocksAreSet",
+			builder: NewDocumentBuilder().
+				OrderedList(ADFListItem(ADFText("first"))).
+				CodeBlock("go", "fmt.Println()").
+				CodeBlock("", "").
+				Blockquote(ADFText("quoted")).
+				Rule().
+				Table(ADFTableRow(ADFTableHeader(ADFText("Key")), ADFTableCell(ADFDate(time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC))))).
+				Node(ADFMediaSingle("", ADFMedia("6e7c1d8c", "file", "jira-attachments"))),
+			wantJSON: `{"version":1,"type":"doc","content":[
+				{"type":"orderedList","content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"first"}]}]}]},
+				{"type":"codeBlock","attrs":{"language":"go"},"content":[{"type":"text","text":"fmt.Println()"}]},
+				{"type":"codeBlock"},
+				{"type":"blockquote","content":[{"type":"paragraph","content":[{"type":"text","text":"quoted"}]}]},
+				{"type":"rule"},
+				{"type":"table","attrs":{"isNumberColumnEnabled":false,"layout":"default"},"content":[{"type":"tableRow","content":[
+					{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Key"}]}]},
+					{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"date","attrs":{"timestamp":"1641513600000"}}]}]}]}]},
+				{"type":"mediaSingle","attrs":{"layout":"center"},"content":[{"type":"media","attrs":{"id":"6e7c1d8c","type":"file","collection":"jira-attachments"}}]}]}`,
+		},
+
+		{
+			name:    "BuildWhenTheHeadingLevelIsInvalid",
+			builder: NewDocumentBuilder().Heading(7, ADFText("Release notes")),
+			wantErr: true,
+		},
+
+		{
+			name:    "BuildWhenTheTextIsEmpty",
+			builder: NewDocumentBuilder().Text(""),
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			gotDocument, err := testCase.builder.Build()
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				assert.Nil(t, gotDocument)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			gotJSON, err := json.Marshal(gotDocument)
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.wantJSON, string(gotJSON))
+
+			// The document decoded from its JSON is still valid
+			decoded := &CommentNodeScheme{}
+			assert.NoError(t, json.Unmarshal(gotJSON, decoded))
+			assert.NoError(t, decoded.Validate())
+		})
+	}
+}
+
+func TestCommentNodeScheme_Validate(t *testing.T) {
+
+	document := func(nodes ...*CommentNodeScheme) *CommentNodeScheme {
+		return &CommentNodeScheme{Version: 1, Type: ADFNodeDoc, Content: nodes}
+	}
+
+	testCases := []struct {
+		name         string
+		document     *CommentNodeScheme
+		wantProblems []*DocumentProblemScheme
+	}{
+		{
+			name:     "ValidateWhenTheDocumentIsValid",
+			document: document(ADFParagraph(ADFText("x").Code().Link("https://example.com"), ADFEmoji(":smile:", ""))),
+		},
+
+		{
+			name:     "ValidateWhenTheRootIsNotADocument",
+			document: ADFParagraph(ADFText("x")),
+			wantProblems: []*DocumentProblemScheme{
+				{Path: "paragraph", Message: "the root node must be a doc node"},
+			},
+		},
+
+		{
+			name:     "ValidateWhenTheVersionIsMissing",
+			document: &CommentNodeScheme{Type: ADFNodeDoc},
+			wantProblems: []*DocumentProblemScheme{
+				{Path: "doc", Message: "the document version must be 1"},
+			},
+		},
+
+		{
+			name:     "ValidateWhenTheNodesAreNotAllowed",
+			document: document(ADFText("x"), ADFParagraph(ADFParagraph()), nil, &CommentNodeScheme{Type: "widget"}),
+			wantProblems: []*DocumentProblemScheme{
+				{Path: "doc.content[0]", Message: "the text node is not allowed inside a doc node"},
+				{Path: "doc.content[1].content[0]", Message: "the paragraph node is not allowed inside a paragraph node"},
+				{Path: "doc.content[2]", Message: "the node is nil"},
+				{Path: "doc.content[3]", Message: `unknown node type "widget"`},
+			},
+		},
+
+		{
+			name:     "ValidateWhenTheContainersAreEmpty",
+			document: document(ADFBulletList(), &CommentNodeScheme{Type: ADFNodeRule, Content: []*CommentNodeScheme{ADFText("x")}}, ADFMediaSingle("", nil)),
+			wantProblems: []*DocumentProblemScheme{
+				{Path: "doc.content[0]", Message: "the bulletList node must contain at least one node"},
+				{Path: "doc.content[1]", Message: "the rule node can't contain other nodes"},
+				{Path: "doc.content[2].content[0]", Message: "the node is nil"},
+			},
+		},
+
+		{
+			name: "ValidateWhenTheAttributesAreInvalid",
+			document: document(
+				ADFHeading(0, ADFText("x")),
+				&CommentNodeScheme{Type: ADFNodeOrderedList, Attrs: map[string]interface{}{"order": -1},
+					Content: []*CommentNodeScheme{ADFListItem(ADFText("x"))}},
+				ADFPanel("tip", ADFText("x")),
+				ADFParagraph(ADFMention("", ""), ADFEmoji("", ""), ADFInlineCard(""), ADFStatus("", "pink"),
+					&CommentNodeScheme{Type: ADFNodeDate}),
+				ADFMediaSingle("", ADFMedia("", "file", "")),
+				ADFMediaSingle("", ADFMedia("", "external", "")),
+				ADFMediaSingle("", ADFMedia("6e7c1d8c", "image", "")),
+			),
+			wantProblems: []*DocumentProblemScheme{
+				{Path: "doc.content[0]", Message: "the heading level must be between 1 and 6"},
+				{Path: "doc.content[1]", Message: "the ordered list order must be a positive number"},
+				{Path: "doc.content[2]", Message: "the panel type must be info, note, warning, success or error"},
+				{Path: "doc.content[3].content[0]", Message: "the mention node requires the account id"},
+				{Path: "doc.content[3].content[1]", Message: "the emoji node requires the short name"},
+				{Path: "doc.content[3].content[2]", Message: "the inlineCard node requires the url or data attribute"},
+				{Path: "doc.content[3].content[3]", Message: "the status node requires the text"},
+				{Path: "doc.content[3].content[3]", Message: "the status color must be neutral, purple, blue, red, yellow or green"},
+				{Path: "doc.content[3].content[4]", Message: "the date node requires the timestamp in milliseconds"},
+				{Path: "doc.content[4].content[0]", Message: "the media node requires the id"},
+				{Path: "doc.content[5].content[0]", Message: "the external media node requires the url"},
+				{Path: "doc.content[6].content[0]", Message: "the media type must be file, link or external"},
+			},
+		},
+
+		{
+			name: "ValidateWhenTheMarksAreInvalid",
+			document: document(
+				ADFParagraph(
+					ADFText("x").Strong().Strong(),
+					ADFText("x").Code().Em(),
+					ADFText("x").Link(""),
+					ADFText("x").TextColor("red"),
+					ADFText("x").addMark(&MarkScheme{Type: ADFMarkSubSup}),
+					ADFText("x").addMark(&MarkScheme{Type: "blink"}).addMark(nil),
+				),
+				&CommentNodeScheme{Type: ADFNodeCodeBlock, Content: []*CommentNodeScheme{ADFText("x")},
+					Marks: []*MarkScheme{{Type: ADFMarkBreakout}}},
+				&CommentNodeScheme{Type: ADFNodeRule, Marks: []*MarkScheme{{Type: ADFMarkBreakout}}},
+			),
+			wantProblems: []*DocumentProblemScheme{
+				{Path: "doc.content[0].content[0]", Message: "the strong mark is duplicated"},
+				{Path: "doc.content[0].content[1]", Message: "the code mark can only be combined with the link mark, found em"},
+				{Path: "doc.content[0].content[2]", Message: "the link mark requires the href"},
+				{Path: "doc.content[0].content[3]", Message: "the text color must use the #rrggbb format"},
+				{Path: "doc.content[0].content[4]", Message: "the subsup mark type must be sub or sup"},
+				{Path: "doc.content[0].content[5]", Message: `unknown text mark "blink"`},
+				{Path: "doc.content[0].content[5]", Message: `unknown text mark "nil"`},
+				{Path: "doc.content[2]", Message: "the rule node doesn't allow the breakout mark"},
+			},
+		},
+
+		{
+			name: "ValidateWhenTheCodeBlockTextIsMarked",
+			document: document(&CommentNodeScheme{Type: ADFNodeCodeBlock,
+				Content: []*CommentNodeScheme{ADFText("x").Strong(), ADFText("")}}),
+			wantProblems: []*DocumentProblemScheme{
+				{Path: "doc.content[0].content[0]", Message: "the code block text can't have marks"},
+				{Path: "doc.content[0].content[1]", Message: "the text node can't be empty"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := testCase.document.Validate()
+
+			if len(testCase.wantProblems) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			if err != nil {
+				t.Logf("error returned: %v", err.Error())
+			}
+
+			validation, ok := err.(*DocumentValidationError)
+			if !ok {
+				t.Fatalf("the error %v is not a *DocumentValidationError", err)
+			}
+
+			assert.Equal(t, testCase.wantProblems, validation.Problems)
+		})
+	}
+}
@@ -0,0 +1,1174 @@
+package models
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// WikiParseOptions customizes the conversion of the Jira wiki markup to Atlassian Documents.
+type WikiParseOptions struct {
+
+	// AttachmentResolver returns the URL of an attachment referenced by its file name, e.g. !screenshot.png!.
+	// The attachments without URL, e.g. !screenshot.png! or [^file.txt], are converted to their file name as text.
+	AttachmentResolver func(fileName string) string
+}
+
+// wikiPanelColors maps the background colors used by Jira when the panels are converted to wiki markup.
+var wikiPanelColors = map[string]string{
+	ADFPanelInfo:    "#deebff",
+	ADFPanelNote:    "#eae6ff",
+	ADFPanelWarning: "#fffae6",
+	ADFPanelSuccess: "#e3fcef",
+	ADFPanelError:   "#ffebe6",
+}
+
+// wikiPanelMacros maps the wiki panel macros to the panel types.
+var wikiPanelMacros = map[string]string{
+	"info":    ADFPanelInfo,
+	"note":    ADFPanelNote,
+	"warning": ADFPanelWarning,
+	"tip":     ADFPanelSuccess,
+}
+
+// wikiColors maps the color names accepted by the {color} macro to the #rrggbb format used by the documents.
+var wikiColors = map[string]string{
+	"black":  "#000000",
+	"white":  "#ffffff",
+	"red":    "#ff0000",
+	"green":  "#008000",
+	"blue":   "#0000ff",
+	"yellow": "#ffff00",
+	"orange": "#ffa500",
+	"purple": "#800080",
+	"grey":   "#808080",
+	"gray":   "#808080",
+}
+
+// wikiEffects maps the text effect delimiters to the text marks.
+var wikiEffects = map[string]*MarkScheme{
+	"*":  {Type: ADFMarkStrong},
+	"_":  {Type: ADFMarkEm},
+	"??": {Type: ADFMarkEm},
+	"-":  {Type: ADFMarkStrike},
+	"+":  {Type: ADFMarkUnderline},
+	"^":  {Type: ADFMarkSubSup, Attrs: map[string]interface{}{"type": "sup"}},
+	"~":  {Type: ADFMarkSubSup, Attrs: map[string]interface{}{"type": "sub"}},
+}
+
+var (
+	wikiHeadingRegexp    = regexp.MustCompile(`^h([1-6])\.\s*(.*)$`)
+	wikiQuoteLineRegexp  = regexp.MustCompile(`^bq\.\s*(.*)$`)
+	wikiListRegexp       = regexp.MustCompile(`^([*#]+|-)\s+(.*)$`)
+	wikiRuleRegexp       = regexp.MustCompile(`^-{4,}\s*$`)
+	wikiMacroRegexp      = regexp.MustCompile(`^\{(code|noformat|quote|panel|info|note|warning|tip)(?::([^}]*))?\}`)
+	wikiImageRegexp      = regexp.MustCompile(`^!([^\s!|][^!|\n]*)(?:\|([^!\n]*))?!`)
+	wikiColorRegexp      = regexp.MustCompile(`^\{color:([^}]+)\}`)
+	wikiBlockStartRegexp = regexp.MustCompile(`^(?:h[1-6]\.|bq\.|[*#]+\s|-\s|-{4,}\s*$|\||\{(?:code|noformat|quote|panel|info|note|warning|tip)[:}])`)
+)
+
+// WikiToDocument converts Jira wiki markup, the format of the v2 descriptions and comments, to an Atlassian Document.
+// The headings, text effects, lists, tables, {code}, {noformat}, {panel}, {quote} and the panel macros, the links,
+// the [~accountid:...] mentions and the images are supported, the unknown macros are kept as text.
+func WikiToDocument(wiki string, options *WikiParseOptions) (*CommentNodeScheme, error) {
+
+	if options == nil {
+		options = &WikiParseOptions{}
+	}
+
+	parser := &wikiParser{options: options}
+	lines := strings.Split(strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(wiki), "\n")
+
+	document := &CommentNodeScheme{Type: ADFNodeDoc, Version: adfDocumentVersion}
+	for _, node := range parser.blocks(lines) {
+		document.AppendNode(node)
+	}
+
+	if err := document.Validate(); err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+type wikiParser struct {
+	options *WikiParseOptions
+}
+
+func (p *wikiParser) blocks(lines []string) (nodes []*CommentNodeScheme) {
+
+	for index := 0; index < len(lines); {
+
+		var block []*CommentNodeScheme
+		block, index = p.block(lines, index)
+
+		nodes = append(nodes, block...)
+	}
+
+	return nodes
+}
+
+func (p *wikiParser) block(lines []string, index int) ([]*CommentNodeScheme, int) {
+
+	line := strings.TrimLeft(lines[index], " \t")
+
+	if line == "" {
+		return nil, index + 1
+	}
+
+	if matches := wikiHeadingRegexp.FindStringSubmatch(line); matches != nil {
+		return []*CommentNodeScheme{ADFHeading(int(matches[1][0]-'0'), p.headingContent(matches[2])...)}, index + 1
+	}
+
+	if wikiRuleRegexp.MatchString(line) {
+		return []*CommentNodeScheme{ADFRule()}, index + 1
+	}
+
+	if matches := wikiQuoteLineRegexp.FindStringSubmatch(line); matches != nil {
+
+		content := documentParagraphs(p.inline(matches[1], nil))
+		if len(content) == 0 {
+			return nil, index + 1
+		}
+
+		return []*CommentNodeScheme{ADFBlockquote(fitDocumentNodes(content, adfChildren[ADFNodeBlockquote])...)}, index + 1
+	}
+
+	if matches := wikiMacroRegexp.FindStringSubmatch(line); matches != nil {
+		return p.macro(lines, index, matches)
+	}
+
+	if wikiListRegexp.MatchString(line) {
+		return p.list(lines, index)
+	}
+
+	if strings.HasPrefix(line, "|") {
+		return p.table(lines, index)
+	}
+
+	// The lines of a paragraph are separated by line breaks
+	text := []string{line}
+	for index++; index < len(lines); index++ {
+
+		next := strings.TrimLeft(lines[index], " \t")
+		if next == "" || wikiBlockStartRegexp.MatchString(next) {
+			break
+		}
+
+		text = append(text, next)
+	}
+
+	return documentParagraphs(p.inline(strings.Join(text, "\n"), nil)), index
+}
+
+// macroContent returns the content of a block macro and the index of the line after the closing tag.
+// The text after the closing tag replaces its line, so it's parsed as the next block.
+func macroContent(lines []string, index int, opening, name string) (string, int) {
+
+	var (
+		content []string
+		closing = "{" + name + "}"
+		line    = strings.TrimLeft(lines[index], " \t")[len(opening):]
+	)
+
+	for {
+
+		if end := strings.Index(line, closing); end >= 0 {
+
+			content = append(content, line[:end])
+
+			if rest := line[end+len(closing):]; strings.TrimSpace(rest) != "" {
+				lines[index] = rest
+				return strings.Join(content, "\n"), index
+			}
+
+			return strings.Join(content, "\n"), index + 1
+		}
+
+		content = append(content, line)
+
+		if index++; index >= len(lines) {
+			return strings.Join(content, "\n"), index
+		}
+
+		line = lines[index]
+	}
+}
+
+// wikiMacroParameters parses the parameters of a macro, e.g. title=Notes|bgColor=#deebff.
+// The parameter without name is returned with an empty key, e.g. the language of the {code:java} macro.
+func wikiMacroParameters(parameters string) map[string]string {
+
+	values := make(map[string]string)
+
+	for _, parameter := range strings.Split(parameters, "|") {
+
+		if parameter = strings.TrimSpace(parameter); parameter == "" {
+			continue
+		}
+
+		if separator := strings.Index(parameter, "="); separator >= 0 {
+			values[strings.TrimSpace(parameter[:separator])] = strings.TrimSpace(parameter[separator+1:])
+			continue
+		}
+
+		values[""] = parameter
+	}
+
+	return values
+}
+
+func (p *wikiParser) macro(lines []string, index int, matches []string) ([]*CommentNodeScheme, int) {
+
+	var (
+		name       = matches[1]
+		parameters = wikiMacroParameters(matches[2])
+	)
+
+	content, index := macroContent(lines, index, matches[0], name)
+
+	switch name {
+	case "code", "noformat":
+
+		language := parameters["language"]
+		if language == "" && name == "code" {
+			language = parameters[""]
+		}
+
+		return []*CommentNodeScheme{ADFCodeBlock(language, strings.Trim(content, "\n"))}, index
+
+	case "quote":
+
+		quoted := fitDocumentNodes(p.blocks(strings.Split(content, "\n")), adfChildren[ADFNodeBlockquote])
+		if len(quoted) == 0 {
+			return nil, index
+		}
+
+		return []*CommentNodeScheme{ADFBlockquote(quoted...)}, index
+	}
+
+	panelType, ok := wikiPanelMacros[name]
+	if !ok {
+
+		panelType = ADFPanelInfo
+		for candidate, color := range wikiPanelColors {
+			if strings.EqualFold(parameters["bgColor"], color) {
+				panelType = candidate
+			}
+		}
+	}
+
+	var panel []*CommentNodeScheme
+	if title := parameters["title"]; title != "" {
+		panel = append(panel, ADFParagraph(ADFText(title).Strong()))
+	}
+
+	panel = append(panel, fitDocumentNodes(p.blocks(strings.Split(content, "\n")), adfChildren[ADFNodePanel])...)
+	if len(panel) == 0 {
+		return nil, index
+	}
+
+	return []*CommentNodeScheme{ADFPanel(panelType, panel...)}, index
+}
+
+func (p *wikiParser) list(lines []string, index int) ([]*CommentNodeScheme, int) {
+
+	type level struct {
+		marker byte
+		list   *CommentNodeScheme
+	}
+
+	var (
+		root  *CommentNodeScheme
+		stack []*level
+	)
+
+	newList := func(marker byte) *CommentNodeScheme {
+
+		if marker == '#' {
+			return ADFOrderedList(1)
+		}
+
+		return ADFBulletList()
+	}
+
+	for ; index < len(lines); index++ {
+
+		matches := wikiListRegexp.FindStringSubmatch(strings.TrimLeft(lines[index], " \t"))
+		if matches == nil {
+			break
+		}
+
+		prefix := strings.Replace(matches[1], "-", "*", 1)
+
+		// A different marker on the first level starts a new list
+		if root != nil && prefix[0] != stack[0].marker {
+			break
+		}
+
+		for len(stack) > len(prefix) || (len(stack) != 0 && stack[len(stack)-1].marker != prefix[len(stack)-1]) {
+			stack = stack[:len(stack)-1]
+		}
+
+		for len(stack) < len(prefix) {
+
+			list := newList(prefix[len(stack)])
+
+			if len(stack) == 0 {
+				root = list
+			} else {
+
+				parent := stack[len(stack)-1].list
+				if len(parent.Content) == 0 {
+					parent.AppendNode(ADFListItem(ADFParagraph()))
+				}
+
+				item := parent.Content[len(parent.Content)-1]
+				item.AppendNode(list)
+			}
+
+			stack = append(stack, &level{marker: prefix[len(stack)], list: list})
+		}
+
+		var content []*CommentNodeScheme
+		for _, block := range documentParagraphs(p.inline(matches[2], nil)) {
+			content = append(content, block)
+		}
+
+		if len(content) == 0 {
+			content = []*CommentNodeScheme{ADFParagraph()}
+		}
+
+		stack[len(stack)-1].list.AppendNode(ADFListItem(content...))
+	}
+
+	return []*CommentNodeScheme{root}, index
+}
+
+// splitWikiTableRow returns the cells of a table row and whether each cell is a header.
+// The pipes inside the links, images and macros don't split the cells.
+func splitWikiTableRow(line string) (cells []string, headers []bool) {
+
+	var (
+		cell    strings.Builder
+		header  bool
+		started bool
+		depth   int
+		image   bool
+	)
+
+	for index := 0; index < len(line); index++ {
+
+		character := line[index]
+
+		switch {
+		case character == '\\' && index+1 < len(line):
+
+			cell.WriteString(line[index : index+2])
+			index++
+			continue
+		case character == '[' || character == '{':
+			depth++
+		case (character == ']' || character == '}') && depth > 0:
+			depth--
+		case character == '!' && depth == 0:
+			image = !image
+		case character == '|' && depth == 0 && !image:
+
+			if started {
+				cells = append(cells, strings.TrimSpace(cell.String()))
+				headers = append(headers, header)
+			}
+
+			cell.Reset()
+			started = true
+			header = index+1 < len(line) && line[index+1] == '|'
+
+			if header {
+				index++
+			}
+
+			continue
+		}
+
+		cell.WriteByte(character)
+	}
+
+	// The last delimiter closes the row
+	if rest := strings.TrimSpace(cell.String()); rest != "" {
+		cells = append(cells, rest)
+		headers = append(headers, header)
+	}
+
+	return cells, headers
+}
+
+func (p *wikiParser) table(lines []string, index int) ([]*CommentNodeScheme, int) {
+
+	table := ADFTable()
+
+	for ; index < len(lines); index++ {
+
+		line := strings.TrimSpace(lines[index])
+		if !strings.HasPrefix(line, "|") {
+			break
+		}
+
+		row := ADFTableRow()
+
+		cells, headers := splitWikiTableRow(line)
+		for position, text := range cells {
+
+			content := documentParagraphs(p.inline(text, nil))
+			if len(content) == 0 {
+				content = []*CommentNodeScheme{ADFParagraph()}
+			}
+
+			if headers[position] {
+				row.AppendNode(ADFTableHeader(content...))
+			} else {
+				row.AppendNode(ADFTableCell(content...))
+			}
+		}
+
+		if len(row.Content) != 0 {
+			table.AppendNode(row)
+		}
+	}
+
+	if len(table.Content) == 0 {
+		return nil, index
+	}
+
+	return []*CommentNodeScheme{table}, index
+}
+
+func (p *wikiParser) headingContent(text string) []*CommentNodeScheme {
+
+	var content []*CommentNodeScheme
+	for _, node := range p.inline(text, nil) {
+		content = append(content, inlineDocumentNodes(node)...)
+	}
+
+	return content
+}
+
+func isWikiWordCharacter(character rune) bool {
+	return unicode.IsLetter(character) || unicode.IsDigit(character)
+}
+
+// findWikiEffectClosing returns the position of the delimiter closing a text effect, or -1 if it's not closed.
+func findWikiEffectClosing(text string, from int, delimiter string) int {
+
+	for index := from; index < len(text); index++ {
+
+		if text[index] == '\\' {
+			index++
+			continue
+		}
+
+		if text[index] == '\n' && index+1 < len(text) && text[index+1] == '\n' {
+			return -1
+		}
+
+		if !strings.HasPrefix(text[index:], delimiter) || index == from {
+			continue
+		}
+
+		// The doubled delimiters, e.g. the -- en dash, don't close the effects
+		if strings.HasSuffix(text[:index], delimiter[len(delimiter)-1:]) {
+			continue
+		}
+
+		before, _ := utf8.DecodeLastRuneInString(text[:index])
+		after, _ := utf8.DecodeRuneInString(text[index+len(delimiter):])
+
+		if !unicode.IsSpace(before) && (index+len(delimiter) == len(text) || !isWikiWordCharacter(after)) {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// wikiEffectAt returns the effect delimiter starting at the position of the text.
+func wikiEffectAt(text string, index int) string {
+
+	if strings.HasPrefix(text[index:], "??") {
+		return "??"
+	}
+
+	if delimiter := text[index : index+1]; wikiEffects[delimiter] != nil {
+		return delimiter
+	}
+
+	return ""
+}
+
+func (p *wikiParser) inline(text string, marks []*MarkScheme) []*CommentNodeScheme {
+
+	var (
+		nodes []*CommentNodeScheme
+		plain strings.Builder
+	)
+
+	flush := func() {
+
+		if plain.Len() != 0 {
+			nodes = append(nodes, linkBareURLs(plain.String(), marks)...)
+			plain.Reset()
+		}
+	}
+
+	for index := 0; index < len(text); {
+
+		rest := text[index:]
+
+		switch {
+		case strings.HasPrefix(rest, `\\`), rest[0] == '\n':
+
+			flush()
+			nodes = append(nodes, ADFHardBreak())
+
+			if rest[0] == '\n' {
+				index++
+			} else {
+				index += 2
+			}
+
+		case rest[0] == '\\' && len(rest) > 1:
+
+			_, size := utf8.DecodeRuneInString(rest[1:])
+			plain.WriteString(rest[1 : 1+size])
+			index += 1 + size
+
+		case strings.HasPrefix(rest, "{{"):
+
+			end := strings.Index(rest[2:], "}}")
+			if end <= 0 {
+				plain.WriteString("{{")
+				index += 2
+				continue
+			}
+
+			// The code mark can only be combined with the links
+			codeMarks := []*MarkScheme{{Type: ADFMarkCode}}
+			for _, mark := range marks {
+				if mark.Type == ADFMarkLink {
+					codeMarks = append(codeMarks, mark)
+				}
+			}
+
+			flush()
+			nodes = append(nodes, &CommentNodeScheme{Type: ADFNodeText, Text: rest[2 : 2+end], Marks: codeMarks})
+			index += end + 4
+
+		case wikiColorRegexp.MatchString(rest):
+
+			opening := wikiColorRegexp.FindStringSubmatch(rest)
+			end := strings.Index(rest[len(opening[0]):], "{color}")
+			if end < 0 {
+				plain.WriteString(opening[0])
+				index += len(opening[0])
+				continue
+			}
+
+			color := strings.ToLower(strings.TrimSpace(opening[1]))
+			if named, ok := wikiColors[color]; ok {
+				color = named
+			}
+
+			innerMarks := marks
+			if adfColorRegexp.MatchString(color) {
+				innerMarks = withDocumentMark(marks, &MarkScheme{Type: ADFMarkTextColor, Attrs: map[string]interface{}{"color": color}})
+			}
+
+			flush()
+			nodes = append(nodes, p.inline(rest[len(opening[0]):len(opening[0])+end], innerMarks)...)
+			index += len(opening[0]) + end + len("{color}")
+
+		case rest[0] == '{' && len(rest) > 2 && wikiEffectAt(rest, 1) != "" && strings.HasPrefix(rest[1+len(wikiEffectAt(rest, 1)):], "}"):
+
+			// The {*}text{*} form allows the effects inside the words
+			delimiter := wikiEffectAt(rest, 1)
+			opening := "{" + delimiter + "}"
+
+			end := strings.Index(rest[len(opening):], opening)
+			if end < 0 {
+				plain.WriteString(opening)
+				index += len(opening)
+				continue
+			}
+
+			flush()
+			nodes = append(nodes, p.inline(rest[len(opening):len(opening)+end], withDocumentMark(marks, wikiEffects[delimiter]))...)
+			index += len(opening)*2 + end
+
+		case rest[0] == '[':
+
+			end := strings.IndexAny(rest[1:], "]\n")
+			if end < 0 || rest[1+end] != ']' {
+				plain.WriteByte('[')
+				index++
+				continue
+			}
+
+			link := p.link(rest[1:1+end], marks)
+			if link == nil {
+				plain.WriteByte('[')
+				index++
+				continue
+			}
+
+			flush()
+			nodes = append(nodes, link...)
+			index += end + 2
+
+		case rest[0] == '!' && wikiImageRegexp.MatchString(rest):
+
+			matches := wikiImageRegexp.FindStringSubmatch(rest)
+			if !strings.Contains(matches[1], ".") && !strings.Contains(matches[1], "://") {
+				plain.WriteByte('!')
+				index++
+				continue
+			}
+
+			flush()
+			nodes = append(nodes, p.image(strings.TrimSpace(matches[1]), wikiMacroParameters(strings.ReplaceAll(matches[2], ",", "|")), marks)...)
+			index += len(matches[0])
+
+		case wikiEffectAt(text, index) != "":
+
+			delimiter := wikiEffectAt(text, index)
+
+			before := ' '
+			if index > 0 {
+				before, _ = utf8.DecodeLastRuneInString(text[:index])
+			}
+
+			after, _ := utf8.DecodeRuneInString(text[index+len(delimiter):])
+
+			// The effects start at the word boundaries, and the doubled delimiters, e.g. the -- en dash, are text
+			closing := -1
+			if !isWikiWordCharacter(before) && index+len(delimiter) < len(text) && !unicode.IsSpace(after) &&
+				!strings.HasSuffix(text[:index], delimiter[len(delimiter)-1:]) &&
+				!strings.HasPrefix(text[index+len(delimiter):], delimiter[:1]) {
+				closing = findWikiEffectClosing(text, index+len(delimiter), delimiter)
+			}
+
+			if closing < 0 {
+				plain.WriteString(delimiter)
+				index += len(delimiter)
+				continue
+			}
+
+			flush()
+			nodes = append(nodes, p.inline(text[index+len(delimiter):closing], withDocumentMark(marks, wikiEffects[delimiter]))...)
+			index = closing + len(delimiter)
+
+		default:
+
+			_, size := utf8.DecodeRuneInString(rest)
+			plain.WriteString(rest[:size])
+			index += size
+		}
+	}
+
+	flush()
+
+	return mergeTextNodes(nodes)
+}
+
+// link converts the content of a [...] link, returning nil when it's not a link.
+func (p *wikiParser) link(content string, marks []*MarkScheme) []*CommentNodeScheme {
+
+	switch {
+	case strings.HasPrefix(content, "~accountid:"):
+		return []*CommentNodeScheme{ADFMention(strings.TrimPrefix(content, "~accountid:"), "")}
+
+	case strings.HasPrefix(content, "~"):
+		return []*CommentNodeScheme{{Type: ADFNodeText, Text: "@" + content[1:], Marks: marks}}
+
+	case strings.HasPrefix(content, "^"):
+
+		fileName := content[1:]
+		if p.options.AttachmentResolver != nil {
+
+			if link := p.options.AttachmentResolver(fileName); link != "" {
+				return []*CommentNodeScheme{{Type: ADFNodeText, Text: fileName,
+					Marks: withDocumentMark(marks, &MarkScheme{Type: ADFMarkLink, Attrs: map[string]interface{}{"href": link}})}}
+			}
+		}
+
+		return []*CommentNodeScheme{{Type: ADFNodeText, Text: fileName, Marks: marks}}
+	}
+
+	parts := strings.Split(content, "|")
+
+	var text, href string
+	switch len(parts) {
+	case 1:
+		href = strings.TrimSpace(parts[0])
+	default:
+		text, href = parts[0], strings.TrimSpace(parts[1])
+	}
+
+	if !strings.Contains(href, "://") && !strings.HasPrefix(href, "mailto:") {
+		return nil
+	}
+
+	if len(parts) > 2 && strings.TrimSpace(parts[2]) == "smart-link" {
+		return []*CommentNodeScheme{ADFInlineCard(href)}
+	}
+
+	linkMarks := withDocumentMark(marks, &MarkScheme{Type: ADFMarkLink, Attrs: map[string]interface{}{"href": href}})
+
+	if text == "" {
+		return []*CommentNodeScheme{{Type: ADFNodeText, Text: strings.TrimPrefix(href, "mailto:"), Marks: linkMarks}}
+	}
+
+	return p.inline(text, linkMarks)
+}
+
+// image converts an image to a media node, the attachments are resolved to their URL. The attachments not resolved
+// are converted to their file name as text, a media node can't reference a file name.
+func (p *wikiParser) image(target string, parameters map[string]string, marks []*MarkScheme) []*CommentNodeScheme {
+
+	link := target
+	if !strings.Contains(target, "://") {
+
+		link = ""
+		if p.options.AttachmentResolver != nil {
+			link = p.options.AttachmentResolver(target)
+		}
+	}
+
+	if link == "" {
+		return []*CommentNodeScheme{{Type: ADFNodeText, Text: target, Marks: marks}}
+	}
+
+	media := &CommentNodeScheme{Type: ADFNodeMedia, Attrs: map[string]interface{}{"type": "external", "url": link}}
+	if alternateText := parameters["alt"]; alternateText != "" {
+		media.Attrs["alt"] = alternateText
+	}
+
+	return []*CommentNodeScheme{ADFMediaSingle("", media)}
+}
+
+// Wiki renders the document as Jira wiki markup, the format of the v2 descriptions and comments.
+// The panels use the background colors of their types, so they are converted back by WikiToDocument.
+func (n *CommentNodeScheme) Wiki(options *DocumentRenderOptions) string {
+
+	renderer := &wikiRenderer{options: renderOptions(options), lineBreak: "\n"}
+	return strings.TrimRight(renderer.block(n), "\n")
+}
+
+type wikiRenderer struct {
+	options *DocumentRenderOptions
+
+	// The line breaks can't be new lines inside the lists, tables and headings
+	lineBreak string
+
+	// The pipes of the text split the table cells
+	escapePipes bool
+}
+
+var wikiEscaper = strings.NewReplacer(`[`, `\[`, `]`, `\]`, `{`, `\{`, `}`, `\}`)
+
+// escapeWiki escapes the characters that would be parsed as markup, the effect delimiters are only escaped
+// when they could open or close an effect.
+func escapeWiki(text string) string {
+
+	var builder strings.Builder
+
+	for index, character := range text {
+
+		var (
+			before, _ = utf8.DecodeLastRuneInString(text[:index])
+			after, _  = utf8.DecodeRuneInString(text[index+utf8.RuneLen(character):])
+			last      = index+utf8.RuneLen(character) == len(text)
+		)
+
+		if index == 0 {
+			before = ' '
+		}
+
+		if last {
+			after = ' '
+		}
+
+		switch {
+		case strings.ContainsRune("*_-+^~!", character) || (character == '?' && after == '?'):
+
+			opening := !isWikiWordCharacter(before) && !unicode.IsSpace(after)
+			closing := !unicode.IsSpace(before) && !isWikiWordCharacter(after)
+
+			if opening || closing {
+				builder.WriteByte('\\')
+			}
+
+			builder.WriteRune(character)
+		default:
+			builder.WriteString(wikiEscaper.Replace(string(character)))
+		}
+	}
+
+	return builder.String()
+}
+
+// escapeWikiLineStart escapes the characters that would turn a line into another block.
+func escapeWikiLineStart(text string) string {
+
+	lines := strings.Split(text, "\n")
+	for index, line := range lines {
+
+		if wikiBlockStartRegexp.MatchString(line) && !strings.HasPrefix(line, "\\") {
+			lines[index] = "\\" + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (r *wikiRenderer) blocks(nodes []*CommentNodeScheme, separator string) string {
+
+	var blocks []string
+	for _, node := range nodes {
+
+		if block := r.block(node); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return strings.Join(blocks, separator)
+}
+
+func (r *wikiRenderer) block(node *CommentNodeScheme) string {
+
+	if node == nil {
+		return ""
+	}
+
+	switch node.Type {
+	case ADFNodeParagraph, ADFNodeTaskItem, ADFNodeDecisionItem:
+		return escapeWikiLineStart(r.inline(node.Content))
+
+	case ADFNodeHeading:
+
+		level, _ := adfNumber(node.Attrs["level"])
+		if level < 1 || level > adfMaxHeadingLevel {
+			level = 1
+		}
+
+		return "h" + strconv.Itoa(int(level)) + ". " + r.singleLine(node.Content)
+
+	case ADFNodeBulletList, ADFNodeOrderedList, ADFNodeTaskList, ADFNodeDecisionList:
+		return r.list(node, "")
+
+	case ADFNodeCodeBlock:
+
+		opening := "{code}"
+		if language := adfString(node.Attrs["language"]); language != "" {
+			opening = "{code:" + language + "}"
+		}
+
+		return opening + "\n" + strings.TrimRight(textContent(node), "\n") + "\n{code}"
+
+	case ADFNodeBlockquote:
+		return "{quote}\n" + r.blocks(node.Content, "\n\n") + "\n{quote}"
+
+	case ADFNodePanel:
+
+		color, ok := wikiPanelColors[adfString(node.Attrs["panelType"])]
+		if !ok {
+			color = wikiPanelColors[ADFPanelInfo]
+		}
+
+		return "{panel:bgColor=" + color + "}\n" + r.blocks(node.Content, "\n\n") + "\n{panel}"
+
+	case ADFNodeExpand, ADFNodeNestedExpand:
+
+		content := r.blocks(node.Content, "\n\n")
+		if title := adfString(node.Attrs["title"]); title != "" {
+			return "*" + escapeWiki(title) + "*\n\n" + content
+		}
+
+		return content
+
+	case ADFNodeRule:
+		return "----"
+
+	case ADFNodeTable:
+		return r.table(node)
+
+	case ADFNodeMediaSingle, ADFNodeMediaGroup:
+		return r.inline(node.Content)
+
+	case ADFNodeBlockCard, ADFNodeEmbedCard:
+		return r.inline([]*CommentNodeScheme{{Type: ADFNodeInlineCard, Attrs: node.Attrs}})
+	}
+
+	if adfInlineTypes[node.Type] || node.Type == ADFNodeMedia {
+		return r.inline([]*CommentNodeScheme{node})
+	}
+
+	// The document and the unknown nodes are rendered through their children
+	return r.blocks(node.Content, "\n\n")
+}
+
+// singleLine renders the inline nodes using the \\ line breaks, e.g. the heading and table cell content.
+func (r *wikiRenderer) singleLine(nodes []*CommentNodeScheme) string {
+
+	lineBreak := r.lineBreak
+	r.lineBreak = `\\ `
+
+	defer func() { r.lineBreak = lineBreak }()
+
+	return r.inline(nodes)
+}
+
+func (r *wikiRenderer) list(node *CommentNodeScheme, prefix string) string {
+
+	marker := "*"
+	if node.Type == ADFNodeOrderedList {
+		marker = "#"
+	}
+
+	var lines []string
+	for _, item := range node.Content {
+
+		if item == nil {
+			continue
+		}
+
+		var (
+			text   []string
+			nested []string
+		)
+
+		switch item.Type {
+		case ADFNodeTaskList:
+			nested = append(nested, r.list(item, prefix+marker))
+		case ADFNodeTaskItem, ADFNodeDecisionItem:
+
+			content := r.singleLine(item.Content)
+			if adfString(item.Attrs["state"]) == "DONE" {
+				content = "(/) " + content
+			}
+
+			text = append(text, content)
+		default:
+
+			for _, child := range item.Content {
+
+				if child == nil {
+					continue
+				}
+
+				switch child.Type {
+				case ADFNodeBulletList, ADFNodeOrderedList, ADFNodeTaskList:
+					nested = append(nested, r.list(child, prefix+marker))
+				case ADFNodeParagraph:
+					text = append(text, r.singleLine(child.Content))
+				default:
+
+					// The other blocks can't be part of the list item, they are rendered after it
+					nested = append(nested, r.block(child))
+				}
+			}
+		}
+
+		lines = append(lines, prefix+marker+" "+strings.Join(text, `\\ `))
+		lines = append(lines, nested...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (r *wikiRenderer) table(node *CommentNodeScheme) string {
+
+	r.escapePipes = true
+	defer func() { r.escapePipes = false }()
+
+	var rows []string
+	for _, row := range node.Content {
+
+		if row == nil {
+			continue
+		}
+
+		var (
+			builder   strings.Builder
+			delimiter = "|"
+		)
+
+		for _, cell := range row.Content {
+
+			if cell == nil {
+				continue
+			}
+
+			delimiter = "|"
+			if cell.Type == ADFNodeTableHeader {
+				delimiter = "||"
+			}
+
+			var paragraphs []string
+			for _, block := range cell.Content {
+
+				if block == nil {
+					continue
+				}
+
+				if text := r.singleLine(inlineDocumentNodes(block)); text != "" {
+					paragraphs = append(paragraphs, text)
+				}
+			}
+
+			content := strings.Join(paragraphs, `\\ `)
+			if content == "" {
+				content = " "
+			}
+
+			builder.WriteString(delimiter + content)
+		}
+
+		rows = append(rows, builder.String()+delimiter)
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+func (r *wikiRenderer) inline(nodes []*CommentNodeScheme) string {
+
+	var builder strings.Builder
+
+	nodes = mergeTextNodes(nodes)
+	for index, node := range nodes {
+
+		switch node.Type {
+		case ADFNodeText:
+
+			var next string
+			if index+1 < len(nodes) {
+				next = nodes[index+1].Text
+			}
+
+			builder.WriteString(r.text(node, builder.String(), next))
+		case ADFNodeHardBreak:
+			builder.WriteString(r.lineBreak)
+		case ADFNodeMention:
+			builder.WriteString("[~accountid:" + adfString(node.Attrs["id"]) + "]")
+		case ADFNodeEmoji:
+			builder.WriteString(emojiText(node))
+		case ADFNodeInlineCard:
+
+			link := adfString(node.Attrs["url"])
+			builder.WriteString("[" + link + "|" + link + "|smart-link]")
+		case ADFNodeStatus:
+			builder.WriteString("{{" + strings.ToUpper(adfString(node.Attrs["text"])) + "}}")
+		case ADFNodeDate:
+			text, _ := dateText(node)
+			builder.WriteString(escapeWiki(text))
+		case ADFNodePlaceholder:
+			builder.WriteString(escapeWiki(adfString(node.Attrs["text"])))
+		case ADFNodeMedia:
+
+			link, name := r.options.media(node)
+			switch {
+			case link == "":
+				builder.WriteString("!" + name + "!")
+			case adfString(node.Attrs["alt"]) != "":
+				builder.WriteString("!" + link + "|alt=" + adfString(node.Attrs["alt"]) + "!")
+			default:
+				builder.WriteString("!" + link + "!")
+			}
+		default:
+
+			if node.Text != "" {
+				builder.WriteString(escapeWiki(node.Text))
+			}
+
+			builder.WriteString(r.inline(node.Content))
+		}
+	}
+
+	return builder.String()
+}
+
+// wikiEffectMarks contains the delimiters of the text marks, from the innermost to the outermost.
+var wikiEffectMarks = []struct {
+	mark, delimiter string
+}{
+	{ADFMarkStrike, "-"},
+	{ADFMarkUnderline, "+"},
+	{ADFMarkEm, "_"},
+	{ADFMarkStrong, "*"},
+}
+
+func (r *wikiRenderer) text(node *CommentNodeScheme, previous, next string) string {
+
+	leading, text, trailing := splitSpaces(node.Text)
+	if text == "" {
+		return escapeWiki(node.Text)
+	}
+
+	if hasMark(node, ADFMarkCode) != nil {
+		text = "{{" + wikiEscaper.Replace(text) + "}}"
+	} else {
+		text = escapeWiki(text)
+	}
+
+	if r.escapePipes {
+		text = strings.ReplaceAll(text, "|", `\|`)
+	}
+
+	// The effects next to a word need the {*} form
+	var (
+		before, _ = utf8.DecodeLastRuneInString(previous + leading)
+		after, _  = utf8.DecodeRuneInString(trailing + next)
+		braces    = isWikiWordCharacter(before) || isWikiWordCharacter(after)
+	)
+
+	wrap := func(delimiter string) {
+
+		if braces {
+			text = "{" + delimiter + "}" + text + "{" + delimiter + "}"
+		} else {
+			text = delimiter + text + delimiter
+		}
+	}
+
+	if mark := hasMark(node, ADFMarkSubSup); mark != nil {
+
+		if adfString(mark.Attrs["type"]) == "sub" {
+			wrap("~")
+		} else {
+			wrap("^")
+		}
+	}
+
+	for _, effect := range wikiEffectMarks {
+		if hasMark(node, effect.mark) != nil {
+			wrap(effect.delimiter)
+		}
+	}
+
+	if mark := hasMark(node, ADFMarkTextColor); mark != nil {
+		text = "{color:" + adfString(mark.Attrs["color"]) + "}" + text + "{color}"
+	}
+
+	if mark := hasMark(node, ADFMarkLink); mark != nil {
+
+		href := adfString(mark.Attrs["href"])
+		if text == escapeWiki(href) || "mailto:"+text == href {
+			text = "[" + href + "]"
+		} else {
+			text = "[" + text + "|" + href + "]"
+		}
+	}
+
+	return leading + text + trailing
+}
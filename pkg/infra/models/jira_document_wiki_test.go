@@ -0,0 +1,197 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWikiToDocument(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		wiki    string
+		options *WikiParseOptions
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "WikiToDocumentWhenTheImageIsNotResolved",
+			wiki: "see *!image.png!*",
+			want: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[
+				{"type":"text","text":"see "},{"type":"text","text":"image.png","marks":[{"type":"strong"}]}]}]}`,
+		},
+
+		{
+			name: "WikiToDocumentWhenTheImageHasAnAlternateText",
+			wiki: "!https://example.com/image.png|alt=Logo!",
+			want: `{"version":1,"type":"doc","content":[{"type":"mediaSingle","attrs":{"layout":"center"},
+				"content":[{"type":"media","attrs":{"type":"external","url":"https://example.com/image.png","alt":"Logo"}}]}]}`,
+		},
+
+		{
+			name: "WikiToDocumentWhenTheImagesAreInsideTheText",
+			wiki: "before !https://example.com/a.png! !https://example.com/b.png! after",
+			want: `{"version":1,"type":"doc","content":[
+				{"type":"paragraph","content":[{"type":"text","text":"before"}]},
+				{"type":"mediaSingle","attrs":{"layout":"center"},"content":[{"type":"media","attrs":{"type":"external","url":"https://example.com/a.png"}}]},
+				{"type":"mediaSingle","attrs":{"layout":"center"},"content":[{"type":"media","attrs":{"type":"external","url":"https://example.com/b.png"}}]},
+				{"type":"paragraph","content":[{"type":"text","text":"after"}]}]}`,
+		},
+
+		{
+			name: "WikiToDocumentWhenTheAttachmentsAreResolved",
+			wiki: "!image.png! [^file.txt]",
+			options: &WikiParseOptions{AttachmentResolver: func(fileName string) string {
+				return "https://ctreminiom.atlassian.net/secure/attachment/10000/" + fileName
+			}},
+			want: `{"version":1,"type":"doc","content":[
+				{"type":"mediaSingle","attrs":{"layout":"center"},"content":[{"type":"media",
+					"attrs":{"type":"external","url":"https://ctreminiom.atlassian.net/secure/attachment/10000/image.png"}}]},
+				{"type":"paragraph","content":[{"type":"text","text":"file.txt",
+					"marks":[{"type":"link","attrs":{"href":"https://ctreminiom.atlassian.net/secure/attachment/10000/file.txt"}}]}]}]}`,
+		},
+
+		{
+			name: "WikiToDocumentWhenTheTextHasEnDashes",
+			wiki: "a -- b -c-",
+			want: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[
+				{"type":"text","text":"a -- b "},{"type":"text","text":"c","marks":[{"type":"strike"}]}]}]}`,
+		},
+
+		{
+			name: "WikiToDocumentWhenTheEnDashIsInsideAWord",
+			wiki: "pages 1--2 and x-y-z",
+			want: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[
+				{"type":"text","text":"pages 1--2 and x-y-z"}]}]}`,
+		},
+
+		{
+			name: "WikiToDocumentWhenTheStrikeStartsWithADash",
+			wiki: "--text-",
+			want: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"--text-"}]}]}`,
+		},
+
+		{
+			name: "WikiToDocumentWhenTheStrikeIsOnWordBoundaries",
+			wiki: "foo -bar- baz",
+			want: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"foo "},
+				{"type":"text","text":"bar","marks":[{"type":"strike"}]},{"type":"text","text":" baz"}]}]}`,
+		},
+
+		{
+			name: "WikiToDocumentWhenTheEffectIsNotClosed",
+			wiki: "unclosed *bold",
+			want: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"unclosed *bold"}]}]}`,
+		},
+
+		{
+			name: "WikiToDocumentWhenTheTextHasLinksAndMentions",
+			wiki: "[Google|https://google.com] [~accountid:5b10a]",
+			want: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[
+				{"type":"text","text":"Google","marks":[{"type":"link","attrs":{"href":"https://google.com"}}]},
+				{"type":"text","text":" "},{"type":"mention","attrs":{"id":"5b10a"}}]}]}`,
+		},
+
+		{
+			name: "WikiToDocumentWhenTheColorIsNamed",
+			wiki: "{color:red}red{color}",
+			want: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[
+				{"type":"text","text":"red","marks":[{"type":"textColor","attrs":{"color":"#ff0000"}}]}]}]}`,
+		},
+
+		{
+			name: "WikiToDocumentWhenTheMacrosAreUsed",
+			wiki: "{code:go}\nfmt.Println()\n{code}\n{info}Careful{info}\n{noformat}\n*x*\n{noformat}",
+			want: `{"version":1,"type":"doc","content":[
+				{"type":"codeBlock","attrs":{"language":"go"},"content":[{"type":"text","text":"fmt.Println()"}]},
+				{"type":"panel","attrs":{"panelType":"info"},"content":[{"type":"paragraph","content":[{"type":"text","text":"Careful"}]}]},
+				{"type":"codeBlock","content":[{"type":"text","text":"*x*"}]}]}`,
+		},
+
+		{
+			name: "WikiToDocumentWhenTheListsAreNested",
+			wiki: "# one\n## two",
+			want: `{"version":1,"type":"doc","content":[{"type":"orderedList","content":[{"type":"listItem","content":[
+				{"type":"paragraph","content":[{"type":"text","text":"one"}]},
+				{"type":"orderedList","content":[{"type":"listItem","content":[
+					{"type":"paragraph","content":[{"type":"text","text":"two"}]}]}]}]}]}]}`,
+		},
+
+		{
+			name: "WikiToDocumentWhenTheTextIsATable",
+			wiki: "||A||B||\n|1|2|",
+			want: `{"version":1,"type":"doc","content":[{"type":"table","attrs":{"isNumberColumnEnabled":false,"layout":"default"},
+				"content":[
+					{"type":"tableRow","content":[
+						{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"A"}]}]},
+						{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"B"}]}]}]},
+					{"type":"tableRow","content":[
+						{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"1"}]}]},
+						{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"2"}]}]}]}]}]}`,
+		},
+
+		{
+			name: "WikiToDocumentWhenTheTextIsEmpty",
+			wiki: "",
+			want: `{"version":1,"type":"doc","content":[]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			gotResult, err := WikiToDocument(testCase.wiki, testCase.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			got, err := json.Marshal(gotResult)
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.want, string(got))
+		})
+	}
+}
+
+func TestCommentNodeScheme_Wiki(t *testing.T) {
+
+	// The wiki markup is rendered back unchanged after the conversion to a document
+	testCases := []struct {
+		name string
+		wiki string
+		want string
+	}{
+		{name: "WikiWhenTheTextHasEffects", wiki: "*bold* and _em_ and -strike-", want: "*bold* and _em_ and -strike-"},
+		{name: "WikiWhenTheImageIsNotResolved", wiki: "!image.png!", want: "image.png"},
+		{name: "WikiWhenTheImageHasAnAlternateText", wiki: "!https://example.com/image.png|alt=Logo!", want: "!https://example.com/image.png|alt=Logo!"},
+		{name: "WikiWhenTheTextHasEnDashes", wiki: "a -- b", want: `a \-\- b`},
+		{name: "WikiWhenTheBlocksAreUsed", wiki: "h1. Title\n\n* one\n* two\n\n----", want: "h1. Title\n\n* one\n* two\n\n----"},
+		{name: "WikiWhenTheTextIsATable", wiki: "||A||B||\n|1|2|", want: "||A||B||\n|1|2|"},
+		{name: "WikiWhenTheQuoteIsALine", wiki: "bq. quote", want: "{quote}\nquote\n{quote}"},
+		{name: "WikiWhenTheColorIsNamed", wiki: "{color:red}red{color}", want: "{color:#ff0000}red{color}"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			document, err := WikiToDocument(testCase.wiki, nil)
+			assert.NoError(t, err)
+
+			assert.Equal(t, testCase.want, document.Wiki(nil))
+
+			// The rendered markup is converted to the same document
+			again, err := WikiToDocument(document.Wiki(nil), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, document, again)
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package models
 
+import "io"
+
 type AttachmentSettingScheme struct {
 	Enabled     bool `json:"enabled,omitempty"`
 	UploadLimit int  `json:"uploadLimit,omitempty"`
@@ -44,3 +46,67 @@ type AttachmentHumanMetadataEntryScheme struct {
 	MediaType string `json:"mediaType,omitempty"`
 	Label     string `json:"label,omitempty"`
 }
+
+type AttachmentRawMetadataScheme struct {
+	Entries         []*AttachmentRawMetadataEntryScheme `json:"entries,omitempty"`
+	TotalEntryCount int                                 `json:"totalEntryCount,omitempty"`
+}
+
+type AttachmentRawMetadataEntryScheme struct {
+	EntryIndex      int    `json:"entryIndex,omitempty"`
+	AbbreviatedName string `json:"abbreviatedName,omitempty"`
+	MediaType       string `json:"mediaType,omitempty"`
+	Name            string `json:"name,omitempty"`
+	Size            int64  `json:"size,omitempty"`
+}
+
+// AttachmentContentOptionsScheme selects the bytes of the attachment downloaded, the whole file is downloaded when
+// both are zero. The download of a partial file can be resumed with the offset set to the bytes already written.
+type AttachmentContentOptionsScheme struct {
+	Offset int64
+	Length int64
+}
+
+// AttachmentThumbnailOptionsScheme sets the maximum size of the thumbnail, the default size is used when it's zero.
+type AttachmentThumbnailOptionsScheme struct {
+	Width             int
+	Height            int
+	FallbackToDefault bool
+}
+
+// AttachmentUploadFileScheme is a file uploaded by the AttachmentService.Upload method.
+type AttachmentUploadFileScheme struct {
+	Name   string
+	Reader io.Reader
+
+	// Size is the number of bytes of the file, it's checked against the upload limit before the upload and used
+	// to skip the existing attachments. The files with an unknown size are checked while they're uploaded.
+	Size int64
+}
+
+// AttachmentUploadOptionsScheme customizes the uploads of the AttachmentService.Upload method.
+type AttachmentUploadOptionsScheme struct {
+
+	// BatchSize is the number of files sent per request, all the files are sent on a single request when it's zero.
+	BatchSize int
+
+	// SkipExisting skips the files attached to the issue with the same name and size, the files uploaded
+	// by an interrupted upload aren't uploaded again.
+	SkipExisting bool
+
+	// SkipSettingsCheck doesn't check the attachment settings of the site before the upload.
+	SkipSettingsCheck bool
+
+	// Progress is called every time a chunk of a file is sent.
+	Progress func(progress *AttachmentUploadProgressScheme)
+}
+
+// AttachmentUploadProgressScheme is the progress of an upload, the sizes are zero when they're unknown.
+type AttachmentUploadProgressScheme struct {
+	FileName    string
+	FileIndex   int
+	FileWritten int64
+	FileSize    int64
+	Written     int64
+	Size        int64
+}
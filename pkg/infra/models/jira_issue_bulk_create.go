@@ -0,0 +1,88 @@
+package models
+
+import "time"
+
+// IssueBulkCreateOptionsScheme customizes the IssueService.BulkCreate method.
+type IssueBulkCreateOptionsScheme struct {
+
+	// ChunkSize is the number of issues created per request, 50 by default and at most, the limit of Jira.
+	ChunkSize int
+
+	// Concurrency is the number of requests sent at the same time, 4 by default.
+	Concurrency int
+
+	// Retries is the number of times the failed issues are created again after a rate limit or a server error.
+	// The issues rejected by Jira, e.g. with a missing required field, aren't retried, and neither are the issues of
+	// the requests without response, e.g. after a timeout, because Jira may have created them.
+	Retries int
+
+	// RetryDelay is the delay before the first retry, it's doubled on every retry, 1 second by default.
+	RetryDelay time.Duration
+
+	// Progress is called with the results of every request.
+	Progress func(results []*IssueBulkCreateResultScheme)
+}
+
+// IssueBulkCreateReportScheme contains the result of every issue of the IssueService.BulkCreate method,
+// in the order of the issues.
+type IssueBulkCreateReportScheme struct {
+	Results []*IssueBulkCreateResultScheme
+	Created int
+	Failed  int
+}
+
+// IssueBulkCreateResultScheme is the result of an issue of a bulk creation.
+type IssueBulkCreateResultScheme struct {
+
+	// Index is the position of the issue on the issues of the caller.
+	Index int
+
+	// ID, Key and Self are set when the issue is created.
+	ID   string
+	Key  string
+	Self string
+
+	// Attempts is the number of times the issue was sent.
+	Attempts int
+
+	// Status is the HTTP status of the failed issues, e.g. 400 when the issue is rejected by Jira.
+	Status int
+
+	// ErrorMessages and Errors are the errors returned by Jira for the issue, the errors are keyed by field ID.
+	ErrorMessages []string
+	Errors        map[string]string
+
+	// Error is the error of the last attempt of the failed issues.
+	Error error
+}
+
+// Messages returns the messages of a failed issue, the errors returned by Jira for the issue or the error of the
+// request, e.g. a timeout.
+func (r *IssueBulkCreateResultScheme) Messages() []string {
+
+	if r.Error == nil {
+		return nil
+	}
+
+	if len(r.ErrorMessages) == 0 && len(r.Errors) == 0 {
+		return []string{r.Error.Error()}
+	}
+
+	return issueBulkErrorMessages(r.ErrorMessages, r.Errors, r.Status)
+}
+
+// NewIssueBulkCreateReport returns the report of the results, counting them.
+func NewIssueBulkCreateReport(results []*IssueBulkCreateResultScheme) *IssueBulkCreateReportScheme {
+
+	report := &IssueBulkCreateReportScheme{Results: results}
+	for _, result := range results {
+
+		if result.Error == nil {
+			report.Created++
+		} else {
+			report.Failed++
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,85 @@
+package models
+
+import "time"
+
+// The statuses of the issues of a bulk operation.
+const (
+	IssueBulkOperationSucceeded = "succeeded"
+	IssueBulkOperationFailed    = "failed"
+	IssueBulkOperationSkipped   = "skipped"
+	IssueBulkOperationPreviewed = "previewed"
+)
+
+// IssueBulkOperationOptionsScheme customizes the IssueService.BulkApply method.
+type IssueBulkOperationOptionsScheme struct {
+
+	// Concurrency is the number of issues updated at the same time, 4 by default.
+	Concurrency int
+
+	// Retries is the number of times an issue is retried after a timeout, a connection reset, a rate limit or a server
+	// error.
+	Retries int
+
+	// RetryDelay is the delay before the first retry, it's doubled on every retry, 1 second by default.
+	RetryDelay time.Duration
+
+	// PageSize is the number of issues requested per search page, 100 by default.
+	PageSize int
+
+	// CheckpointFile records the keys of the issues updated, one per line. The issues of the file are skipped,
+	// an interrupted operation is resumed by running it again with the same file.
+	CheckpointFile string
+
+	// DryRun searches the issues without updating them, the report previews the issues updated.
+	DryRun bool
+
+	// Progress is called with the result of every issue.
+	Progress func(result *IssueBulkOperationResultScheme)
+}
+
+// IssueBulkOperationReportScheme contains the result of every issue matched by the JQL, in the search order.
+type IssueBulkOperationReportScheme struct {
+	Results   []*IssueBulkOperationResultScheme
+	Succeeded int
+	Failed    int
+	Skipped   int
+	Previewed int
+}
+
+// IssueBulkOperationResultScheme is the result of an issue of a bulk operation.
+type IssueBulkOperationResultScheme struct {
+	IssueID  string
+	IssueKey string
+	Summary  string
+	Status   string
+
+	// Result is one of the IssueBulkOperation constants.
+	Result string
+
+	// Attempts is the number of times the operation was applied to the issue.
+	Attempts int
+
+	// Error is the error of the last attempt of the failed issues.
+	Error error
+}
+
+// NewIssueBulkOperationReport returns the report of the results, counting them.
+func NewIssueBulkOperationReport(results []*IssueBulkOperationResultScheme) *IssueBulkOperationReportScheme {
+
+	report := &IssueBulkOperationReportScheme{Results: results}
+	for _, result := range results {
+
+		switch result.Result {
+		case IssueBulkOperationSucceeded:
+			report.Succeeded++
+		case IssueBulkOperationFailed:
+			report.Failed++
+		case IssueBulkOperationSkipped:
+			report.Skipped++
+		case IssueBulkOperationPreviewed:
+			report.Previewed++
+		}
+	}
+
+	return report
+}
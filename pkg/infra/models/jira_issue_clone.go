@@ -0,0 +1,112 @@
+package models
+
+// IssueCloneOptionsScheme customizes the IssueService.Clone method.
+// The subtasks, the issue links, the remote links and the attachments are copied unless they're skipped,
+// the comments and the watchers are copied when they're enabled.
+type IssueCloneOptionsScheme struct {
+
+	// ProjectKey is the project of the clone, the project of the issue by default.
+	ProjectKey string
+
+	// IssueTypeID is the issue type of the clone, the issue type of the issue by default.
+	// The subtasks keep their issue type.
+	IssueTypeID string
+
+	// ParentKey is the parent of the clone, the parent of the issue by default when it's cloned into the same project.
+	// A subtask cloned into another project needs a parent on that project, or a standard IssueTypeID.
+	ParentKey string
+
+	// SummaryPrefix is prepended to the summary of the clones, e.g. "CLONE - ".
+	SummaryPrefix string
+
+	// FieldMapping maps the ID of a field of the issue to the ID of the field of the clone, e.g. when a custom field
+	// of the issue isn't on the screen of the target project. The fields mapped to an empty ID aren't copied.
+	FieldMapping map[string]string
+
+	// SkipScreenCheck copies the fields without checking the create screen of the target project and issue type,
+	// by default the fields that aren't on the screen aren't copied and they're reported on the result.
+	SkipScreenCheck bool
+
+	SkipSubtasks    bool
+	SkipLinks       bool
+	SkipRemoteLinks bool
+	SkipAttachments bool
+
+	Comments bool
+	Watchers bool
+
+	// LinkTypeName links the clone to the issue with the issue link type, e.g. "Cloners".
+	// The issue is the outward issue of the link, and the clone is the inward issue.
+	LinkTypeName string
+}
+
+// IssueCloneScheme is the result of the IssueService.Clone method.
+type IssueCloneScheme struct {
+
+	// Keys maps the key of every issue cloned to the key of its clone, including the subtasks.
+	Keys map[string]string
+
+	// DroppedFields contains the IDs of the fields not copied because they aren't on the create screen,
+	// keyed by the key of the issue.
+	DroppedFields map[string][]string
+}
+
+// issueReadOnlyFields are the fields returned by Jira that aren't cloned or compared, the project, the issue type and
+// the parent of an issue are set apart.
+var issueReadOnlyFields = map[string]bool{
+	"aggregateprogress":             true,
+	"aggregatetimeestimate":         true,
+	"aggregatetimeoriginalestimate": true,
+	"aggregatetimespent":            true,
+	"attachment":                    true,
+	"comment":                       true,
+	"created":                       true,
+	"creator":                       true,
+	"issuekey":                      true,
+	"issuelinks":                    true,
+	"issuetype":                     true,
+	"lastViewed":                    true,
+	"parent":                        true,
+	"progress":                      true,
+	"project":                       true,
+	"resolution":                    true,
+	"resolutiondate":                true,
+	"status":                        true,
+	"statuscategorychangedate":      true,
+	"subtasks":                      true,
+	"thumbnail":                     true,
+	"timeestimate":                  true,
+	"timeoriginalestimate":          true,
+	"timespent":                     true,
+	"updated":                       true,
+	"votes":                         true,
+	"watches":                       true,
+	"worklog":                       true,
+	"workratio":                     true,
+}
+
+// IssueCloneFields returns the fields of an issue that can be copied to its clone, renamed with the mapping.
+// The fields are the raw fields returned by the IssueService.Get method, the empty fields aren't copied.
+func IssueCloneFields(raw map[string]interface{}, mapping map[string]string) map[string]interface{} {
+
+	fields := make(map[string]interface{})
+	for fieldID, value := range raw {
+
+		if issueReadOnlyFields[fieldID] || value == nil {
+			continue
+		}
+
+		if targetID, ok := mapping[fieldID]; ok {
+
+			if targetID == "" {
+				continue
+			}
+
+			fieldID = targetID
+		}
+
+		fields[fieldID] = value
+	}
+
+	return fields
+}
@@ -0,0 +1,213 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// IssueExportOptionsScheme customizes the issue exports of the search service.
+type IssueExportOptionsScheme struct {
+
+	// Columns are the exported values, the DefaultIssueExportColumns are used on the CSV exports when it's empty.
+	// The JSON Lines exports write the whole issue when it's empty.
+	Columns []*IssueExportColumnScheme
+
+	// Fields are the fields returned on the JSON Lines exports without columns, Jira returns the navigable fields when it's empty.
+	Fields []string
+
+	// Expand is sent to the search, e.g. renderedFields or names.
+	Expand []string
+
+	// PageSize is the number of issues requested per page, 100 by default.
+	PageSize int
+
+	// Separator joins the values of the multi-value fields, like labels or components, ", " by default.
+	Separator string
+
+	// SkipHeader omits the header row of the CSV exports.
+	SkipHeader bool
+
+	// Validate is the JQL validation mode, strict, warn or none.
+	Validate string
+
+	// DocumentOptions customizes how the Atlassian Documents, like the description, are converted to plain text.
+	DocumentOptions *DocumentRenderOptions
+}
+
+// IssueExportColumnScheme is an exported value of the issues.
+type IssueExportColumnScheme struct {
+
+	// Header is the name of the column, the field is used when it's empty.
+	Header string
+
+	// Field is the field ID, e.g. summary or customfield_10010, or the key, id and self attributes of the issue.
+	// The values inside the field can be selected with a path, e.g. assignee.emailAddress.
+	Field string
+
+	// Format converts the value of the field, IssueFieldText is used when it's nil.
+	Format func(value interface{}) string
+}
+
+// DefaultIssueExportColumns returns the columns exported when they're not provided.
+func DefaultIssueExportColumns() []*IssueExportColumnScheme {
+
+	var columns []*IssueExportColumnScheme
+	for _, field := range []string{"key", "issuetype", "summary", "status", "priority", "assignee", "reporter", "created", "updated"} {
+		columns = append(columns, &IssueExportColumnScheme{Field: field})
+	}
+
+	return columns
+}
+
+// FieldIDs returns the fields to request on the search to export the columns.
+func (o *IssueExportOptionsScheme) FieldIDs() (fields []string) {
+
+	if len(o.Columns) == 0 {
+		return o.Fields
+	}
+
+	seen := make(map[string]bool)
+
+	for _, column := range o.Columns {
+
+		field := strings.SplitN(column.Field, ".", 2)[0]
+		if isIssueExportAttribute(field) || seen[field] {
+			continue
+		}
+
+		seen[field] = true
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+// Headers returns the name of the columns.
+func (o *IssueExportOptionsScheme) Headers() (headers []string) {
+
+	for _, column := range o.Columns {
+		headers = append(headers, firstNonEmpty(column.Header, column.Field))
+	}
+
+	return headers
+}
+
+// Row returns the value of every column for the issue, the fields are the raw fields returned by Jira.
+func (o *IssueExportOptionsScheme) Row(id, key, self string, fields map[string]interface{}) (row []string) {
+
+	for _, column := range o.Columns {
+
+		path := strings.Split(column.Field, ".")
+
+		var value interface{}
+		switch {
+		case len(path) == 1 && path[0] == "id":
+			value = id
+		case len(path) == 1 && path[0] == "key":
+			value = key
+		case len(path) == 1 && path[0] == "self":
+			value = self
+		default:
+
+			value = fields[path[0]]
+			for _, name := range path[1:] {
+
+				object, _ := value.(map[string]interface{})
+				value = object[name]
+			}
+		}
+
+		if column.Format != nil {
+			row = append(row, column.Format(value))
+			continue
+		}
+
+		row = append(row, IssueFieldText(value, o.separator(), o.DocumentOptions))
+	}
+
+	return row
+}
+
+func (o *IssueExportOptionsScheme) separator() string {
+
+	if o.Separator == "" {
+		return ", "
+	}
+
+	return o.Separator
+}
+
+func isIssueExportAttribute(name string) bool {
+	return name == "id" || name == "key" || name == "self"
+}
+
+// issueFieldTextKeys are the attributes used as the text of the objects, in order, e.g. the option value or the user name.
+var issueFieldTextKeys = []string{"value", "displayName", "name", "key", "emailAddress", "id"}
+
+// IssueFieldText converts a raw field value to text.
+// The lists are joined with the separator, the Atlassian Documents are converted to plain text and the objects
+// are represented by their value, display name, name, key, email address or ID, e.g. the user display name.
+// The cascading options are joined with " - " and the other objects are encoded as JSON.
+func IssueFieldText(value interface{}, separator string, options *DocumentRenderOptions) string {
+
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(typed)
+	case []interface{}:
+
+		var values []string
+		for _, element := range typed {
+
+			if text := IssueFieldText(element, separator, options); text != "" {
+				values = append(values, text)
+			}
+		}
+
+		return strings.Join(values, separator)
+
+	case map[string]interface{}:
+
+		if typed["type"] == "doc" {
+
+			document := &CommentNodeScheme{}
+			if data, err := json.Marshal(typed); err == nil && json.Unmarshal(data, document) == nil {
+				return document.PlainText(options)
+			}
+		}
+
+		for _, key := range issueFieldTextKeys {
+
+			text, ok := typed[key].(string)
+			if !ok || text == "" {
+				continue
+			}
+
+			if child, ok := typed["child"].(map[string]interface{}); ok && key == "value" {
+
+				if childText := IssueFieldText(child, separator, options); childText != "" {
+					return text + " - " + childText
+				}
+			}
+
+			return text
+		}
+
+		// The keys are sorted by encoding/json, the output is stable
+		data, err := json.Marshal(typed)
+		if err != nil {
+			return ""
+		}
+
+		return string(data)
+	}
+
+	return fmt.Sprint(value)
+}
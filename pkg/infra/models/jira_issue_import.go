@@ -0,0 +1,293 @@
+package models
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// IssueImportMappingScheme maps the columns of a CSV file to the fields of the issues created by the importer.
+type IssueImportMappingScheme struct {
+
+	// Columns maps the CSV columns to the issue fields, the columns without mapping are ignored.
+	Columns []*IssueImportColumnScheme
+
+	// ProjectKey is the project of the rows without a value mapped to the project field.
+	ProjectKey string
+
+	// IssueType is the issue type name of the rows without a value mapped to the issuetype field.
+	IssueType string
+
+	// IDColumn is the column identifying the rows, it's referenced by the ParentColumn of the other rows.
+	IDColumn string
+
+	// ParentColumn is the column with the parent of the row, the IDColumn value of another row of the file
+	// or the key of an existing issue. The rows are created after their parents, e.g. the subtasks.
+	ParentColumn string
+
+	// Separator splits the values of the multi-value fields, like labels or components, "," by default.
+	Separator string
+}
+
+// IssueImportColumnScheme maps a CSV column to an issue field.
+type IssueImportColumnScheme struct {
+
+	// Column is the header of the CSV column.
+	Column string
+
+	// Field is the field ID, e.g. summary or customfield_10010.
+	Field string
+
+	// Hint is one of the IssueFieldHint constants, the system fields use their default hint when it's empty and the
+	// custom fields use IssueFieldHintText. The user hints accept account IDs or emails, looked up by the importer,
+	// and the select, multiselect, radio and checkbox values are looked up on the options of the create screen.
+	Hint string
+
+	// Layout is the time layout of the date and date-time values, they're sent as they're written when it's empty.
+	Layout string
+
+	// Values replaces the CSV values before they're encoded, e.g. High to Highest, the other values are kept.
+	Values map[string]string
+}
+
+// IssueImportResolver looks up a CSV value before it's encoded, e.g. returning the account ID of an email.
+// It's called with every value of the multi-value fields.
+type IssueImportResolver func(projectKey, fieldID, hint, value string) (string, error)
+
+// IssueImportRowError contains every mapping error of a row.
+type IssueImportRowError struct {
+	Messages []string
+}
+
+func (e *IssueImportRowError) Error() string {
+	return "jira: the row can't be imported: " + strings.Join(e.Messages, "; ")
+}
+
+// IssueImportReportScheme contains the result of every imported row.
+type IssueImportReportScheme struct {
+	Rows    []*IssueImportRowScheme
+	Created int
+	Failed  int
+}
+
+// IssueImportRowScheme is the result of an imported row.
+type IssueImportRowScheme struct {
+
+	// Row is the position of the row on the CSV file, the first row after the header is the row 1.
+	Row int
+
+	// ID is the IDColumn value of the row.
+	ID string
+
+	// IssueID and IssueKey identify the issue created for the row.
+	IssueID  string
+	IssueKey string
+
+	// Errors contains the mapping errors or the errors returned by Jira when the issue wasn't created.
+	Errors []string
+}
+
+// issueImportListFields contains the system fields with multiple values.
+var issueImportListFields = map[string]bool{
+	"labels":      true,
+	"components":  true,
+	"fixVersions": true,
+	"versions":    true,
+}
+
+// issueImportListHints contains the value hints with multiple values.
+var issueImportListHints = map[string]bool{
+	IssueFieldHintMultiSelect: true,
+	IssueFieldHintCheckBox:    true,
+	IssueFieldHintUsers:       true,
+	IssueFieldHintGroups:      true,
+	IssueFieldHintCascading:   true,
+}
+
+// RowProjectKey returns the project of the row.
+func (m *IssueImportMappingScheme) RowProjectKey(record map[string]string) string {
+
+	for _, column := range m.Columns {
+
+		if column.Field == "project" && strings.TrimSpace(record[column.Column]) != "" {
+			return strings.TrimSpace(record[column.Column])
+		}
+	}
+
+	return m.ProjectKey
+}
+
+// RowIssueType returns the issue type name or ID of the row.
+func (m *IssueImportMappingScheme) RowIssueType(record map[string]string) string {
+
+	for _, column := range m.Columns {
+
+		if column.Field == "issuetype" && strings.TrimSpace(record[column.Column]) != "" {
+			return strings.TrimSpace(record[column.Column])
+		}
+	}
+
+	return m.IssueType
+}
+
+// Fields maps the row, keyed by the column headers, to the fields of the issue.
+// The documents are sent as Atlassian Documents when adf is set, or as text for the v2 API.
+// The empty values are omitted, and every invalid value is reported on a single IssueImportRowError.
+func (m *IssueImportMappingScheme) Fields(record map[string]string, adf bool, resolve IssueImportResolver) (
+	fields *CustomFields, err error) {
+
+	fields = &CustomFields{}
+	projectKey := m.RowProjectKey(record)
+
+	var (
+		messages []string
+		mapped   = make(map[string]bool)
+	)
+
+	for _, column := range m.Columns {
+
+		value, err := m.value(column, record, projectKey, adf, resolve)
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("column %q: %v", column.Column, err))
+			continue
+		}
+
+		if value == nil {
+			continue
+		}
+
+		mapped[column.Field] = true
+		fields.Fields = append(fields.Fields, map[string]interface{}{"fields": map[string]interface{}{column.Field: value}})
+	}
+
+	if !mapped["project"] && m.ProjectKey != "" {
+		fields.Fields = append(fields.Fields, map[string]interface{}{"fields": map[string]interface{}{
+			"project": map[string]interface{}{"key": m.ProjectKey}}})
+	}
+
+	if !mapped["issuetype"] && m.IssueType != "" {
+		fields.Fields = append(fields.Fields, map[string]interface{}{"fields": map[string]interface{}{
+			"issuetype": map[string]interface{}{"name": m.IssueType}}})
+	}
+
+	if len(messages) != 0 {
+		return nil, &IssueImportRowError{Messages: messages}
+	}
+
+	return fields, nil
+}
+
+func (m *IssueImportMappingScheme) value(column *IssueImportColumnScheme, record map[string]string, projectKey string,
+	adf bool, resolve IssueImportResolver) (interface{}, error) {
+
+	cell, ok := record[column.Column]
+	if !ok {
+		return nil, fmt.Errorf("the column doesn't exist")
+	}
+
+	if strings.TrimSpace(cell) == "" {
+		return nil, nil
+	}
+
+	hint := column.Hint
+	if hint == "" {
+
+		hint = issueSystemFieldHints[column.Field]
+		if hint == "" {
+			hint = IssueFieldHintText
+		}
+	}
+
+	var values []string
+	if issueImportListFields[column.Field] || issueImportListHints[hint] {
+
+		separator := m.Separator
+		if separator == "" {
+			separator = ","
+		}
+
+		for _, value := range strings.Split(cell, separator) {
+
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+
+		if len(values) == 0 {
+			return nil, nil
+		}
+
+	} else if hint == IssueFieldHintADF || hint == IssueFieldHintText {
+		values = []string{cell}
+	} else {
+		values = []string{strings.TrimSpace(cell)}
+	}
+
+	for index, value := range values {
+
+		if replacement, ok := column.Values[value]; ok {
+			value = replacement
+		}
+
+		if resolve != nil {
+
+			resolved, err := resolve(projectKey, column.Field, hint, value)
+			if err != nil {
+				return nil, err
+			}
+
+			value = resolved
+		}
+
+		values[index] = value
+	}
+
+	if hint == IssueFieldHintDate || hint == IssueFieldHintDateTime {
+
+		if column.Layout == "" {
+			return values[0], nil
+		}
+
+		date, err := time.Parse(column.Layout, values[0])
+		if err != nil {
+			return nil, fmt.Errorf("the date %q doesn't match the layout %q", values[0], column.Layout)
+		}
+
+		return encodeIssueValue(reflect.ValueOf(date), hint, adf)
+	}
+
+	if issueImportListFields[column.Field] || issueImportListHints[hint] {
+		return encodeIssueValue(reflect.ValueOf(values), hint, adf)
+	}
+
+	return encodeIssueValue(reflect.ValueOf(values[0]), hint, adf)
+}
+
+// IssueBulkErrorMessages returns the messages of a bulk creation error, the field errors are prefixed by the field ID.
+func IssueBulkErrorMessages(bulkError *IssueBulkResponseErrorScheme) []string {
+	return issueBulkErrorMessages(bulkError.ElementErrors.ErrorMessages, bulkError.ElementErrors.Errors, bulkError.Status)
+}
+
+func issueBulkErrorMessages(errorMessages []string, errors map[string]string, status int) (messages []string) {
+
+	messages = append(messages, errorMessages...)
+
+	var fields []string
+	for field := range errors {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		messages = append(messages, fmt.Sprintf("%v: %v", field, errors[field]))
+	}
+
+	if len(messages) == 0 {
+		messages = append(messages, fmt.Sprintf("the issue wasn't created, status code %v", status))
+	}
+
+	return messages
+}
@@ -0,0 +1,882 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IssueMarshalOptions customizes how MarshalIssue and UnmarshalIssue resolve the `jira` struct tags.
+//
+// The tags use the following format:
+//
+//	Summary     string    `jira:"summary"`
+//	StoryPoints float64   `jira:"customfield_10016,number"`
+//	Team        string    `jira:"field=Team,select,omitempty"`
+//	DueDate     time.Time `jira:"duedate,date"`
+//
+// The first element is the field ID, or field=<name> to look the ID up on FieldIDs.
+// The optional elements are a value hint (see the IssueFieldHint constants) and omitempty.
+//
+// The empty values, e.g. the empty strings and slices, the zero numbers and times or the nil pointers, are sent
+// as null to clear the fields, or skipped with omitempty. Use a pointer to send a zero number.
+type IssueMarshalOptions struct {
+
+	// FieldIDs maps the field names used on the field=<name> tags to the field IDs.
+	FieldIDs map[string]string
+}
+
+// AddFields registers the field names returned by the FieldService.Gets method.
+func (o *IssueMarshalOptions) AddFields(fields []*IssueFieldScheme) {
+
+	if o.FieldIDs == nil {
+		o.FieldIDs = make(map[string]string)
+	}
+
+	for _, field := range fields {
+		if field == nil || field.ID == "" || field.Name == "" {
+			continue
+		}
+
+		o.FieldIDs[field.Name] = field.ID
+	}
+}
+
+func (o *IssueMarshalOptions) fieldID(name string) (string, error) {
+
+	if o != nil {
+		if fieldID, ok := o.FieldIDs[name]; ok {
+			return fieldID, nil
+		}
+	}
+
+	return "", fmt.Errorf("jira: no field id found for the field name %q", name)
+}
+
+// The value hints supported by the `jira` struct tags.
+const (
+	IssueFieldHintRaw         = "raw"
+	IssueFieldHintText        = "text"
+	IssueFieldHintADF         = "adf"
+	IssueFieldHintNumber      = "number"
+	IssueFieldHintDate        = "date"
+	IssueFieldHintDateTime    = "datetime"
+	IssueFieldHintSelect      = "select"
+	IssueFieldHintMultiSelect = "multiselect"
+	IssueFieldHintRadio       = "radio"
+	IssueFieldHintCheckBox    = "checkbox"
+	IssueFieldHintCascading   = "cascading"
+	IssueFieldHintUser        = "user"
+	IssueFieldHintUsers       = "users"
+	IssueFieldHintGroup       = "group"
+	IssueFieldHintGroups      = "groups"
+	IssueFieldHintName        = "name"
+	IssueFieldHintID          = "id"
+	IssueFieldHintKey         = "key"
+)
+
+// issueSystemFieldHints contains the value hint used when a system field tag doesn't provide one.
+var issueSystemFieldHints = map[string]string{
+	"project":     IssueFieldHintKey,
+	"issuetype":   IssueFieldHintName,
+	"parent":      IssueFieldHintKey,
+	"priority":    IssueFieldHintName,
+	"resolution":  IssueFieldHintName,
+	"security":    IssueFieldHintID,
+	"assignee":    IssueFieldHintUser,
+	"reporter":    IssueFieldHintUser,
+	"creator":     IssueFieldHintUser,
+	"components":  IssueFieldHintName,
+	"fixVersions": IssueFieldHintName,
+	"versions":    IssueFieldHintName,
+	"status":      IssueFieldHintName,
+	"duedate":     IssueFieldHintDate,
+	"labels":      IssueFieldHintRaw,
+	"summary":     IssueFieldHintText,
+	"description": IssueFieldHintADF,
+	"environment": IssueFieldHintADF,
+}
+
+var issueDateTimeLayouts = []string{
+	DateFormatJira,
+	time.RFC3339Nano,
+	DateFormatJiraDay,
+}
+
+type issueFieldTag struct {
+	ID        string
+	Hint      string
+	OmitEmpty bool
+}
+
+func parseIssueFieldTag(tag string, options *IssueMarshalOptions) (*issueFieldTag, error) {
+
+	elements := strings.Split(tag, ",")
+
+	field := &issueFieldTag{ID: strings.TrimSpace(elements[0])}
+	if field.ID == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidIssueTagError, tag)
+	}
+
+	if strings.HasPrefix(field.ID, "field=") {
+
+		fieldID, err := options.fieldID(strings.TrimPrefix(field.ID, "field="))
+		if err != nil {
+			return nil, err
+		}
+
+		field.ID = fieldID
+	}
+
+	for _, element := range elements[1:] {
+
+		switch element = strings.TrimSpace(element); element {
+		case "":
+		case "omitempty":
+			field.OmitEmpty = true
+		default:
+			field.Hint = element
+		}
+	}
+
+	if field.Hint == "" {
+		field.Hint = issueSystemFieldHints[field.ID]
+	}
+
+	return field, nil
+}
+
+// MarshalIssue converts a struct with `jira` tags into the payload and custom fields expected by the
+// IssueService.Create and IssueService.Update methods of the v3 client.
+// The custom fields are nil when every tagged field maps to an IssueFieldsScheme field.
+func MarshalIssue(value interface{}, options *IssueMarshalOptions) (payload *IssueScheme, customFields *CustomFields, err error) {
+
+	fields, err := marshalIssueFields(value, options, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payload = &IssueScheme{Fields: &IssueFieldsScheme{}}
+
+	customFields, err = splitIssueFields(fields, payload.Fields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return payload, customFields, nil
+}
+
+// MarshalIssueV2 converts a struct with `jira` tags into the payload and custom fields expected by the
+// IssueService.Create and IssueService.Update methods of the v2 client.
+// The custom fields are nil when every tagged field maps to an IssueFieldsSchemeV2 field.
+func MarshalIssueV2(value interface{}, options *IssueMarshalOptions) (payload *IssueSchemeV2, customFields *CustomFields, err error) {
+
+	fields, err := marshalIssueFields(value, options, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payload = &IssueSchemeV2{Fields: &IssueFieldsSchemeV2{}}
+
+	customFields, err = splitIssueFields(fields, payload.Fields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return payload, customFields, nil
+}
+
+// UnmarshalIssue decodes the fields of a v3 issue into the struct pointed by target using its `jira` tags.
+func UnmarshalIssue(issue *IssueScheme, target interface{}, options *IssueMarshalOptions) error {
+
+	if issue == nil || issue.Fields == nil {
+		return ErrNoIssueFieldsError
+	}
+
+	fields := issue.Fields.Raw
+	if fields == nil {
+		fields = issueFieldsAsMap(issue.Fields)
+	}
+
+	return unmarshalIssueFields(fields, target, options)
+}
+
+// UnmarshalIssueV2 decodes the fields of a v2 issue into the struct pointed by target using its `jira` tags.
+func UnmarshalIssueV2(issue *IssueSchemeV2, target interface{}, options *IssueMarshalOptions) error {
+
+	if issue == nil || issue.Fields == nil {
+		return ErrNoIssueFieldsError
+	}
+
+	fields := issue.Fields.Raw
+	if fields == nil {
+		fields = issueFieldsAsMap(issue.Fields)
+	}
+
+	return unmarshalIssueFields(fields, target, options)
+}
+
+type issueField struct {
+	ID    string
+	Value interface{}
+}
+
+func marshalIssueFields(value interface{}, options *IssueMarshalOptions, adf bool) (fields []*issueField, err error) {
+
+	structValue := reflect.ValueOf(value)
+	for structValue.Kind() == reflect.Ptr {
+
+		if structValue.IsNil() {
+			return nil, ErrNoIssueStructError
+		}
+
+		structValue = structValue.Elem()
+	}
+
+	if structValue.Kind() != reflect.Struct {
+		return nil, ErrNoIssueStructError
+	}
+
+	err = walkIssueStruct(structValue, options, func(tag *issueFieldTag, fieldValue reflect.Value) error {
+
+		if isEmptyIssueValue(fieldValue) {
+
+			if !tag.OmitEmpty {
+				fields = append(fields, &issueField{ID: tag.ID})
+			}
+
+			return nil
+		}
+
+		encoded, err := encodeIssueValue(fieldValue, tag.Hint, adf)
+		if err != nil {
+			return fmt.Errorf("jira: field %v: %w", tag.ID, err)
+		}
+
+		fields = append(fields, &issueField{ID: tag.ID, Value: encoded})
+		return nil
+	})
+
+	return fields, err
+}
+
+func unmarshalIssueFields(fields map[string]interface{}, target interface{}, options *IssueMarshalOptions) error {
+
+	targetValue := reflect.ValueOf(target)
+	if targetValue.Kind() != reflect.Ptr || targetValue.IsNil() || targetValue.Elem().Kind() != reflect.Struct {
+		return ErrNoIssueTargetError
+	}
+
+	return walkIssueStruct(targetValue.Elem(), options, func(tag *issueFieldTag, fieldValue reflect.Value) error {
+
+		value, ok := fields[tag.ID]
+		if !ok || value == nil {
+			return nil
+		}
+
+		if err := decodeIssueValue(value, fieldValue, tag.Hint); err != nil {
+			return fmt.Errorf("jira: field %v: %w", tag.ID, err)
+		}
+
+		return nil
+	})
+}
+
+// walkIssueStruct calls fn for every exported field with a `jira` tag, including the fields of embedded structs.
+func walkIssueStruct(structValue reflect.Value, options *IssueMarshalOptions, fn func(*issueFieldTag, reflect.Value) error) error {
+
+	structType := structValue.Type()
+
+	for index := 0; index < structType.NumField(); index++ {
+
+		structField := structType.Field(index)
+		tag, hasTag := structField.Tag.Lookup("jira")
+
+		if tag == "-" {
+			continue
+		}
+
+		if !hasTag {
+
+			if structField.Anonymous && structField.Type.Kind() == reflect.Struct {
+				if err := walkIssueStruct(structValue.Field(index), options, fn); err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		if structField.PkgPath != "" {
+			continue
+		}
+
+		fieldTag, err := parseIssueFieldTag(tag, options)
+		if err != nil {
+			return err
+		}
+
+		if err = fn(fieldTag, structValue.Field(index)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// splitIssueFields sets the fields known by the fields struct and returns the rest as custom fields.
+// The null values are returned as custom fields as well, the fields struct would omit them.
+func splitIssueFields(fields []*issueField, fieldsStruct interface{}) (*CustomFields, error) {
+
+	knownFields := jsonFieldNames(reflect.TypeOf(fieldsStruct).Elem())
+	customFields := &CustomFields{}
+
+	for _, field := range fields {
+
+		if knownFields[field.ID] && field.Value != nil {
+
+			fieldAsBytes, err := json.Marshal(map[string]interface{}{field.ID: field.Value})
+			if err != nil {
+				return nil, err
+			}
+
+			// Values that don't fit the typed field are sent untouched as custom fields
+			if err = json.Unmarshal(fieldAsBytes, fieldsStruct); err == nil {
+				continue
+			}
+		}
+
+		customFields.Fields = append(customFields.Fields, map[string]interface{}{
+			"fields": map[string]interface{}{field.ID: field.Value},
+		})
+	}
+
+	if len(customFields.Fields) == 0 {
+		return nil, nil
+	}
+
+	return customFields, nil
+}
+
+func jsonFieldNames(structType reflect.Type) map[string]bool {
+
+	names := make(map[string]bool)
+
+	for index := 0; index < structType.NumField(); index++ {
+
+		name := strings.Split(structType.Field(index).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+
+	return names
+}
+
+func issueFieldsAsMap(fields interface{}) map[string]interface{} {
+
+	fieldsAsBytes, _ := json.Marshal(fields)
+
+	fieldsAsMap := make(map[string]interface{})
+	_ = json.Unmarshal(fieldsAsBytes, &fieldsAsMap)
+
+	return fieldsAsMap
+}
+
+func isEmptyIssueValue(value reflect.Value) bool {
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.String:
+		return value.Len() == 0
+	}
+
+	return value.IsZero()
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func encodeIssueValue(value reflect.Value, hint string, adf bool) (interface{}, error) {
+
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+
+		if value.IsNil() {
+			return nil, nil
+		}
+
+		value = value.Elem()
+	}
+
+	if hint == "" {
+		hint = defaultIssueHint(value)
+	}
+
+	switch hint {
+	case IssueFieldHintRaw:
+		return value.Interface(), nil
+
+	case IssueFieldHintText:
+		return issueValueAsString(value)
+
+	case IssueFieldHintADF:
+
+		// Documents already built by the caller are sent as they are
+		if value.Kind() == reflect.Struct || value.Kind() == reflect.Map {
+			return value.Interface(), nil
+		}
+
+		text, err := issueValueAsString(value)
+		if err != nil || !adf {
+			return text, err
+		}
+
+		return textAsDocument(text), nil
+
+	case IssueFieldHintNumber:
+
+		switch value.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return float64(value.Int()), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return float64(value.Uint()), nil
+		case reflect.Float32, reflect.Float64:
+			return value.Float(), nil
+		case reflect.String:
+			return strconv.ParseFloat(value.String(), 64)
+		}
+
+		return nil, fmt.Errorf("the %v type can't be encoded as a number", value.Type())
+
+	case IssueFieldHintDate, IssueFieldHintDateTime:
+
+		if value.Type() == timeType {
+
+			date := value.Interface().(time.Time)
+			if hint == IssueFieldHintDate {
+				return date.Format(DateFormatJiraDay), nil
+			}
+
+			return date.Format(DateFormatJira), nil
+		}
+
+		return issueValueAsString(value)
+
+	case IssueFieldHintSelect, IssueFieldHintRadio:
+		return issueObject(value, "value")
+
+	case IssueFieldHintMultiSelect, IssueFieldHintCheckBox:
+		return issueObjects(value, "value")
+
+	case IssueFieldHintUser:
+		return issueObject(value, "accountId")
+
+	case IssueFieldHintUsers:
+		return issueObjects(value, "accountId")
+
+	case IssueFieldHintGroup:
+		return issueObject(value, "name")
+
+	case IssueFieldHintGroups:
+		return issueObjects(value, "name")
+
+	case IssueFieldHintName, IssueFieldHintID, IssueFieldHintKey:
+
+		if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+			return issueObjects(value, hint)
+		}
+
+		return issueObject(value, hint)
+
+	case IssueFieldHintCascading:
+
+		options, err := issueValueAsStrings(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(options) == 0 || len(options) > 2 {
+			return nil, fmt.Errorf("a cascading value needs a parent and an optional child option")
+		}
+
+		cascading := map[string]interface{}{"value": options[0]}
+		if len(options) == 2 {
+			cascading["child"] = map[string]interface{}{"value": options[1]}
+		}
+
+		return cascading, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown value hint %q", ErrInvalidIssueTagError, hint)
+}
+
+func defaultIssueHint(value reflect.Value) string {
+
+	if value.Type() == timeType {
+		return IssueFieldHintDateTime
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return IssueFieldHintText
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return IssueFieldHintNumber
+	}
+
+	return IssueFieldHintRaw
+}
+
+func issueValueAsString(value reflect.Value) (string, error) {
+
+	if value.Kind() == reflect.String {
+		return value.String(), nil
+	}
+
+	if stringer, ok := value.Interface().(fmt.Stringer); ok {
+		return stringer.String(), nil
+	}
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	}
+
+	return "", fmt.Errorf("the %v type can't be encoded as a string", value.Type())
+}
+
+func issueValueAsStrings(value reflect.Value) ([]string, error) {
+
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+
+		element, err := issueValueAsString(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{element}, nil
+	}
+
+	elements := make([]string, 0, value.Len())
+	for index := 0; index < value.Len(); index++ {
+
+		element, err := issueValueAsString(reflect.Indirect(value.Index(index)))
+		if err != nil {
+			return nil, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	return elements, nil
+}
+
+func issueObject(value reflect.Value, key string) (interface{}, error) {
+
+	if value.Kind() == reflect.Struct || value.Kind() == reflect.Map {
+		return value.Interface(), nil
+	}
+
+	element, err := issueValueAsString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{key: element}, nil
+}
+
+func issueObjects(value reflect.Value, key string) (interface{}, error) {
+
+	if value.Kind() == reflect.Slice && value.Type().Elem().Kind() != reflect.String {
+		return value.Interface(), nil
+	}
+
+	elements, err := issueValueAsStrings(value)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]map[string]interface{}, 0, len(elements))
+	for _, element := range elements {
+		objects = append(objects, map[string]interface{}{key: element})
+	}
+
+	return objects, nil
+}
+
+// textAsDocument wraps a plain text value into an Atlassian Document, one paragraph per line.
+func textAsDocument(text string) *CommentNodeScheme {
+
+	document := &CommentNodeScheme{Version: 1, Type: "doc"}
+
+	for _, line := range strings.Split(text, "\n") {
+
+		paragraph := &CommentNodeScheme{Type: "paragraph"}
+		if line != "" {
+			paragraph.AppendNode(&CommentNodeScheme{Type: "text", Text: line})
+		}
+
+		document.AppendNode(paragraph)
+	}
+
+	return document
+}
+
+// documentAsText extracts the text of an Atlassian Document, one line per block node.
+func documentAsText(document map[string]interface{}) string {
+
+	var lines []string
+
+	var walk func(node map[string]interface{})
+	walk = func(node map[string]interface{}) {
+
+		children := documentChildren(node)
+
+		if !hasBlockChildren(children) {
+			lines = append(lines, inlineNodesAsText(children))
+			return
+		}
+
+		for _, child := range children {
+			walk(child)
+		}
+	}
+
+	for _, child := range documentChildren(document) {
+		walk(child)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func documentChildren(node map[string]interface{}) (children []map[string]interface{}) {
+
+	content, _ := node["content"].([]interface{})
+	for _, child := range content {
+
+		if childNode, ok := child.(map[string]interface{}); ok {
+			children = append(children, childNode)
+		}
+	}
+
+	return children
+}
+
+func hasBlockChildren(children []map[string]interface{}) bool {
+
+	for _, child := range children {
+
+		if childType, _ := child["type"].(string); !isInlineNodeType(childType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func inlineNodesAsText(nodes []map[string]interface{}) string {
+
+	var text strings.Builder
+
+	for _, node := range nodes {
+
+		attributes, _ := node["attrs"].(map[string]interface{})
+
+		switch node["type"] {
+		case "text":
+			text.WriteString(fmt.Sprint(node["text"]))
+		case "hardBreak":
+			text.WriteString("\n")
+		case "mention", "status":
+			text.WriteString(fmt.Sprint(attributes["text"]))
+		case "emoji":
+			text.WriteString(fmt.Sprint(attributes["shortName"]))
+		case "inlineCard":
+			text.WriteString(fmt.Sprint(attributes["url"]))
+		}
+	}
+
+	return text.String()
+}
+
+func isInlineNodeType(nodeType string) bool {
+
+	switch nodeType {
+	case "text", "hardBreak", "mention", "emoji", "inlineCard", "status", "date":
+		return true
+	}
+
+	return false
+}
+
+func decodeIssueValue(value interface{}, target reflect.Value, hint string) error {
+
+	if target.Kind() == reflect.Ptr {
+
+		element := reflect.New(target.Type().Elem())
+		if err := decodeIssueValue(value, element.Elem(), hint); err != nil {
+			return err
+		}
+
+		target.Set(element)
+		return nil
+	}
+
+	if target.Type() == timeType {
+
+		date, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("the %T value can't be decoded as a date", value)
+		}
+
+		for _, layout := range issueDateTimeLayouts {
+
+			if parsed, err := time.Parse(layout, date); err == nil {
+				target.Set(reflect.ValueOf(parsed))
+				return nil
+			}
+		}
+
+		return fmt.Errorf("the %q value is not a valid date", date)
+	}
+
+	switch target.Kind() {
+	case reflect.String:
+
+		text, err := issueString(value, hint)
+		if err != nil {
+			return err
+		}
+
+		target.SetString(text)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+
+		number, err := issueNumber(value)
+		if err != nil {
+			return err
+		}
+
+		switch target.Kind() {
+		case reflect.Float32, reflect.Float64:
+			target.SetFloat(number)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			target.SetUint(uint64(number))
+		default:
+			target.SetInt(int64(number))
+		}
+
+		return nil
+
+	case reflect.Slice:
+
+		if target.Type().Elem().Kind() != reflect.String {
+			break
+		}
+
+		var elements []interface{}
+
+		switch typedValue := value.(type) {
+		case []interface{}:
+			elements = typedValue
+		case map[string]interface{}:
+
+			// The cascading fields are decoded as the parent and child options
+			if hint == IssueFieldHintCascading {
+
+				elements = append(elements, typedValue)
+				if child, ok := typedValue["child"]; ok {
+					elements = append(elements, child)
+				}
+
+				break
+			}
+
+			elements = []interface{}{typedValue}
+		default:
+			elements = []interface{}{typedValue}
+		}
+
+		texts := reflect.MakeSlice(target.Type(), 0, len(elements))
+		for _, element := range elements {
+
+			text, err := issueString(element, hint)
+			if err != nil {
+				return err
+			}
+
+			texts = reflect.Append(texts, reflect.ValueOf(text).Convert(target.Type().Elem()))
+		}
+
+		target.Set(texts)
+		return nil
+	}
+
+	valueAsBytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(valueAsBytes, target.Addr().Interface())
+}
+
+var issueObjectKeys = map[string][]string{
+	IssueFieldHintSelect:      {"value"},
+	IssueFieldHintMultiSelect: {"value"},
+	IssueFieldHintRadio:       {"value"},
+	IssueFieldHintCheckBox:    {"value"},
+	IssueFieldHintCascading:   {"value"},
+	IssueFieldHintUser:        {"accountId"},
+	IssueFieldHintUsers:       {"accountId"},
+	IssueFieldHintGroup:       {"name"},
+	IssueFieldHintGroups:      {"name"},
+	IssueFieldHintName:        {"name"},
+	IssueFieldHintID:          {"id"},
+	IssueFieldHintKey:         {"key"},
+}
+
+func issueString(value interface{}, hint string) (string, error) {
+
+	switch typedValue := value.(type) {
+	case string:
+		return typedValue, nil
+	case float64:
+		return strconv.FormatFloat(typedValue, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(typedValue), nil
+	case map[string]interface{}:
+
+		if typedValue["type"] == "doc" {
+			return documentAsText(typedValue), nil
+		}
+
+		keys := []string{"value", "name", "key", "accountId", "id"}
+		for _, key := range append(issueObjectKeys[hint], keys...) {
+
+			if text, ok := typedValue[key].(string); ok {
+				return text, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("the %T value can't be decoded as a string", value)
+}
+
+func issueNumber(value interface{}) (float64, error) {
+
+	switch typedValue := value.(type) {
+	case float64:
+		return typedValue, nil
+	case string:
+		return strconv.ParseFloat(typedValue, 64)
+	}
+
+	return 0, fmt.Errorf("the %T value can't be decoded as a number", value)
+}
@@ -0,0 +1,370 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type mockTaggedIssue struct {
+	Summary     string    `jira:"summary"`
+	Description string    `jira:"description"`
+	Project     string    `jira:"project"`
+	IssueType   string    `jira:"issuetype"`
+	Labels      []string  `jira:"labels"`
+	Components  []string  `jira:"components"`
+	DueDate     time.Time `jira:"duedate,date"`
+	Assignee    string    `jira:"assignee,omitempty"`
+	StoryPoints float64   `jira:"customfield_10016"`
+	Team        string    `jira:"field=Team,select,omitempty"`
+	Platforms   []string  `jira:"customfield_10020,multiselect"`
+	Region      []string  `jira:"customfield_10030,cascading"`
+	Ignored     string    `jira:"-"`
+}
+
+func TestMarshalIssue(t *testing.T) {
+
+	options := &IssueMarshalOptions{}
+	options.AddFields([]*IssueFieldScheme{{ID: "customfield_10040", Name: "Team"}, {ID: "", Name: "Ignored"}})
+
+	issue := &mockTaggedIssue{
+		Summary:     "Migrate the billing service",
+		Description: "First line\nSecond line",
+		Project:     "KP",
+		IssueType:   "Story",
+		Labels:      []string{"backend"},
+		Components:  []string{"API"},
+		DueDate:     time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC),
+		StoryPoints: 3,
+		Team:        "Team A",
+		Platforms:   []string{"iOS", "Android"},
+		Region:      []string{"EU", "ES"},
+		Ignored:     "not sent",
+	}
+
+	customFields := `{"Fields":[
+		{"fields":{"customfield_10016":3}},
+		{"fields":{"customfield_10040":{"value":"Team A"}}},
+		{"fields":{"customfield_10020":[{"value":"iOS"},{"value":"Android"}]}},
+		{"fields":{"customfield_10030":{"value":"EU","child":{"value":"ES"}}}}]}`
+
+	testCases := []struct {
+		name             string
+		value            interface{}
+		options          *IssueMarshalOptions
+		wantPayload      string
+		wantCustomFields string
+		wantErr          error
+	}{
+		{
+			name:    "MarshalIssueWhenTheTagsAreValid",
+			value:   issue,
+			options: options,
+			wantPayload: `{"fields":{"summary":"Migrate the billing service","project":{"key":"KP"},"issuetype":{"name":"Story"},
+				"labels":["backend"],"components":[{"name":"API"}],"duedate":"2022-01-07",
+				"description":{"version":1,"type":"doc","content":[
+					{"type":"paragraph","content":[{"type":"text","text":"First line"}]},
+					{"type":"paragraph","content":[{"type":"text","text":"Second line"}]}]}}}`,
+			wantCustomFields: customFields,
+		},
+
+		{
+			// The empty values are sent as null to clear the fields, unless they're omitted
+			name:        "MarshalIssueWhenTheFieldsAreEmpty",
+			value:       mockTaggedIssue{Summary: "Empty", Region: []string{"EU"}},
+			options:     options,
+			wantPayload: `{"fields":{"summary":"Empty"}}`,
+			wantCustomFields: `{"Fields":[{"fields":{"description":null}},{"fields":{"project":null}},
+				{"fields":{"issuetype":null}},{"fields":{"labels":null}},{"fields":{"components":null}},
+				{"fields":{"duedate":null}},{"fields":{"customfield_10016":null}},{"fields":{"customfield_10020":null}},
+				{"fields":{"customfield_10030":{"value":"EU"}}}]}`,
+		},
+
+		{
+			name: "MarshalIssueWhenTheValuesArePointers",
+			value: &struct {
+				Summary     string   `jira:"summary"`
+				StoryPoints *float64 `jira:"customfield_10016"`
+				Estimate    *float64 `jira:"customfield_10017"`
+			}{
+				Summary:     "Pointers",
+				StoryPoints: new(float64),
+			},
+			wantPayload:      `{"fields":{"summary":"Pointers"}}`,
+			wantCustomFields: `{"Fields":[{"fields":{"customfield_10016":0}},{"fields":{"customfield_10017":null}}]}`,
+		},
+
+		{
+			name: "MarshalIssueWhenTheValueIsADateTime",
+			value: &struct {
+				Summary string    `jira:"summary"`
+				Started time.Time `jira:"customfield_10050"`
+			}{Summary: "Started", Started: time.Date(2022, 1, 7, 10, 9, 9, 123000000, time.FixedZone("CEST", 2*60*60))},
+			wantPayload:      `{"fields":{"summary":"Started"}}`,
+			wantCustomFields: `{"Fields":[{"fields":{"customfield_10050":"2022-01-07T10:09:09.123+0200"}}]}`,
+		},
+
+		{
+			name:    "MarshalIssueWhenTheFieldNameIsUnknown",
+			value:   issue,
+			options: nil,
+			wantErr: errors.New(`jira: no field id found for the field name "Team"`),
+		},
+
+		{
+			name: "MarshalIssueWhenTheHintIsUnknown",
+			value: &struct {
+				Summary string `jira:"summary,unknown"`
+			}{Summary: "Unknown"},
+			wantErr: ErrInvalidIssueTagError,
+		},
+
+		{
+			name: "MarshalIssueWhenTheTagHasNoFieldID",
+			value: &struct {
+				Summary string `jira:",omitempty"`
+			}{},
+			wantErr: ErrInvalidIssueTagError,
+		},
+
+		{
+			name: "MarshalIssueWhenTheCascadingValueHasTooManyOptions",
+			value: &struct {
+				Region []string `jira:"customfield_10030,cascading"`
+			}{Region: []string{"EU", "ES", "Madrid"}},
+			wantErr: errors.New("jira: field customfield_10030: a cascading value needs a parent and an optional child option"),
+		},
+
+		{
+			name:    "MarshalIssueWhenTheValueIsNotAStruct",
+			value:   "summary",
+			wantErr: ErrNoIssueStructError,
+		},
+
+		{
+			name:    "MarshalIssueWhenTheValueIsANilPointer",
+			value:   (*mockTaggedIssue)(nil),
+			wantErr: ErrNoIssueStructError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			gotPayload, gotCustomFields, err := MarshalIssue(testCase.value, testCase.options)
+
+			if testCase.wantErr != nil {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				if !errors.Is(err, testCase.wantErr) {
+					assert.EqualError(t, err, testCase.wantErr.Error())
+				}
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			payloadAsBytes, err := json.Marshal(gotPayload)
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.wantPayload, string(payloadAsBytes))
+
+			customFieldsAsBytes, err := json.Marshal(gotCustomFields)
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.wantCustomFields, string(customFieldsAsBytes))
+		})
+	}
+}
+
+func TestMarshalIssueV2(t *testing.T) {
+
+	value := &struct {
+		Summary     string `jira:"summary"`
+		Description string `jira:"description"`
+		Environment string `jira:"environment,omitempty"`
+		Assignee    string `jira:"assignee"`
+	}{Summary: "Migrate the billing service", Description: "First line\nSecond line", Assignee: "5b10a2844c20165700ede21g"}
+
+	gotPayload, gotCustomFields, err := MarshalIssueV2(value, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, gotCustomFields)
+
+	// The v2 documents are sent as plain text
+	payloadAsBytes, err := json.Marshal(gotPayload)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"fields":{"summary":"Migrate the billing service","description":"First line\nSecond line",
+		"assignee":{"accountId":"5b10a2844c20165700ede21g"}}}`, string(payloadAsBytes))
+}
+
+func TestUnmarshalIssue(t *testing.T) {
+
+	options := &IssueMarshalOptions{FieldIDs: map[string]string{"Team": "customfield_10040"}}
+
+	issueAsJSON := `{"key":"KP-1","fields":{
+		"summary":"Migrate the billing service",
+		"description":{"type":"doc","version":1,"content":[
+			{"type":"paragraph","content":[{"type":"text","text":"First "},{"type":"text","text":"line","marks":[{"type":"strong"}]}]},
+			{"type":"bulletList","content":[{"type":"listItem","content":[{"type":"paragraph","content":[
+				{"type":"text","text":"Second line"}]}]}]}]},
+		"project":{"id":"10000","key":"KP"},"issuetype":{"id":"10001","name":"Story"},
+		"labels":["backend"],"components":[{"id":"1","name":"API"}],"duedate":"2022-01-07",
+		"assignee":{"accountId":"5b10a2844c20165700ede21g","displayName":"Alice"},
+		"customfield_10016":"3.5","customfield_10040":{"id":"9","value":"Team A"},
+		"customfield_10020":[{"value":"iOS"},{"value":"Android"}],
+		"customfield_10030":{"value":"EU","child":{"value":"ES"}}}}`
+
+	issue := &IssueScheme{}
+	if err := json.Unmarshal([]byte(issueAsJSON), issue); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name    string
+		issue   *IssueScheme
+		target  interface{}
+		options *IssueMarshalOptions
+		want    interface{}
+		wantErr error
+	}{
+		{
+			name:    "UnmarshalIssueWhenTheTagsAreValid",
+			issue:   issue,
+			target:  &mockTaggedIssue{},
+			options: options,
+			want: &mockTaggedIssue{
+				Summary:     "Migrate the billing service",
+				Description: "First line\nSecond line",
+				Project:     "KP",
+				IssueType:   "Story",
+				Labels:      []string{"backend"},
+				Components:  []string{"API"},
+				DueDate:     time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC),
+				Assignee:    "5b10a2844c20165700ede21g",
+				StoryPoints: 3.5,
+				Team:        "Team A",
+				Platforms:   []string{"iOS", "Android"},
+				Region:      []string{"EU", "ES"},
+			},
+		},
+
+		{
+			name:  "UnmarshalIssueWhenTheFieldsAreNotRaw",
+			issue: &IssueScheme{Fields: &IssueFieldsScheme{Summary: "Typed", Labels: []string{"frontend"}}},
+			target: &struct {
+				Summary string   `jira:"summary"`
+				Labels  []string `jira:"labels"`
+				Missing *string  `jira:"customfield_10099"`
+			}{},
+			want: &struct {
+				Summary string   `jira:"summary"`
+				Labels  []string `jira:"labels"`
+				Missing *string  `jira:"customfield_10099"`
+			}{Summary: "Typed", Labels: []string{"frontend"}},
+		},
+
+		{
+			name:  "UnmarshalIssueWhenTheDateIsMalformed",
+			issue: &IssueScheme{Fields: &IssueFieldsScheme{Raw: map[string]interface{}{"duedate": "07/01/2022"}}},
+			target: &struct {
+				DueDate time.Time `jira:"duedate"`
+			}{},
+			wantErr: errors.New(`jira: field duedate: the "07/01/2022" value is not a valid date`),
+		},
+
+		{
+			name:  "UnmarshalIssueWhenTheNumberIsMalformed",
+			issue: &IssueScheme{Fields: &IssueFieldsScheme{Raw: map[string]interface{}{"customfield_10016": true}}},
+			target: &struct {
+				StoryPoints int `jira:"customfield_10016"`
+			}{},
+			wantErr: errors.New("jira: field customfield_10016: the bool value can't be decoded as a number"),
+		},
+
+		{
+			name:    "UnmarshalIssueWhenTheTargetIsNotAPointer",
+			issue:   issue,
+			target:  mockTaggedIssue{},
+			wantErr: ErrNoIssueTargetError,
+		},
+
+		{
+			name:    "UnmarshalIssueWhenTheIssueHasNoFields",
+			issue:   &IssueScheme{Key: "KP-1"},
+			target:  &mockTaggedIssue{},
+			wantErr: ErrNoIssueFieldsError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := UnmarshalIssue(testCase.issue, testCase.target, testCase.options)
+
+			if testCase.wantErr != nil {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				if !errors.Is(err, testCase.wantErr) {
+					assert.EqualError(t, err, testCase.wantErr.Error())
+				}
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, testCase.target)
+		})
+	}
+}
+
+func TestMarshalIssue_RoundTrip(t *testing.T) {
+
+	options := &IssueMarshalOptions{FieldIDs: map[string]string{"Team": "customfield_10040"}}
+
+	want := &mockTaggedIssue{
+		Summary:     "Migrate the billing service",
+		Description: "First line\n\nThird line",
+		Project:     "KP",
+		IssueType:   "Story",
+		Labels:      []string{"backend", "billing"},
+		Components:  []string{"API", "Backend"},
+		DueDate:     time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC),
+		Assignee:    "5b10a2844c20165700ede21g",
+		StoryPoints: 8,
+		Team:        "Team A",
+		Platforms:   []string{"iOS"},
+		Region:      []string{"EU"},
+	}
+
+	payload, customFields, err := MarshalIssue(want, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fields, err := payload.MergeCustomFields(customFields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fieldsAsBytes, err := json.Marshal(fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The issue is read back as it would be returned by Jira
+	issue := &IssueScheme{}
+	if err = json.Unmarshal(fieldsAsBytes, issue); err != nil {
+		t.Fatal(err)
+	}
+
+	got := &mockTaggedIssue{}
+	assert.NoError(t, UnmarshalIssue(issue, got, options))
+	assert.Equal(t, want, got)
+}
@@ -1,5 +1,12 @@
 package models
 
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
 type IssueMetadataCreateOptions struct {
 	ProjectIDs     []string
 	ProjectKeys    []string
@@ -7,3 +14,403 @@ type IssueMetadataCreateOptions struct {
 	IssueTypeNames []string
 	Expand         string
 }
+
+// IssueFieldMetadataScheme describes a field of a create, edit or transition screen.
+type IssueFieldMetadataScheme struct {
+	Required        bool                    `json:"required,omitempty"`
+	Schema          *IssueFieldSchemaScheme `json:"schema,omitempty"`
+	Name            string                  `json:"name,omitempty"`
+	Key             string                  `json:"key,omitempty"`
+	HasDefaultValue bool                    `json:"hasDefaultValue,omitempty"`
+	Operations      []string                `json:"operations,omitempty"`
+	AllowedValues   []interface{}           `json:"allowedValues,omitempty"`
+	DefaultValue    interface{}             `json:"defaultValue,omitempty"`
+	AutoCompleteURL string                  `json:"autoCompleteUrl,omitempty"`
+}
+
+// IssueEditMetadataScheme contains the fields of the edit screen of an issue, keyed by the field ID.
+type IssueEditMetadataScheme struct {
+	Fields map[string]*IssueFieldMetadataScheme `json:"fields,omitempty"`
+}
+
+// IssueCreateMetadataScheme contains the projects and their issue types available to create issues.
+type IssueCreateMetadataScheme struct {
+	Expand   string                              `json:"expand,omitempty"`
+	Projects []*IssueCreateMetadataProjectScheme `json:"projects,omitempty"`
+}
+
+type IssueCreateMetadataProjectScheme struct {
+	Expand     string                                `json:"expand,omitempty"`
+	Self       string                                `json:"self,omitempty"`
+	ID         string                                `json:"id,omitempty"`
+	Key        string                                `json:"key,omitempty"`
+	Name       string                                `json:"name,omitempty"`
+	AvatarUrls *AvatarURLScheme                      `json:"avatarUrls,omitempty"`
+	IssueTypes []*IssueCreateMetadataIssueTypeScheme `json:"issuetypes,omitempty"`
+}
+
+// IssueCreateMetadataIssueTypeScheme is an issue type of a project, the fields of its create screen are keyed by
+// the field ID. The fields are returned with the projects.issuetypes.fields expand, or collected from the fields
+// of the create screen by the IssueMetadataService.ValidateCreate method.
+type IssueCreateMetadataIssueTypeScheme struct {
+	Expand           string                               `json:"expand,omitempty"`
+	Self             string                               `json:"self,omitempty"`
+	ID               string                               `json:"id,omitempty"`
+	Description      string                               `json:"description,omitempty"`
+	IconURL          string                               `json:"iconUrl,omitempty"`
+	Name             string                               `json:"name,omitempty"`
+	UntranslatedName string                               `json:"untranslatedName,omitempty"`
+	Subtask          bool                                 `json:"subtask,omitempty"`
+	HierarchyLevel   int                                  `json:"hierarchyLevel,omitempty"`
+	Fields           map[string]*IssueFieldMetadataScheme `json:"fields,omitempty"`
+}
+
+// IssueCreateMetadataIssueTypePageScheme is a page of the issue types of a project available to create issues.
+type IssueCreateMetadataIssueTypePageScheme struct {
+	StartAt    int                                   `json:"startAt,omitempty"`
+	MaxResults int                                   `json:"maxResults,omitempty"`
+	Total      int                                   `json:"total,omitempty"`
+	IssueTypes []*IssueCreateMetadataIssueTypeScheme `json:"issueTypes,omitempty"`
+}
+
+// IssueCreateMetadataFieldPageScheme is a page of the fields of the create screen of a project and an issue type.
+type IssueCreateMetadataFieldPageScheme struct {
+	StartAt    int                               `json:"startAt,omitempty"`
+	MaxResults int                               `json:"maxResults,omitempty"`
+	Total      int                               `json:"total,omitempty"`
+	Fields     []*IssueCreateMetadataFieldScheme `json:"fields,omitempty"`
+}
+
+// IssueCreateMetadataFieldScheme is a field of a create screen with its ID.
+type IssueCreateMetadataFieldScheme struct {
+	FieldID string `json:"fieldId,omitempty"`
+	IssueFieldMetadataScheme
+}
+
+// IssueType returns the issue type of the project, the project is looked up by its key or ID and the issue type by
+// its ID or name. It returns nil when the project or the issue type aren't on the metadata.
+func (m *IssueCreateMetadataScheme) IssueType(projectKeyOrID, issueTypeIDOrName string) *IssueCreateMetadataIssueTypeScheme {
+
+	for _, project := range m.Projects {
+
+		if project.Key != projectKeyOrID && project.ID != projectKeyOrID {
+			continue
+		}
+
+		for _, issueType := range project.IssueTypes {
+
+			if issueType.ID == issueTypeIDOrName || issueType.Name == issueTypeIDOrName {
+				return issueType
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the fields of the payload against the create screen, see the IssueFieldValidationError.
+func (i *IssueCreateMetadataIssueTypeScheme) Validate(payload *IssueScheme, customFields *CustomFields) error {
+	return validateIssueFields(i.Fields, payload, customFields, true)
+}
+
+// ValidateV2 checks the fields of the payload against the create screen, see the IssueFieldValidationError.
+func (i *IssueCreateMetadataIssueTypeScheme) ValidateV2(payload *IssueSchemeV2, customFields *CustomFields) error {
+	return validateIssueFields(i.Fields, payload, customFields, true)
+}
+
+// Validate checks the fields of the payload against the edit screen, see the IssueFieldValidationError.
+// The required fields aren't checked, the fields not on the payload aren't edited.
+func (m *IssueEditMetadataScheme) Validate(payload *IssueScheme, customFields *CustomFields) error {
+	return validateIssueFields(m.Fields, payload, customFields, false)
+}
+
+// ValidateV2 checks the fields of the payload against the edit screen, see the IssueFieldValidationError.
+// The required fields aren't checked, the fields not on the payload aren't edited.
+func (m *IssueEditMetadataScheme) ValidateV2(payload *IssueSchemeV2, customFields *CustomFields) error {
+	return validateIssueFields(m.Fields, payload, customFields, false)
+}
+
+// The problems reported by the IssueFieldValidationError.
+const (
+	IssueFieldProblemRequired    = "required"
+	IssueFieldProblemNotOnScreen = "not-on-screen"
+	IssueFieldProblemType        = "type"
+	IssueFieldProblemNotAllowed  = "not-allowed"
+)
+
+// IssueFieldProblemScheme is a problem of a field of a payload.
+type IssueFieldProblemScheme struct {
+	FieldID   string
+	FieldName string
+	Problem   string
+	Message   string
+}
+
+// IssueFieldValidationError is returned when a payload doesn't match the metadata of a screen.
+// Every problem is reported: the required fields without a value, the fields not on the screen, the values that
+// don't match the schema type of the field and the values that aren't allowed.
+type IssueFieldValidationError struct {
+	Problems []*IssueFieldProblemScheme
+}
+
+func (e *IssueFieldValidationError) Error() string {
+
+	var problems []string
+	for _, problem := range e.Problems {
+		problems = append(problems, fmt.Sprintf("%v: %v", problem.FieldID, problem.Message))
+	}
+
+	return fmt.Sprintf("jira: the issue payload isn't valid, %v", strings.Join(problems, "; "))
+}
+
+// issueFieldObjectTypes are the schema types of the fields set with an object, e.g. {"id": "10000"}.
+var issueFieldObjectTypes = map[string]bool{
+	"component":         true,
+	"group":             true,
+	"issuelink":         true,
+	"issuetype":         true,
+	"option":            true,
+	"option-with-child": true,
+	"priority":          true,
+	"project":           true,
+	"resolution":        true,
+	"securitylevel":     true,
+	"timetracking":      true,
+	"user":              true,
+	"version":           true,
+}
+
+// validateIssueFields checks the fields of the payload and the custom fields against the fields of the screen.
+func validateIssueFields(fields map[string]*IssueFieldMetadataScheme, payload interface{}, customFields *CustomFields,
+	create bool) error {
+
+	values, err := issuePayloadFields(payload, customFields)
+	if err != nil {
+		return err
+	}
+
+	var problems []*IssueFieldProblemScheme
+
+	if create {
+
+		for fieldID, field := range fields {
+
+			if field.Required && !field.HasDefaultValue && isEmptyIssueFieldValue(values[fieldID]) {
+				problems = append(problems, &IssueFieldProblemScheme{FieldID: fieldID, FieldName: field.Name,
+					Problem: IssueFieldProblemRequired, Message: "the field is required"})
+			}
+		}
+	}
+
+	for fieldID, value := range values {
+
+		field, ok := fields[fieldID]
+		if !ok {
+			problems = append(problems, &IssueFieldProblemScheme{FieldID: fieldID, Problem: IssueFieldProblemNotOnScreen,
+				Message: "the field isn't on the screen"})
+			continue
+		}
+
+		if value == nil {
+			continue
+		}
+
+		if message := issueFieldTypeProblem(field.Schema, value); message != "" {
+			problems = append(problems, &IssueFieldProblemScheme{FieldID: fieldID, FieldName: field.Name,
+				Problem: IssueFieldProblemType, Message: message})
+			continue
+		}
+
+		if notAllowed := issueFieldNotAllowedValues(field.AllowedValues, value); len(notAllowed) != 0 {
+			problems = append(problems, &IssueFieldProblemScheme{FieldID: fieldID, FieldName: field.Name,
+				Problem: IssueFieldProblemNotAllowed,
+				Message: fmt.Sprintf("the values %v aren't allowed", strings.Join(notAllowed, ", "))})
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	sort.Slice(problems, func(i, j int) bool {
+
+		if problems[i].FieldID != problems[j].FieldID {
+			return problems[i].FieldID < problems[j].FieldID
+		}
+
+		return problems[i].Problem < problems[j].Problem
+	})
+
+	return &IssueFieldValidationError{Problems: problems}
+}
+
+// issuePayloadFields returns the fields of the payload merged with the custom fields, as they're sent to Jira.
+func issuePayloadFields(payload interface{}, customFields *CustomFields) (map[string]interface{}, error) {
+
+	values := make(map[string]interface{})
+
+	nodes := []interface{}{payload}
+	if customFields != nil {
+
+		for _, customField := range customFields.Fields {
+			nodes = append(nodes, customField)
+		}
+	}
+
+	for _, node := range nodes {
+
+		nodeAsBytes, err := json.Marshal(node)
+		if err != nil {
+			return nil, err
+		}
+
+		var issue struct {
+			Fields map[string]interface{} `json:"fields"`
+		}
+
+		if err = json.Unmarshal(nodeAsBytes, &issue); err != nil {
+			return nil, err
+		}
+
+		for fieldID, value := range issue.Fields {
+			values[fieldID] = value
+		}
+	}
+
+	return values, nil
+}
+
+func isEmptyIssueFieldValue(value interface{}) bool {
+
+	switch value := value.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case []interface{}:
+		return len(value) == 0
+	case map[string]interface{}:
+		return len(value) == 0
+	}
+
+	return false
+}
+
+// issueFieldTypeProblem returns the problem of the value when it doesn't match the schema type of the field,
+// the values of unknown schema types aren't checked.
+func issueFieldTypeProblem(schema *IssueFieldSchemaScheme, value interface{}) string {
+
+	if schema == nil {
+		return ""
+	}
+
+	if schema.Type != "array" {
+		return issueFieldValueTypeProblem(schema.Type, value)
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return "the value isn't an array"
+	}
+
+	for _, item := range items {
+
+		if message := issueFieldValueTypeProblem(schema.Items, item); message != "" {
+			return message
+		}
+	}
+
+	return ""
+}
+
+func issueFieldValueTypeProblem(schemaType string, value interface{}) string {
+
+	switch {
+	case schemaType == "string":
+
+		// The text fields of the v3 API are documents, e.g. the description
+		if _, ok := value.(string); ok || isIssueFieldDocument(value) {
+			return ""
+		}
+
+	case schemaType == "date" || schemaType == "datetime":
+
+		if _, ok := value.(string); ok {
+			return ""
+		}
+
+	case schemaType == "number":
+
+		if _, ok := value.(float64); ok {
+			return ""
+		}
+
+	case issueFieldObjectTypes[schemaType]:
+
+		if _, ok := value.(map[string]interface{}); ok {
+			return ""
+		}
+
+	default:
+		return ""
+	}
+
+	return fmt.Sprintf("the value isn't a %v", schemaType)
+}
+
+func isIssueFieldDocument(value interface{}) bool {
+	document, ok := value.(map[string]interface{})
+	return ok && document["type"] == "doc"
+}
+
+// issueFieldNotAllowedValues returns the values that don't match an allowed value by their id, key, name or value.
+func issueFieldNotAllowedValues(allowedValues []interface{}, value interface{}) (notAllowed []string) {
+
+	if len(allowedValues) == 0 {
+		return nil
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		items = []interface{}{value}
+	}
+
+	for _, item := range items {
+
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var (
+			allowed     bool
+			description string
+		)
+
+		for _, attribute := range []string{"id", "key", "name", "value"} {
+
+			identifier, ok := object[attribute]
+			if !ok {
+				continue
+			}
+
+			if description == "" {
+				description = fmt.Sprint(identifier)
+			}
+
+			for _, allowedValue := range allowedValues {
+
+				allowedObject, ok := allowedValue.(map[string]interface{})
+				if ok && allowedObject[attribute] != nil && fmt.Sprint(allowedObject[attribute]) == fmt.Sprint(identifier) {
+					allowed = true
+				}
+			}
+		}
+
+		if !allowed && description != "" {
+			notAllowed = append(notAllowed, description)
+		}
+	}
+
+	return notAllowed
+}
@@ -1,6 +1,11 @@
 package models
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
 
 type UpdateOperations struct{ Fields []map[string]interface{} }
 
@@ -60,3 +65,267 @@ func (u *UpdateOperations) AddStringOperation(customFieldID, operation, value st
 
 	return
 }
+
+// DiffIssue returns the minimal operations changing the current issue into the desired issue, the fields of the
+// desired issue and the desired custom fields are compared with the fields of the current issue. The items of the
+// sets, e.g. the labels, the components, the versions or the options of a multi-select, are added and removed, and
+// the other fields are set when they're changed. The fields not on the desired issue and the read-only fields,
+// e.g. the status, aren't changed. The objects with an identifier are compared by their id, accountId, key, value or
+// name, the first one set on the desired object, e.g. {"name": "High"} matches the current priority named High.
+// The other objects, e.g. the Atlassian Documents of the descriptions, and the other arrays are compared exactly,
+// including the order of their items.
+// The current issue is the issue returned by the IssueService.Get method, with the fields compared.
+func DiffIssue(current, desired *IssueScheme, customFields *CustomFields) (*UpdateOperations, error) {
+
+	var currentFields *IssueFieldsScheme
+	if current != nil {
+		currentFields = current.Fields
+	}
+
+	var raw map[string]interface{}
+	if currentFields != nil {
+		raw = currentFields.Raw
+	}
+
+	return diffIssueFields(raw, &IssueScheme{Fields: currentFields}, desired, customFields)
+}
+
+// DiffIssueV2 returns the minimal operations changing the current issue into the desired issue, see DiffIssue.
+func DiffIssueV2(current, desired *IssueSchemeV2, customFields *CustomFields) (*UpdateOperations, error) {
+
+	var currentFields *IssueFieldsSchemeV2
+	if current != nil {
+		currentFields = current.Fields
+	}
+
+	var raw map[string]interface{}
+	if currentFields != nil {
+		raw = currentFields.Raw
+	}
+
+	return diffIssueFields(raw, &IssueSchemeV2{Fields: currentFields}, desired, customFields)
+}
+
+// diffIssueFields compares the desired fields with the current fields, the raw fields returned by Jira are used
+// when they're available.
+func diffIssueFields(raw map[string]interface{}, current, desired interface{}, customFields *CustomFields) (
+	*UpdateOperations, error) {
+
+	currentValues := raw
+	if currentValues == nil {
+
+		values, err := issuePayloadFields(current, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		currentValues = values
+	}
+
+	desiredValues, err := issuePayloadFields(desired, customFields)
+	if err != nil {
+		return nil, err
+	}
+
+	// The current values are normalized like the desired values, e.g. the numbers are float64
+	currentAsBytes, err := json.Marshal(currentValues)
+	if err != nil {
+		return nil, err
+	}
+
+	currentValues = make(map[string]interface{})
+	if err = json.Unmarshal(currentAsBytes, &currentValues); err != nil {
+		return nil, err
+	}
+
+	var fieldIDs []string
+	for fieldID := range desiredValues {
+
+		if !issueReadOnlyFields[fieldID] {
+			fieldIDs = append(fieldIDs, fieldID)
+		}
+	}
+
+	sort.Strings(fieldIDs)
+
+	operations := &UpdateOperations{}
+	for _, fieldID := range fieldIDs {
+
+		fieldOperations := diffIssueField(fieldID, currentValues[fieldID], desiredValues[fieldID])
+		if len(fieldOperations) == 0 {
+			continue
+		}
+
+		operations.Fields = append(operations.Fields, map[string]interface{}{
+			"update": map[string]interface{}{fieldID: fieldOperations},
+		})
+	}
+
+	return operations, nil
+}
+
+// issueSetFields are the system fields whose items are a set, the order of their items doesn't matter.
+var issueSetFields = map[string]bool{
+	"components":  true,
+	"fixVersions": true,
+	"labels":      true,
+	"versions":    true,
+}
+
+// diffIssueField returns the operations of a field, the items of the sets are added and removed.
+func diffIssueField(fieldID string, current, desired interface{}) (operations []map[string]interface{}) {
+
+	desiredItems, ok := desired.([]interface{})
+	if !ok || !isIssueSetField(fieldID, desiredItems) {
+
+		if isSameIssueFieldValue(current, desired) {
+			return nil
+		}
+
+		return []map[string]interface{}{{"set": desired}}
+	}
+
+	currentItems, _ := current.([]interface{})
+
+	for _, item := range desiredItems {
+
+		if !containsIssueFieldValue(currentItems, item) {
+			operations = append(operations, map[string]interface{}{"add": item})
+		}
+	}
+
+	for _, item := range currentItems {
+
+		if !containsIssueFieldValue(desiredItems, item) {
+			operations = append(operations, map[string]interface{}{"remove": issueFieldValueIdentifier(item)})
+		}
+	}
+
+	return operations
+}
+
+// isIssueSetField reports whether the items of a field are a set, the system sets and the custom fields whose items
+// are objects with an identifier, e.g. the options of a multi-select or the users of a multi-user picker.
+func isIssueSetField(fieldID string, items []interface{}) bool {
+
+	if issueSetFields[fieldID] {
+		return true
+	}
+
+	if len(items) == 0 {
+		return false
+	}
+
+	for _, item := range items {
+
+		object, ok := item.(map[string]interface{})
+		if !ok || !hasIssueFieldIdentifier(object) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// issueFieldIdentifiers are the attributes identifying an object, in order of preference.
+var issueFieldIdentifiers = []string{"id", "accountId", "key", "value", "name"}
+
+// hasIssueFieldIdentifier reports whether an object has an identifier.
+func hasIssueFieldIdentifier(object map[string]interface{}) bool {
+
+	for _, identifier := range issueFieldIdentifiers {
+
+		if _, ok := object[identifier]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSameIssueFieldValue reports whether the current value matches the desired value. The objects with an identifier
+// are compared by their first identifier set on the desired object and their nested objects, e.g. the child of a
+// cascading select. The other objects are compared exactly, and the arrays are compared item by item, in order.
+func isSameIssueFieldValue(current, desired interface{}) bool {
+
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+
+		currentObject, ok := current.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		for _, identifier := range issueFieldIdentifiers {
+
+			if _, ok := desired[identifier]; !ok {
+				continue
+			}
+
+			if !reflect.DeepEqual(currentObject[identifier], desired[identifier]) {
+				return false
+			}
+
+			for attribute, value := range desired {
+
+				if _, ok := value.(map[string]interface{}); ok && !isSameIssueFieldValue(currentObject[attribute], value) {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		return reflect.DeepEqual(currentObject, desired)
+
+	case []interface{}:
+
+		currentItems, ok := current.([]interface{})
+		if !ok || len(currentItems) != len(desired) {
+			return false
+		}
+
+		for index, item := range desired {
+
+			if !isSameIssueFieldValue(currentItems[index], item) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return reflect.DeepEqual(current, desired)
+}
+
+// containsIssueFieldValue reports whether an item of a set matches the value, the value is matched both ways because
+// the current items are removed when they don't match a desired item.
+func containsIssueFieldValue(items []interface{}, value interface{}) bool {
+
+	for _, item := range items {
+
+		if isSameIssueFieldValue(item, value) || isSameIssueFieldValue(value, item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// issueFieldValueIdentifier returns the first identifier of an object, e.g. {"id": "10000"}, or the value itself.
+func issueFieldValueIdentifier(value interface{}) interface{} {
+
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+
+	for _, identifier := range issueFieldIdentifiers {
+
+		if identifierValue, ok := object[identifier]; ok {
+			return map[string]interface{}{identifier: identifierValue}
+		}
+	}
+
+	return value
+}
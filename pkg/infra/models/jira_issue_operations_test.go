@@ -0,0 +1,223 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDiffIssue(t *testing.T) {
+
+	// paragraph returns an Atlassian Document paragraph, with the strong mark when it's set
+	paragraph := func(text string, strong bool) *CommentNodeScheme {
+
+		node := &CommentNodeScheme{Type: "text", Text: text}
+		if strong {
+			node.Marks = []*MarkScheme{{Type: "strong"}}
+		}
+
+		return &CommentNodeScheme{Type: "paragraph", Content: []*CommentNodeScheme{node}}
+	}
+
+	document := func(paragraphs ...*CommentNodeScheme) *CommentNodeScheme {
+		return &CommentNodeScheme{Version: 1, Type: "doc", Content: paragraphs}
+	}
+
+	current := `{
+		"summary": "Login page",
+		"labels": ["backend", "login"],
+		"components": [{"self": "https://ctreminiom.atlassian.net/rest/api/3/component/10000", "id": "10000", "name": "API"}],
+		"priority": {"self": "https://ctreminiom.atlassian.net/rest/api/3/priority/2", "id": "2", "name": "High"},
+		"status": {"id": "1", "name": "Open"},
+		"description": {"version": 1, "type": "doc", "content": [
+			{"type": "paragraph", "content": [{"type": "text", "text": "First", "marks": [{"type": "strong"}]}]},
+			{"type": "paragraph", "content": [{"type": "text", "text": "Second"}]}
+		]},
+		"customfield_10001": {"self": "https://ctreminiom.atlassian.net/rest/api/3/customFieldOption/1", "id": "1", "value": "A",
+			"child": {"id": "2", "value": "A1"}},
+		"customfield_10002": [{"accountId": "alice", "displayName": "Alice"}, {"accountId": "bob", "displayName": "Bob"}],
+		"customfield_10003": ["first", "second"]
+	}`
+
+	testCases := []struct {
+		name           string
+		desired        *IssueFieldsScheme
+		customFields   func(fields *CustomFields)
+		wantOperations []map[string]interface{}
+	}{
+		{
+			name: "DiffIssueWhenTheFieldsAreNotChanged",
+			desired: &IssueFieldsScheme{
+				Summary:     "Login page",
+				Labels:      []string{"login", "backend"},
+				Components:  []*ComponentScheme{{Name: "API"}},
+				Priority:    &PriorityScheme{Name: "High"},
+				Description: document(paragraph("First", true), paragraph("Second", false)),
+			},
+			customFields: func(fields *CustomFields) {
+				_ = fields.Cascading("customfield_10001", "A", "A1")
+				_ = fields.Users("customfield_10002", []string{"bob", "alice"})
+			},
+			wantOperations: nil,
+		},
+
+		{
+			name:    "DiffIssueWhenTheParagraphsAreReordered",
+			desired: &IssueFieldsScheme{Description: document(paragraph("Second", false), paragraph("First", true))},
+			wantOperations: []map[string]interface{}{
+				{"description": []interface{}{map[string]interface{}{"set": map[string]interface{}{
+					"version": float64(1), "type": "doc", "content": []interface{}{
+						map[string]interface{}{"type": "paragraph", "content": []interface{}{
+							map[string]interface{}{"type": "text", "text": "Second"}}},
+						map[string]interface{}{"type": "paragraph", "content": []interface{}{
+							map[string]interface{}{"type": "text", "text": "First", "marks": []interface{}{
+								map[string]interface{}{"type": "strong"}}}}},
+					}}}}},
+			},
+		},
+
+		{
+			name:           "DiffIssueWhenAMarkIsRemoved",
+			desired:        &IssueFieldsScheme{Description: document(paragraph("First", false), paragraph("Second", false))},
+			wantOperations: []map[string]interface{}{{"description": "set"}},
+		},
+
+		{
+			name:           "DiffIssueWhenANodeIsRemoved",
+			desired:        &IssueFieldsScheme{Description: document(paragraph("First", true))},
+			wantOperations: []map[string]interface{}{{"description": "set"}},
+		},
+
+		{
+			name:    "DiffIssueWhenTheSetsAreChanged",
+			desired: &IssueFieldsScheme{Labels: []string{"login", "frontend"}, Components: []*ComponentScheme{{Name: "UI"}}},
+			wantOperations: []map[string]interface{}{
+				{"components": []interface{}{
+					map[string]interface{}{"add": map[string]interface{}{"name": "UI"}},
+					map[string]interface{}{"remove": map[string]interface{}{"id": "10000"}},
+				}},
+				{"labels": []interface{}{
+					map[string]interface{}{"add": "frontend"},
+					map[string]interface{}{"remove": "backend"},
+				}},
+			},
+		},
+
+		{
+			name:    "DiffIssueWhenTheScalarsAreChanged",
+			desired: &IssueFieldsScheme{Summary: "Logout page", Priority: &PriorityScheme{Name: "Low"}},
+			wantOperations: []map[string]interface{}{
+				{"priority": []interface{}{map[string]interface{}{"set": map[string]interface{}{"name": "Low"}}}},
+				{"summary": []interface{}{map[string]interface{}{"set": "Logout page"}}},
+			},
+		},
+
+		{
+			name:    "DiffIssueWhenTheChildOfACascadingSelectIsChanged",
+			desired: &IssueFieldsScheme{},
+			customFields: func(fields *CustomFields) {
+				_ = fields.Cascading("customfield_10001", "A", "A2")
+			},
+			wantOperations: []map[string]interface{}{{"customfield_10001": "set"}},
+		},
+
+		{
+			name:    "DiffIssueWhenAMultiUserPickerIsChanged",
+			desired: &IssueFieldsScheme{},
+			customFields: func(fields *CustomFields) {
+				_ = fields.Users("customfield_10002", []string{"alice", "carol"})
+			},
+			wantOperations: []map[string]interface{}{
+				{"customfield_10002": []interface{}{
+					map[string]interface{}{"add": map[string]interface{}{"accountId": "carol"}},
+					map[string]interface{}{"remove": map[string]interface{}{"accountId": "bob"}},
+				}},
+			},
+		},
+
+		{
+			name:    "DiffIssueWhenAnOrderedArrayIsReordered",
+			desired: &IssueFieldsScheme{},
+			customFields: func(fields *CustomFields) {
+				fields.Fields = append(fields.Fields, map[string]interface{}{
+					"fields": map[string]interface{}{"customfield_10003": []string{"second", "first"}}})
+			},
+			wantOperations: []map[string]interface{}{
+				{"customfield_10003": []interface{}{map[string]interface{}{"set": []interface{}{"second", "first"}}}},
+			},
+		},
+
+		{
+			name:           "DiffIssueWhenAReadOnlyFieldIsChanged",
+			desired:        &IssueFieldsScheme{Status: &StatusScheme{Name: "Done"}},
+			wantOperations: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			currentIssue := &IssueScheme{}
+			if err := json.Unmarshal([]byte(`{"key": "KP-1", "fields": `+current+`}`), currentIssue); err != nil {
+				t.Fatal(err)
+			}
+
+			customFields := &CustomFields{}
+			if testCase.customFields != nil {
+				testCase.customFields(customFields)
+			}
+
+			gotOperations, err := DiffIssue(currentIssue, &IssueScheme{Fields: testCase.desired}, customFields)
+			assert.NoError(t, err)
+
+			var gotFields []map[string]interface{}
+			for _, operation := range gotOperations.Fields {
+
+				fields := operation["update"].(map[string]interface{})
+				for fieldID, fieldOperations := range fields {
+
+					// The wanted operation is only compared by its type when it's a string
+					if wantType, ok := operationType(testCase.wantOperations, fieldID); ok {
+						gotFields = append(gotFields, map[string]interface{}{fieldID: wantType})
+						assert.Equal(t, 1, len(fieldOperations.([]map[string]interface{})), fieldID)
+						assert.Contains(t, fieldOperations.([]map[string]interface{})[0], wantType, fieldID)
+						continue
+					}
+
+					gotFields = append(gotFields, map[string]interface{}{fieldID: normalizeOperations(t, fieldOperations)})
+				}
+			}
+
+			assert.Equal(t, testCase.wantOperations, gotFields)
+		})
+	}
+}
+
+// operationType returns the type of the wanted operation of the field, when it's only compared by its type.
+func operationType(operations []map[string]interface{}, fieldID string) (string, bool) {
+
+	for _, operation := range operations {
+
+		if operationType, ok := operation[fieldID].(string); ok {
+			return operationType, true
+		}
+	}
+
+	return "", false
+}
+
+// normalizeOperations returns the operations as decoded from JSON.
+func normalizeOperations(t *testing.T, operations interface{}) (normalized interface{}) {
+
+	operationsAsBytes, err := json.Marshal(operations)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = json.Unmarshal(operationsAsBytes, &normalized); err != nil {
+		t.Fatal(err)
+	}
+
+	return normalized
+}
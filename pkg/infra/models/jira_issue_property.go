@@ -0,0 +1,22 @@
+package models
+
+// IssuePropertyBulkSetScheme is the payload of the IssuePropertyService.BulkSet method.
+// The property is set to the value, or to the result of the Jira expression evaluated on every issue.
+type IssuePropertyBulkSetScheme struct {
+	Value      interface{}                       `json:"value,omitempty"`
+	Expression string                            `json:"expression,omitempty"`
+	Filter     *IssuePropertyBulkSetFilterScheme `json:"filter,omitempty"`
+}
+
+// IssuePropertyBulkSetFilterScheme selects the issues updated, all the issues the user can edit are updated without filter.
+type IssuePropertyBulkSetFilterScheme struct {
+	EntityIDs    []int       `json:"entityIds,omitempty"`
+	CurrentValue interface{} `json:"currentValue,omitempty"`
+	HasProperty  *bool       `json:"hasProperty,omitempty"`
+}
+
+// IssuePropertyBulkDeleteScheme selects the issues of the IssuePropertyService.BulkDelete method, by ID and current value.
+type IssuePropertyBulkDeleteScheme struct {
+	EntityIDs    []int       `json:"entityIds,omitempty"`
+	CurrentValue interface{} `json:"currentValue,omitempty"`
+}
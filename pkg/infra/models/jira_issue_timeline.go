@@ -0,0 +1,424 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IssueTimelineScheme reconstructs the state of an issue at any point in time.
+// It starts from the current field values and replays the changelog backwards, undoing every change
+// made after the requested timestamp.
+type IssueTimelineScheme struct {
+	Created time.Time
+
+	current map[string][]*IssueFieldValueScheme
+	changes []*IssueFieldChangeScheme
+}
+
+// IssueFieldValueScheme is a field value as recorded by the changelog, e.g. the status ID and name.
+type IssueFieldValueScheme struct {
+	ID    string
+	Value string
+}
+
+// IssueFieldChangeScheme is a changelog item with the timestamp and author of its history.
+type IssueFieldChangeScheme struct {
+	FieldID string
+	At      time.Time
+	Author  *IssueChangelogAuthor
+	Item    *IssueChangelogHistoryItemScheme
+}
+
+// IssueStateScheme contains the field values of an issue at a point in time, keyed by field ID.
+type IssueStateScheme struct {
+	At     time.Time
+	Fields map[string][]*IssueFieldValueScheme
+}
+
+// Value returns the value of a single value field, e.g. status or assignee, or nil if the field is empty.
+func (s *IssueStateScheme) Value(fieldID string) *IssueFieldValueScheme {
+
+	if values := s.Fields[fieldID]; len(values) != 0 {
+		return values[0]
+	}
+
+	return nil
+}
+
+// Values returns the values of a field, e.g. the fix versions or the sprints.
+func (s *IssueStateScheme) Values(fieldID string) []*IssueFieldValueScheme {
+	return s.Fields[fieldID]
+}
+
+// IssueFieldIntervalScheme is a period where a field kept the same values.
+type IssueFieldIntervalScheme struct {
+	FieldID  string
+	Values   []*IssueFieldValueScheme
+	Start    time.Time
+	End      time.Time
+	Duration time.Duration
+}
+
+// Value returns the display value of the interval, joining the values of the multi-value fields.
+func (i *IssueFieldIntervalScheme) Value() string {
+
+	var values []string
+	for _, value := range i.Values {
+		values = append(values, value.Value)
+	}
+
+	return strings.Join(values, ", ")
+}
+
+// issueAdditiveFields contains the fields whose changelog items add or remove a single value,
+// instead of replacing the whole field value.
+var issueAdditiveFields = map[string]bool{
+	"fixVersions": true,
+	"versions":    true,
+	"components":  true,
+	"Fix Version": true,
+	"Version":     true,
+	"Component":   true,
+}
+
+// issueFieldNames maps the names used by the old changelog items without a field ID.
+var issueFieldNames = map[string]string{
+	"Fix Version": "fixVersions",
+	"Version":     "versions",
+	"Component":   "components",
+}
+
+// NewIssueTimeline creates the timeline of a v3 issue.
+// The histories must be complete, use the IssueChangelogService.All method to fetch them.
+func NewIssueTimeline(issue *IssueScheme, histories []*IssueChangelogHistoryScheme) (*IssueTimelineScheme, error) {
+
+	if issue == nil || issue.Fields == nil {
+		return nil, ErrNoIssueFieldsError
+	}
+
+	fields := issue.Fields.Raw
+	if fields == nil {
+		fields = issueFieldsAsMap(issue.Fields)
+	}
+
+	created, err := issue.Fields.CreatedTime()
+	if err != nil {
+		return nil, err
+	}
+
+	return newIssueTimeline(fields, created, histories)
+}
+
+// NewIssueTimelineV2 creates the timeline of a v2 issue.
+// The histories must be complete, use the IssueChangelogService.All method to fetch them.
+func NewIssueTimelineV2(issue *IssueSchemeV2, histories []*IssueChangelogHistoryScheme) (*IssueTimelineScheme, error) {
+
+	if issue == nil || issue.Fields == nil {
+		return nil, ErrNoIssueFieldsError
+	}
+
+	fields := issue.Fields.Raw
+	if fields == nil {
+		fields = issueFieldsAsMap(issue.Fields)
+	}
+
+	created, err := issue.Fields.CreatedTime()
+	if err != nil {
+		return nil, err
+	}
+
+	return newIssueTimeline(fields, created, histories)
+}
+
+func newIssueTimeline(fields map[string]interface{}, created time.Time, histories []*IssueChangelogHistoryScheme) (
+	*IssueTimelineScheme, error) {
+
+	timeline := &IssueTimelineScheme{
+		Created: created,
+		current: make(map[string][]*IssueFieldValueScheme),
+	}
+
+	for fieldID, value := range fields {
+		timeline.current[fieldID] = rawIssueFieldValues(value)
+	}
+
+	for _, history := range histories {
+
+		if history == nil {
+			continue
+		}
+
+		at, err := ParseDateTime(history.Created)
+		if err != nil {
+			return nil, fmt.Errorf("jira: invalid changelog %v date: %w", history.ID, err)
+		}
+
+		for _, item := range history.Items {
+
+			if item == nil {
+				continue
+			}
+
+			timeline.changes = append(timeline.changes, &IssueFieldChangeScheme{
+				FieldID: changelogFieldID(item),
+				At:      at,
+				Author:  history.Author,
+				Item:    item,
+			})
+		}
+	}
+
+	sort.SliceStable(timeline.changes, func(i, j int) bool {
+		return timeline.changes[i].At.Before(timeline.changes[j].At)
+	})
+
+	if timeline.Created.IsZero() && len(timeline.changes) != 0 {
+		timeline.Created = timeline.changes[0].At
+	}
+
+	return timeline, nil
+}
+
+// Changes returns the changes of a field sorted by date, or every change if the field ID is empty.
+func (t *IssueTimelineScheme) Changes(fieldID string) (changes []*IssueFieldChangeScheme) {
+
+	for _, change := range t.changes {
+		if fieldID == "" || change.FieldID == fieldID {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// At returns the field values of the issue at the given time.
+// The changes made at the exact timestamp are included on the state.
+func (t *IssueTimelineScheme) At(at time.Time) *IssueStateScheme {
+
+	state := &IssueStateScheme{At: at, Fields: make(map[string][]*IssueFieldValueScheme, len(t.current))}
+
+	for fieldID, values := range t.current {
+		state.Fields[fieldID] = append([]*IssueFieldValueScheme(nil), values...)
+	}
+
+	for index := len(t.changes) - 1; index >= 0 && t.changes[index].At.After(at); index-- {
+		undoIssueFieldChange(state, t.changes[index])
+	}
+
+	return state
+}
+
+// Intervals returns the periods where the field kept the same values, from the creation of the issue until the given time.
+func (t *IssueTimelineScheme) Intervals(fieldID string, until time.Time) (intervals []*IssueFieldIntervalScheme) {
+
+	var (
+		start  = t.Created
+		values = t.At(start).Values(fieldID)
+	)
+
+	for _, change := range t.Changes(fieldID) {
+
+		if !change.At.After(start) {
+			continue
+		}
+
+		if !change.At.Before(until) {
+			break
+		}
+
+		intervals = append(intervals, newIssueFieldInterval(fieldID, values, start, change.At))
+
+		start = change.At
+		values = t.At(start).Values(fieldID)
+	}
+
+	if until.After(start) {
+		intervals = append(intervals, newIssueFieldInterval(fieldID, values, start, until))
+	}
+
+	return intervals
+}
+
+// StatusIntervals returns the time-in-status intervals of the issue until the given time.
+func (t *IssueTimelineScheme) StatusIntervals(until time.Time) []*IssueFieldIntervalScheme {
+	return t.Intervals("status", until)
+}
+
+// AssigneeIntervals returns the time-with-assignee intervals of the issue until the given time.
+// The unassigned periods have no values.
+func (t *IssueTimelineScheme) AssigneeIntervals(until time.Time) []*IssueFieldIntervalScheme {
+	return t.Intervals("assignee", until)
+}
+
+// Durations sums the duration of the field intervals by display value, e.g. the total time in each status.
+func (t *IssueTimelineScheme) Durations(fieldID string, until time.Time) map[string]time.Duration {
+
+	durations := make(map[string]time.Duration)
+	for _, interval := range t.Intervals(fieldID, until) {
+		durations[interval.Value()] += interval.Duration
+	}
+
+	return durations
+}
+
+func newIssueFieldInterval(fieldID string, values []*IssueFieldValueScheme, start, end time.Time) *IssueFieldIntervalScheme {
+
+	return &IssueFieldIntervalScheme{
+		FieldID:  fieldID,
+		Values:   values,
+		Start:    start,
+		End:      end,
+		Duration: end.Sub(start),
+	}
+}
+
+func changelogFieldID(item *IssueChangelogHistoryItemScheme) string {
+
+	if item.FieldID != "" {
+		return item.FieldID
+	}
+
+	if fieldID, ok := issueFieldNames[item.Field]; ok {
+		return fieldID
+	}
+
+	return item.Field
+}
+
+func undoIssueFieldChange(state *IssueStateScheme, change *IssueFieldChangeScheme) {
+
+	item := change.Item
+
+	if issueAdditiveFields[change.FieldID] || issueAdditiveFields[item.Field] {
+
+		var values []*IssueFieldValueScheme
+
+		// Remove the value added by the change
+		for _, value := range state.Fields[change.FieldID] {
+
+			added := (item.To != "" && value.ID == item.To) || (item.To == "" && item.ToString != "" && value.Value == item.ToString)
+			if !added {
+				values = append(values, value)
+			}
+		}
+
+		// Restore the value removed by the change
+		if item.From != "" || item.FromString != "" {
+			values = append(values, &IssueFieldValueScheme{ID: item.From, Value: item.FromString})
+		}
+
+		state.Fields[change.FieldID] = values
+		return
+	}
+
+	state.Fields[change.FieldID] = changelogFieldValues(change.FieldID, item.From, item.FromString)
+}
+
+// changelogFieldValues parses the from or to side of a changelog item.
+func changelogFieldValues(fieldID, id, value string) []*IssueFieldValueScheme {
+
+	if id == "" && value == "" {
+		return nil
+	}
+
+	switch {
+	case fieldID == "labels":
+
+		var values []*IssueFieldValueScheme
+		for _, label := range strings.Fields(value) {
+			values = append(values, &IssueFieldValueScheme{ID: label, Value: label})
+		}
+
+		return values
+
+	case strings.Contains(id, ", "):
+
+		// The sprint changes contain the comma separated list of sprints
+		var (
+			values []*IssueFieldValueScheme
+			ids    = strings.Split(id, ", ")
+			names  = strings.Split(value, ", ")
+		)
+
+		for index, sprintID := range ids {
+
+			fieldValue := &IssueFieldValueScheme{ID: strings.TrimSpace(sprintID)}
+			if len(names) == len(ids) {
+				fieldValue.Value = strings.TrimSpace(names[index])
+			}
+
+			values = append(values, fieldValue)
+		}
+
+		return values
+	}
+
+	return []*IssueFieldValueScheme{{ID: id, Value: value}}
+}
+
+// rawIssueFieldValues converts a field value returned by Jira to the values recorded by the changelog.
+func rawIssueFieldValues(value interface{}) []*IssueFieldValueScheme {
+
+	switch typedValue := value.(type) {
+	case nil:
+		return nil
+
+	case []interface{}:
+
+		var values []*IssueFieldValueScheme
+		for _, element := range typedValue {
+			values = append(values, rawIssueFieldValues(element)...)
+		}
+
+		return values
+
+	case map[string]interface{}:
+
+		fieldValue := &IssueFieldValueScheme{
+			ID:    firstRawString(typedValue, "id", "accountId", "key", "value"),
+			Value: firstRawString(typedValue, "name", "displayName", "value", "key"),
+		}
+
+		if fieldValue.ID == "" && fieldValue.Value == "" {
+			return nil
+		}
+
+		return []*IssueFieldValueScheme{fieldValue}
+
+	case float64:
+
+		number := strconv.FormatFloat(typedValue, 'f', -1, 64)
+		return []*IssueFieldValueScheme{{ID: number, Value: number}}
+
+	case string:
+
+		if typedValue == "" {
+			return nil
+		}
+
+		return []*IssueFieldValueScheme{{ID: typedValue, Value: typedValue}}
+	}
+
+	text := fmt.Sprint(value)
+	return []*IssueFieldValueScheme{{ID: text, Value: text}}
+}
+
+func firstRawString(value map[string]interface{}, keys ...string) string {
+
+	for _, key := range keys {
+
+		switch typedValue := value[key].(type) {
+		case string:
+			if typedValue != "" {
+				return typedValue
+			}
+		case float64:
+			return strconv.FormatFloat(typedValue, 'f', -1, 64)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,325 @@
+package models
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// mockTimelineIssue is an issue created on 2022-01-01 at 09:00, started on the 2nd, planned on the 3rd and done
+// on the 4th. The histories are returned out of order, and the fix version change of the 3rd has no field ID.
+func mockTimelineIssue(t *testing.T) (*IssueScheme, []*IssueChangelogHistoryScheme) {
+
+	issue := &IssueScheme{}
+	err := json.Unmarshal([]byte(`{"key":"KP-1","fields":{
+		"created":"2022-01-01T09:00:00.000+0000",
+		"status":{"id":"10001","name":"Done"},
+		"assignee":{"accountId":"bob","displayName":"Bob"},
+		"fixVersions":[{"id":"10001","name":"1.1"}],
+		"labels":["backend","urgent"],
+		"customfield_10020":[{"id":2,"name":"Sprint 2"}]}}`), issue)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	histories := []*IssueChangelogHistoryScheme{
+		{ID: "3", Created: "2022-01-04T09:00:00.000+0000", Author: &IssueChangelogAuthor{AccountID: "bob"}, Items: []*IssueChangelogHistoryItemScheme{
+			{FieldID: "status", From: "3", FromString: "In Progress", To: "10001", ToString: "Done"},
+			{FieldID: "assignee", From: "alice", FromString: "Alice", To: "bob", ToString: "Bob"},
+			{FieldID: "fixVersions", From: "10000", FromString: "1.0"},
+			{FieldID: "fixVersions", To: "10001", ToString: "1.1"},
+			{FieldID: "customfield_10020", From: "1, 2", FromString: "Sprint 1, Sprint 2", To: "2", ToString: "Sprint 2"},
+		}},
+		{ID: "1", Created: "2022-01-02T09:00:00.000+0000", Author: &IssueChangelogAuthor{AccountID: "alice"}, Items: []*IssueChangelogHistoryItemScheme{
+			{FieldID: "status", From: "1", FromString: "Open", To: "3", ToString: "In Progress"},
+			{FieldID: "assignee", To: "alice", ToString: "Alice"},
+		}},
+		nil,
+		{ID: "2", Created: "2022-01-03T09:00:00.000+0000", Author: &IssueChangelogAuthor{AccountID: "alice"}, Items: []*IssueChangelogHistoryItemScheme{
+			{Field: "Fix Version", To: "10000", ToString: "1.0"},
+			{FieldID: "labels", FromString: "backend", ToString: "backend urgent"},
+			{FieldID: "customfield_10020", From: "1", FromString: "Sprint 1", To: "1, 2", ToString: "Sprint 1, Sprint 2"},
+			nil,
+		}},
+	}
+
+	return issue, histories
+}
+
+func TestIssueTimelineScheme_At(t *testing.T) {
+
+	issue, histories := mockTimelineIssue(t)
+
+	timeline, err := NewIssueTimeline(issue, histories)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := func(fieldValues ...string) (result []*IssueFieldValueScheme) {
+
+		for index := 0; index < len(fieldValues); index += 2 {
+			result = append(result, &IssueFieldValueScheme{ID: fieldValues[index], Value: fieldValues[index+1]})
+		}
+
+		return result
+	}
+
+	testCases := []struct {
+		name            string
+		at              time.Time
+		wantStatus      []*IssueFieldValueScheme
+		wantAssignee    []*IssueFieldValueScheme
+		wantFixVersions []*IssueFieldValueScheme
+		wantLabels      []*IssueFieldValueScheme
+		wantSprints     []*IssueFieldValueScheme
+	}{
+		{
+			name:            "AtWhenTheIssueIsCreated",
+			at:              time.Date(2022, 1, 1, 9, 0, 0, 0, time.UTC),
+			wantStatus:      values("1", "Open"),
+			wantFixVersions: nil,
+			wantLabels:      values("backend", "backend"),
+			wantSprints:     values("1", "Sprint 1"),
+		},
+
+		{
+			name:         "AtWhenTheIssueIsStarted",
+			at:           time.Date(2022, 1, 2, 9, 0, 0, 0, time.UTC),
+			wantStatus:   values("3", "In Progress"),
+			wantAssignee: values("alice", "Alice"),
+			wantLabels:   values("backend", "backend"),
+			wantSprints:  values("1", "Sprint 1"),
+		},
+
+		{
+			name:            "AtWhenTheIssueIsPlanned",
+			at:              time.Date(2022, 1, 3, 12, 0, 0, 0, time.UTC),
+			wantStatus:      values("3", "In Progress"),
+			wantAssignee:    values("alice", "Alice"),
+			wantFixVersions: values("10000", "1.0"),
+			wantLabels:      values("backend", "backend", "urgent", "urgent"),
+			wantSprints:     values("1", "Sprint 1", "2", "Sprint 2"),
+		},
+
+		{
+			name:            "AtWhenTheIssueIsDone",
+			at:              time.Date(2022, 1, 5, 0, 0, 0, 0, time.UTC),
+			wantStatus:      values("10001", "Done"),
+			wantAssignee:    values("bob", "Bob"),
+			wantFixVersions: values("10001", "1.1"),
+			wantLabels:      values("backend", "backend", "urgent", "urgent"),
+			wantSprints:     values("2", "Sprint 2"),
+		},
+
+		{
+			name:        "AtWhenTheTimeIsBeforeTheCreation",
+			at:          time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC),
+			wantStatus:  values("1", "Open"),
+			wantLabels:  values("backend", "backend"),
+			wantSprints: values("1", "Sprint 1"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			state := timeline.At(testCase.at)
+
+			assert.Equal(t, testCase.at, state.At)
+			assert.Equal(t, testCase.wantStatus, state.Values("status"))
+			assert.Equal(t, testCase.wantAssignee, state.Values("assignee"))
+			assert.Equal(t, testCase.wantFixVersions, state.Values("fixVersions"))
+			assert.Equal(t, testCase.wantLabels, state.Values("labels"))
+			assert.Equal(t, testCase.wantSprints, state.Values("customfield_10020"))
+
+			if len(testCase.wantStatus) != 0 {
+				assert.Equal(t, testCase.wantStatus[0], state.Value("status"))
+			}
+		})
+	}
+}
+
+func TestIssueTimelineScheme_Intervals(t *testing.T) {
+
+	issue, histories := mockTimelineIssue(t)
+
+	timeline, err := NewIssueTimeline(issue, histories)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	day := 24 * time.Hour
+
+	testCases := []struct {
+		name          string
+		fieldID       string
+		until         time.Time
+		wantValues    []string
+		wantDurations []time.Duration
+	}{
+		{
+			name:          "IntervalsWhenTheStatusIsDone",
+			fieldID:       "status",
+			until:         time.Date(2022, 1, 5, 9, 0, 0, 0, time.UTC),
+			wantValues:    []string{"Open", "In Progress", "Done"},
+			wantDurations: []time.Duration{day, 2 * day, day},
+		},
+
+		{
+			name:          "IntervalsWhenTheTimeIsBeforeTheLastChange",
+			fieldID:       "status",
+			until:         time.Date(2022, 1, 3, 9, 0, 0, 0, time.UTC),
+			wantValues:    []string{"Open", "In Progress"},
+			wantDurations: []time.Duration{day, day},
+		},
+
+		{
+			name:          "IntervalsWhenTheIssueIsUnassigned",
+			fieldID:       "assignee",
+			until:         time.Date(2022, 1, 5, 9, 0, 0, 0, time.UTC),
+			wantValues:    []string{"", "Alice", "Bob"},
+			wantDurations: []time.Duration{day, 2 * day, day},
+		},
+
+		{
+			name:          "IntervalsWhenTheFieldHasSeveralValues",
+			fieldID:       "customfield_10020",
+			until:         time.Date(2022, 1, 4, 9, 0, 0, 0, time.UTC),
+			wantValues:    []string{"Sprint 1", "Sprint 1, Sprint 2"},
+			wantDurations: []time.Duration{2 * day, day},
+		},
+
+		{
+			name:    "IntervalsWhenTheTimeIsTheCreation",
+			fieldID: "status",
+			until:   time.Date(2022, 1, 1, 9, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var (
+				gotValues    []string
+				gotDurations []time.Duration
+			)
+
+			for _, interval := range timeline.Intervals(testCase.fieldID, testCase.until) {
+				gotValues = append(gotValues, interval.Value())
+				gotDurations = append(gotDurations, interval.Duration)
+			}
+
+			assert.Equal(t, testCase.wantValues, gotValues)
+			assert.Equal(t, testCase.wantDurations, gotDurations)
+		})
+	}
+
+	until := time.Date(2022, 1, 5, 9, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, timeline.Intervals("status", until), timeline.StatusIntervals(until))
+	assert.Equal(t, timeline.Intervals("assignee", until), timeline.AssigneeIntervals(until))
+	assert.Equal(t, map[string]time.Duration{"Open": day, "In Progress": 2 * day, "Done": day},
+		timeline.Durations("status", until))
+
+	// The changes are sorted by date, the old items are keyed by the field ID
+	assert.Equal(t, 10, len(timeline.Changes("")))
+	assert.Equal(t, 3, len(timeline.Changes("fixVersions")))
+	assert.Equal(t, "alice", timeline.Changes("fixVersions")[0].Author.AccountID)
+}
+
+func TestNewIssueTimeline(t *testing.T) {
+
+	issue, histories := mockTimelineIssue(t)
+
+	testCases := []struct {
+		name        string
+		issue       *IssueScheme
+		histories   []*IssueChangelogHistoryScheme
+		wantCreated time.Time
+		wantErr     bool
+	}{
+		{
+			name:        "NewIssueTimelineWhenTheIssueIsCreated",
+			issue:       issue,
+			histories:   histories,
+			wantCreated: time.Date(2022, 1, 1, 9, 0, 0, 0, time.UTC),
+		},
+
+		{
+			name:        "NewIssueTimelineWhenTheCreationIsUnknown",
+			issue:       &IssueScheme{Fields: &IssueFieldsScheme{Summary: "Login page"}},
+			histories:   histories,
+			wantCreated: time.Date(2022, 1, 2, 9, 0, 0, 0, time.UTC),
+		},
+
+		{
+			name:  "NewIssueTimelineWhenTheHistoryDateIsRFC3339",
+			issue: issue,
+			histories: []*IssueChangelogHistoryScheme{{ID: "1", Created: "2022-01-03T09:00:00Z",
+				Items: []*IssueChangelogHistoryItemScheme{{FieldID: "status"}}}},
+			wantCreated: time.Date(2022, 1, 1, 9, 0, 0, 0, time.UTC),
+		},
+
+		{
+			name:  "NewIssueTimelineWhenTheHistoryDateIsMalformed",
+			issue: issue,
+			histories: []*IssueChangelogHistoryScheme{{ID: "1", Created: "yesterday",
+				Items: []*IssueChangelogHistoryItemScheme{{FieldID: "status"}}}},
+			wantErr: true,
+		},
+
+		{
+			name:    "NewIssueTimelineWhenTheCreationIsMalformed",
+			issue:   &IssueScheme{Fields: &IssueFieldsScheme{Created: "yesterday"}},
+			wantErr: true,
+		},
+
+		{
+			name:    "NewIssueTimelineWhenTheIssueHasNoFields",
+			issue:   &IssueScheme{Key: "KP-1"},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			gotTimeline, err := NewIssueTimeline(testCase.issue, testCase.histories)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, testCase.wantCreated.Equal(gotTimeline.Created), "got %v", gotTimeline.Created)
+		})
+	}
+}
+
+func TestNewIssueTimelineV2(t *testing.T) {
+
+	issue := &IssueSchemeV2{}
+	err := json.Unmarshal([]byte(`{"key":"KP-1","fields":{"created":"2022-01-01T09:00:00.000+0000",
+		"status":{"id":"3","name":"In Progress"}}}`), issue)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	histories := []*IssueChangelogHistoryScheme{{ID: "1", Created: "2022-01-02T09:00:00.000+0000",
+		Items: []*IssueChangelogHistoryItemScheme{{FieldID: "status", From: "1", FromString: "Open", To: "3", ToString: "In Progress"}}}}
+
+	timeline, err := NewIssueTimelineV2(issue, histories)
+	assert.NoError(t, err)
+	assert.Equal(t, "Open", timeline.At(time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)).Value("status").Value)
+	assert.Equal(t, "In Progress", timeline.At(time.Date(2022, 1, 2, 12, 0, 0, 0, time.UTC)).Value("status").Value)
+
+	_, err = NewIssueTimelineV2(nil, histories)
+	assert.Error(t, err)
+}
@@ -0,0 +1,145 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// IssueTransitionFieldScheme is a field of a transition screen, the fields of the screens share the metadata scheme.
+type IssueTransitionFieldScheme = IssueFieldMetadataScheme
+
+// IssueTransitionToOptionsScheme customizes the IssueService.MoveTo method.
+type IssueTransitionToOptionsScheme struct {
+
+	// Fields contains the values of the transition screen fields, keyed by the field ID, e.g. resolution.
+	// The values are sent to the transitions with the field on their screen.
+	Fields map[string]interface{}
+
+	// MultipleHops moves the issue through other statuses when the status isn't reachable with a transition.
+	MultipleHops bool
+
+	// WorkflowName is the workflow of the issue used to find the statuses of the multiple hops, it's looked up
+	// on the workflow scheme of the project when it's empty.
+	WorkflowName string
+}
+
+// IssueTransitionFieldsError is returned when the values of required transition screen fields are missing.
+type IssueTransitionFieldsError struct {
+	TransitionID   string
+	TransitionName string
+
+	// Fields contains the missing fields, keyed by the field ID.
+	Fields map[string]*IssueTransitionFieldScheme
+}
+
+func (e *IssueTransitionFieldsError) Error() string {
+
+	var fields []string
+	for fieldID, field := range e.Fields {
+		fields = append(fields, fmt.Sprintf("%v (%v)", field.Name, fieldID))
+	}
+
+	sort.Strings(fields)
+
+	return fmt.Sprintf("jira: the transition %v requires the fields %v", e.TransitionName, strings.Join(fields, ", "))
+}
+
+// MissingFields returns the required fields of the transition screen without a value or a default value.
+func (i *IssueTransitionScheme) MissingFields(values map[string]interface{}) map[string]*IssueTransitionFieldScheme {
+
+	missing := make(map[string]*IssueTransitionFieldScheme)
+	for fieldID, field := range i.Fields {
+
+		if _, ok := values[fieldID]; field.Required && !field.HasDefaultValue && !ok {
+			missing[fieldID] = field
+		}
+	}
+
+	return missing
+}
+
+// Matches reports whether the transition or the status it goes to has the name, the names are compared ignoring the case.
+func (i *IssueTransitionScheme) Matches(name string) bool {
+	return strings.EqualFold(i.Name, name) || (i.To != nil && strings.EqualFold(i.To.Name, name))
+}
+
+// WorkflowTransitionPath returns the transitions to move an issue from a status to a status with the name, or to the
+// status of a transition with the name. The shortest path is returned, the global transitions go from every status.
+// The path is empty when the issue is on the status, and nil when the status isn't reachable.
+func WorkflowTransitionPath(workflow *WorkflowScheme, fromStatusID, target string) []*WorkflowTransitionScheme {
+
+	targets := make(map[string]bool)
+	for _, status := range workflow.Statuses {
+
+		if strings.EqualFold(status.Name, target) {
+			targets[status.ID] = true
+		}
+	}
+
+	for _, transition := range workflow.Transitions {
+
+		if strings.EqualFold(transition.Name, target) && transition.Type != "initial" {
+			targets[transition.To] = true
+		}
+	}
+
+	if targets[fromStatusID] {
+		return []*WorkflowTransitionScheme{}
+	}
+
+	// Breadth-first search, the first path found is the shortest one
+	var (
+		previousStatus     = map[string]string{fromStatusID: ""}
+		previousTransition = make(map[string]*WorkflowTransitionScheme)
+		queue              = []string{fromStatusID}
+	)
+
+	for len(queue) != 0 {
+
+		statusID := queue[0]
+		queue = queue[1:]
+
+		for _, transition := range workflow.Transitions {
+
+			if _, visited := previousStatus[transition.To]; visited || !transition.from(statusID) {
+				continue
+			}
+
+			previousStatus[transition.To], previousTransition[transition.To] = statusID, transition
+
+			if !targets[transition.To] {
+				queue = append(queue, transition.To)
+				continue
+			}
+
+			var path []*WorkflowTransitionScheme
+			for step := transition.To; step != fromStatusID; step = previousStatus[step] {
+				path = append([]*WorkflowTransitionScheme{previousTransition[step]}, path...)
+			}
+
+			return path
+		}
+	}
+
+	return nil
+}
+
+func (w *WorkflowTransitionScheme) from(statusID string) bool {
+
+	switch w.Type {
+	case "initial":
+		return false
+	case "global":
+		return true
+	}
+
+	for _, from := range w.From {
+
+		if from == statusID {
+			return true
+		}
+	}
+
+	return false
+}
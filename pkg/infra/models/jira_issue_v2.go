@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/imdario/mergo"
+	"time"
 )
 
 type IssueSchemeV2 struct {
@@ -75,30 +76,90 @@ func (i *IssueSchemeV2) ToMap() (result map[string]interface{}, err error) {
 }
 
 type IssueFieldsSchemeV2 struct {
-	IssueType                *IssueTypeScheme          `json:"issuetype,omitempty"`
-	IssueLinks               []*IssueLinkScheme        `json:"issuelinks,omitempty"`
-	Watcher                  *IssueWatcherScheme       `json:"watches,omitempty"`
-	Votes                    *IssueVoteScheme          `json:"votes,omitempty"`
-	Versions                 []*VersionScheme          `json:"versions,omitempty"`
-	Project                  *ProjectScheme            `json:"project,omitempty"`
-	FixVersions              []*VersionScheme          `json:"fixVersions,omitempty"`
-	Priority                 *PriorityScheme           `json:"priority,omitempty"`
-	Components               []*ComponentScheme        `json:"components,omitempty"`
-	Creator                  *UserScheme               `json:"creator,omitempty"`
-	Reporter                 *UserScheme               `json:"reporter,omitempty"`
-	Resolution               *ResolutionScheme         `json:"resolution,omitempty"`
-	Resolutiondate           string                    `json:"resolutiondate,omitempty"`
-	Workratio                int                       `json:"workratio,omitempty"`
-	StatusCategoryChangeDate string                    `json:"statuscategorychangedate,omitempty"`
-	LastViewed               string                    `json:"lastViewed,omitempty"`
-	Summary                  string                    `json:"summary,omitempty"`
-	Created                  string                    `json:"created,omitempty"`
-	Updated                  string                    `json:"updated,omitempty"`
-	Labels                   []string                  `json:"labels,omitempty"`
-	Status                   *StatusScheme             `json:"status,omitempty"`
-	Description              string                    `json:"description,omitempty"`
-	Comment                  *IssueCommentPageSchemeV2 `json:"comment,omitempty"`
-	Subtasks                 []*IssueScheme            `json:"subtasks,omitempty"`
+	IssueType                     *IssueTypeScheme          `json:"issuetype,omitempty"`
+	Parent                        *ParentScheme             `json:"parent,omitempty"`
+	IssueLinks                    []*IssueLinkScheme        `json:"issuelinks,omitempty"`
+	Watcher                       *IssueWatcherScheme       `json:"watches,omitempty"`
+	Votes                         *IssueVoteScheme          `json:"votes,omitempty"`
+	Versions                      []*VersionScheme          `json:"versions,omitempty"`
+	Project                       *ProjectScheme            `json:"project,omitempty"`
+	FixVersions                   []*VersionScheme          `json:"fixVersions,omitempty"`
+	Priority                      *PriorityScheme           `json:"priority,omitempty"`
+	Components                    []*ComponentScheme        `json:"components,omitempty"`
+	Creator                       *UserScheme               `json:"creator,omitempty"`
+	Reporter                      *UserScheme               `json:"reporter,omitempty"`
+	Assignee                      *UserScheme               `json:"assignee,omitempty"`
+	Resolution                    *ResolutionScheme         `json:"resolution,omitempty"`
+	Resolutiondate                string                    `json:"resolutiondate,omitempty"`
+	Workratio                     int                       `json:"workratio,omitempty"`
+	StatusCategoryChangeDate      string                    `json:"statuscategorychangedate,omitempty"`
+	LastViewed                    string                    `json:"lastViewed,omitempty"`
+	Summary                       string                    `json:"summary,omitempty"`
+	Created                       string                    `json:"created,omitempty"`
+	Updated                       string                    `json:"updated,omitempty"`
+	DueDate                       string                    `json:"duedate,omitempty"`
+	Labels                        []string                  `json:"labels,omitempty"`
+	Status                        *StatusScheme             `json:"status,omitempty"`
+	Security                      *SecurityScheme           `json:"security,omitempty"`
+	Description                   string                    `json:"description,omitempty"`
+	Environment                   string                    `json:"environment,omitempty"`
+	Comment                       *IssueCommentPageSchemeV2 `json:"comment,omitempty"`
+	Worklog                       *IssueWorklogPageScheme   `json:"worklog,omitempty"`
+	Attachment                    []*AttachmentScheme       `json:"attachment,omitempty"`
+	Subtasks                      []*IssueScheme            `json:"subtasks,omitempty"`
+	TimeTracking                  *IssueTimeTrackingScheme  `json:"timetracking,omitempty"`
+	TimeSpent                     int                       `json:"timespent,omitempty"`
+	TimeEstimate                  int                       `json:"timeestimate,omitempty"`
+	TimeOriginalEstimate          int                       `json:"timeoriginalestimate,omitempty"`
+	AggregateTimeSpent            int                       `json:"aggregatetimespent,omitempty"`
+	AggregateTimeEstimate         int                       `json:"aggregatetimeestimate,omitempty"`
+	AggregateTimeOriginalEstimate int                       `json:"aggregatetimeoriginalestimate,omitempty"`
+	Progress                      *IssueProgressScheme      `json:"progress,omitempty"`
+	AggregateProgress             *IssueProgressScheme      `json:"aggregateprogress,omitempty"`
+
+	// Raw contains every field returned by Jira, keyed by the field ID, including the custom fields.
+	Raw map[string]interface{} `json:"-"`
+}
+
+// CreatedTime returns the created field as a time, the zero time when it's not set.
+func (i *IssueFieldsSchemeV2) CreatedTime() (time.Time, error) {
+	return ParseDateTime(i.Created)
+}
+
+// UpdatedTime returns the updated field as a time, the zero time when it's not set.
+func (i *IssueFieldsSchemeV2) UpdatedTime() (time.Time, error) {
+	return ParseDateTime(i.Updated)
+}
+
+// ResolutionDateTime returns the resolutiondate field as a time, the zero time when the issue isn't resolved.
+func (i *IssueFieldsSchemeV2) ResolutionDateTime() (time.Time, error) {
+	return ParseDateTime(i.Resolutiondate)
+}
+
+// StatusCategoryChangeTime returns the statuscategorychangedate field as a time, the zero time when it's not set.
+func (i *IssueFieldsSchemeV2) StatusCategoryChangeTime() (time.Time, error) {
+	return ParseDateTime(i.StatusCategoryChangeDate)
+}
+
+// LastViewedTime returns the lastViewed field as a time, the zero time when it's not set.
+func (i *IssueFieldsSchemeV2) LastViewedTime() (time.Time, error) {
+	return ParseDateTime(i.LastViewed)
+}
+
+// DueDateTime returns the duedate field as a date at midnight UTC, the zero time when it's not set.
+func (i *IssueFieldsSchemeV2) DueDateTime() (time.Time, error) {
+	return ParseDate(i.DueDate)
+}
+
+// UnmarshalJSON decodes the typed fields and keeps a copy of every field on Raw.
+func (i *IssueFieldsSchemeV2) UnmarshalJSON(data []byte) error {
+
+	type alias IssueFieldsSchemeV2
+	if err := json.Unmarshal(data, (*alias)(i)); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, &i.Raw)
 }
 
 type IssueResponseScheme struct {
@@ -128,8 +189,9 @@ type IssueBulkResponseScheme struct {
 type IssueBulkResponseErrorScheme struct {
 	Status        int `json:"status"`
 	ElementErrors struct {
-		ErrorMessages []string `json:"errorMessages"`
-		Status        int      `json:"status"`
+		ErrorMessages []string          `json:"errorMessages"`
+		Errors        map[string]string `json:"errors"`
+		Status        int               `json:"status"`
 	} `json:"elementErrors"`
 	FailedElementNumber int `json:"failedElementNumber"`
 }
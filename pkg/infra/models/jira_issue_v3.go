@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/imdario/mergo"
+	"time"
 )
 
 type IssueScheme struct {
@@ -75,30 +76,90 @@ func (i *IssueScheme) ToMap() (result map[string]interface{}, err error) {
 }
 
 type IssueFieldsScheme struct {
-	IssueType                *IssueTypeScheme        `json:"issuetype,omitempty"`
-	IssueLinks               []*IssueLinkScheme      `json:"issuelinks,omitempty"`
-	Watcher                  *IssueWatcherScheme     `json:"watches,omitempty"`
-	Votes                    *IssueVoteScheme        `json:"votes,omitempty"`
-	Versions                 []*VersionScheme        `json:"versions,omitempty"`
-	Project                  *ProjectScheme          `json:"project,omitempty"`
-	FixVersions              []*VersionScheme        `json:"fixVersions,omitempty"`
-	Priority                 *PriorityScheme         `json:"priority,omitempty"`
-	Components               []*ComponentScheme      `json:"components,omitempty"`
-	Creator                  *UserScheme             `json:"creator,omitempty"`
-	Reporter                 *UserScheme             `json:"reporter,omitempty"`
-	Resolution               *ResolutionScheme       `json:"resolution,omitempty"`
-	Resolutiondate           string                  `json:"resolutiondate,omitempty"`
-	Workratio                int                     `json:"workratio,omitempty"`
-	StatusCategoryChangeDate string                  `json:"statuscategorychangedate,omitempty"`
-	LastViewed               string                  `json:"lastViewed,omitempty"`
-	Summary                  string                  `json:"summary,omitempty"`
-	Created                  string                  `json:"created,omitempty"`
-	Updated                  string                  `json:"updated,omitempty"`
-	Labels                   []string                `json:"labels,omitempty"`
-	Status                   *StatusScheme           `json:"status,omitempty"`
-	Description              *CommentNodeScheme      `json:"description,omitempty"`
-	Comment                  *IssueCommentPageScheme `json:"comment,omitempty"`
-	Subtasks                 []*IssueScheme          `json:"subtasks,omitempty"`
+	IssueType                     *IssueTypeScheme         `json:"issuetype,omitempty"`
+	Parent                        *ParentScheme            `json:"parent,omitempty"`
+	IssueLinks                    []*IssueLinkScheme       `json:"issuelinks,omitempty"`
+	Watcher                       *IssueWatcherScheme      `json:"watches,omitempty"`
+	Votes                         *IssueVoteScheme         `json:"votes,omitempty"`
+	Versions                      []*VersionScheme         `json:"versions,omitempty"`
+	Project                       *ProjectScheme           `json:"project,omitempty"`
+	FixVersions                   []*VersionScheme         `json:"fixVersions,omitempty"`
+	Priority                      *PriorityScheme          `json:"priority,omitempty"`
+	Components                    []*ComponentScheme       `json:"components,omitempty"`
+	Creator                       *UserScheme              `json:"creator,omitempty"`
+	Reporter                      *UserScheme              `json:"reporter,omitempty"`
+	Assignee                      *UserScheme              `json:"assignee,omitempty"`
+	Resolution                    *ResolutionScheme        `json:"resolution,omitempty"`
+	Resolutiondate                string                   `json:"resolutiondate,omitempty"`
+	Workratio                     int                      `json:"workratio,omitempty"`
+	StatusCategoryChangeDate      string                   `json:"statuscategorychangedate,omitempty"`
+	LastViewed                    string                   `json:"lastViewed,omitempty"`
+	Summary                       string                   `json:"summary,omitempty"`
+	Created                       string                   `json:"created,omitempty"`
+	Updated                       string                   `json:"updated,omitempty"`
+	DueDate                       string                   `json:"duedate,omitempty"`
+	Labels                        []string                 `json:"labels,omitempty"`
+	Status                        *StatusScheme            `json:"status,omitempty"`
+	Security                      *SecurityScheme          `json:"security,omitempty"`
+	Description                   *CommentNodeScheme       `json:"description,omitempty"`
+	Environment                   *CommentNodeScheme       `json:"environment,omitempty"`
+	Comment                       *IssueCommentPageScheme  `json:"comment,omitempty"`
+	Worklog                       *IssueWorklogPageScheme  `json:"worklog,omitempty"`
+	Attachment                    []*AttachmentScheme      `json:"attachment,omitempty"`
+	Subtasks                      []*IssueScheme           `json:"subtasks,omitempty"`
+	TimeTracking                  *IssueTimeTrackingScheme `json:"timetracking,omitempty"`
+	TimeSpent                     int                      `json:"timespent,omitempty"`
+	TimeEstimate                  int                      `json:"timeestimate,omitempty"`
+	TimeOriginalEstimate          int                      `json:"timeoriginalestimate,omitempty"`
+	AggregateTimeSpent            int                      `json:"aggregatetimespent,omitempty"`
+	AggregateTimeEstimate         int                      `json:"aggregatetimeestimate,omitempty"`
+	AggregateTimeOriginalEstimate int                      `json:"aggregatetimeoriginalestimate,omitempty"`
+	Progress                      *IssueProgressScheme     `json:"progress,omitempty"`
+	AggregateProgress             *IssueProgressScheme     `json:"aggregateprogress,omitempty"`
+
+	// Raw contains every field returned by Jira, keyed by the field ID, including the custom fields.
+	Raw map[string]interface{} `json:"-"`
+}
+
+// CreatedTime returns the created field as a time, the zero time when it's not set.
+func (i *IssueFieldsScheme) CreatedTime() (time.Time, error) {
+	return ParseDateTime(i.Created)
+}
+
+// UpdatedTime returns the updated field as a time, the zero time when it's not set.
+func (i *IssueFieldsScheme) UpdatedTime() (time.Time, error) {
+	return ParseDateTime(i.Updated)
+}
+
+// ResolutionDateTime returns the resolutiondate field as a time, the zero time when the issue isn't resolved.
+func (i *IssueFieldsScheme) ResolutionDateTime() (time.Time, error) {
+	return ParseDateTime(i.Resolutiondate)
+}
+
+// StatusCategoryChangeTime returns the statuscategorychangedate field as a time, the zero time when it's not set.
+func (i *IssueFieldsScheme) StatusCategoryChangeTime() (time.Time, error) {
+	return ParseDateTime(i.StatusCategoryChangeDate)
+}
+
+// LastViewedTime returns the lastViewed field as a time, the zero time when it's not set.
+func (i *IssueFieldsScheme) LastViewedTime() (time.Time, error) {
+	return ParseDateTime(i.LastViewed)
+}
+
+// DueDateTime returns the duedate field as a date at midnight UTC, the zero time when it's not set.
+func (i *IssueFieldsScheme) DueDateTime() (time.Time, error) {
+	return ParseDate(i.DueDate)
+}
+
+// UnmarshalJSON decodes the typed fields and keeps a copy of every field on Raw.
+func (i *IssueFieldsScheme) UnmarshalJSON(data []byte) error {
+
+	type alias IssueFieldsScheme
+	if err := json.Unmarshal(data, (*alias)(i)); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, &i.Raw)
 }
 
 type IssueTransitionScheme struct {
@@ -111,6 +172,9 @@ type IssueTransitionScheme struct {
 	IsAvailable   bool          `json:"isAvailable,omitempty"`
 	IsConditional bool          `json:"isConditional,omitempty"`
 	IsLooped      bool          `json:"isLooped,omitempty"`
+
+	// Fields contains the fields of the transition screen, they're returned with the transitions.fields expand.
+	Fields map[string]*IssueTransitionFieldScheme `json:"fields,omitempty"`
 }
 
 type StatusScheme struct {
@@ -179,3 +243,39 @@ type IssueMoveOptionsV3 struct {
 	CustomFields *CustomFields
 	Operations   *UpdateOperations
 }
+
+type ParentScheme struct {
+	ID     string              `json:"id,omitempty"`
+	Key    string              `json:"key,omitempty"`
+	Self   string              `json:"self,omitempty"`
+	Fields *ParentFieldsScheme `json:"fields,omitempty"`
+}
+
+type ParentFieldsScheme struct {
+	Summary   string           `json:"summary,omitempty"`
+	Status    *StatusScheme    `json:"status,omitempty"`
+	Priority  *PriorityScheme  `json:"priority,omitempty"`
+	IssueType *IssueTypeScheme `json:"issuetype,omitempty"`
+}
+
+type SecurityScheme struct {
+	Self        string `json:"self,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type IssueTimeTrackingScheme struct {
+	OriginalEstimate         string `json:"originalEstimate,omitempty"`
+	RemainingEstimate        string `json:"remainingEstimate,omitempty"`
+	TimeSpent                string `json:"timeSpent,omitempty"`
+	OriginalEstimateSeconds  int    `json:"originalEstimateSeconds,omitempty"`
+	RemainingEstimateSeconds int    `json:"remainingEstimateSeconds,omitempty"`
+	TimeSpentSeconds         int    `json:"timeSpentSeconds,omitempty"`
+}
+
+type IssueProgressScheme struct {
+	Progress int `json:"progress,omitempty"`
+	Total    int `json:"total,omitempty"`
+	Percent  int `json:"percent,omitempty"`
+}
@@ -0,0 +1,118 @@
+package models
+
+type ParsedJQLQueriesScheme struct {
+	Queries []*ParsedJQLQueryScheme `json:"queries,omitempty"`
+}
+
+type ParsedJQLQueryScheme struct {
+	Query     string                    `json:"query,omitempty"`
+	Structure *ParsedJQLStructureScheme `json:"structure,omitempty"`
+	Errors    []string                  `json:"errors,omitempty"`
+}
+
+type ParsedJQLStructureScheme struct {
+	Where   *ParsedJQLClauseScheme  `json:"where,omitempty"`
+	OrderBy *ParsedJQLOrderByScheme `json:"orderBy,omitempty"`
+}
+
+type ParsedJQLClauseScheme struct {
+	Clauses    []*ParsedJQLClauseScheme    `json:"clauses,omitempty"`
+	Operator   string                      `json:"operator,omitempty"`
+	Field      *ParsedJQLFieldScheme       `json:"field,omitempty"`
+	Operand    *ParsedJQLOperandScheme     `json:"operand,omitempty"`
+	Predicates []*ParsedJQLPredicateScheme `json:"predicates,omitempty"`
+}
+
+type ParsedJQLFieldScheme struct {
+	Name        string                          `json:"name,omitempty"`
+	EncodedName string                          `json:"encodedName,omitempty"`
+	Property    []*ParsedJQLFieldPropertyScheme `json:"property,omitempty"`
+}
+
+type ParsedJQLFieldPropertyScheme struct {
+	Entity string `json:"entity,omitempty"`
+	Key    string `json:"key,omitempty"`
+	Path   string `json:"path,omitempty"`
+	Type   string `json:"type,omitempty"`
+}
+
+type ParsedJQLOperandScheme struct {
+	Values         []*ParsedJQLOperandScheme `json:"values,omitempty"`
+	EncodedOperand string                    `json:"encodedOperand,omitempty"`
+	Value          string                    `json:"value,omitempty"`
+	EncodedValue   string                    `json:"encodedValue,omitempty"`
+	Function       string                    `json:"function,omitempty"`
+	Arguments      []string                  `json:"arguments,omitempty"`
+	Keyword        string                    `json:"keyword,omitempty"`
+}
+
+type ParsedJQLPredicateScheme struct {
+	Operator string                  `json:"operator,omitempty"`
+	Operand  *ParsedJQLOperandScheme `json:"operand,omitempty"`
+}
+
+type ParsedJQLOrderByScheme struct {
+	Fields []*ParsedJQLOrderByFieldScheme `json:"fields,omitempty"`
+}
+
+type ParsedJQLOrderByFieldScheme struct {
+	Field     *ParsedJQLFieldScheme `json:"field,omitempty"`
+	Direction string                `json:"direction,omitempty"`
+}
+
+type JQLSanitizeQueryScheme struct {
+	Query     string `json:"query,omitempty"`
+	AccountID string `json:"accountId,omitempty"`
+}
+
+type SanitizedJQLQueriesScheme struct {
+	Queries []*SanitizedJQLQueryScheme `json:"queries,omitempty"`
+}
+
+type SanitizedJQLQueryScheme struct {
+	InitialQuery   string                    `json:"initialQuery,omitempty"`
+	SanitizedQuery string                    `json:"sanitizedQuery,omitempty"`
+	Errors         *SanitizedJQLErrorsScheme `json:"errors,omitempty"`
+	AccountID      string                    `json:"accountId,omitempty"`
+}
+
+type SanitizedJQLErrorsScheme struct {
+	ErrorMessages []string          `json:"errorMessages,omitempty"`
+	Errors        map[string]string `json:"errors,omitempty"`
+	Status        int               `json:"status,omitempty"`
+}
+
+type ConvertedJQLQueriesScheme struct {
+	QueryStrings            []string                              `json:"queryStrings,omitempty"`
+	QueriesWithUnknownUsers []*ConvertedJQLQueryUnknownUserScheme `json:"queriesWithUnknownUsers,omitempty"`
+}
+
+type ConvertedJQLQueryUnknownUserScheme struct {
+	OriginalQuery  string `json:"originalQuery,omitempty"`
+	ConvertedQuery string `json:"convertedQuery,omitempty"`
+}
+
+type JQLAutocompleteDataScheme struct {
+	VisibleFieldNames    []*JQLAutocompleteFieldScheme    `json:"visibleFieldNames,omitempty"`
+	VisibleFunctionNames []*JQLAutocompleteFunctionScheme `json:"visibleFunctionNames,omitempty"`
+	JqlReservedWords     []string                         `json:"jqlReservedWords,omitempty"`
+}
+
+type JQLAutocompleteFieldScheme struct {
+	Value       string   `json:"value,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Orderable   string   `json:"orderable,omitempty"`
+	Searchable  string   `json:"searchable,omitempty"`
+	Auto        string   `json:"auto,omitempty"`
+	Cfid        string   `json:"cfid,omitempty"`
+	Operators   []string `json:"operators,omitempty"`
+	Types       []string `json:"types,omitempty"`
+}
+
+type JQLAutocompleteFunctionScheme struct {
+	Value                               string   `json:"value,omitempty"`
+	DisplayName                         string   `json:"displayName,omitempty"`
+	IsList                              string   `json:"isList,omitempty"`
+	SupportsListAndSingleValueOperators string   `json:"supportsListAndSingleValueOperators,omitempty"`
+	Types                               []string `json:"types,omitempty"`
+}
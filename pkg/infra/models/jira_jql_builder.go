@@ -0,0 +1,631 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JQLExpression is a node of a JQL query that can be combined with AND, OR and NOT: a clause, a group or a negation.
+// The String method returns the canonical JQL of the expression.
+type JQLExpression interface {
+	fmt.Stringer
+	isJQLExpression()
+}
+
+// JQLOperand is the right side of a clause: a value, a list, a function or the EMPTY keyword.
+type JQLOperand interface {
+	fmt.Stringer
+	isJQLOperand()
+}
+
+// The JQL operators.
+const (
+	JQLOperatorEquals         = "="
+	JQLOperatorNotEquals      = "!="
+	JQLOperatorGreater        = ">"
+	JQLOperatorGreaterOrEqual = ">="
+	JQLOperatorLess           = "<"
+	JQLOperatorLessOrEqual    = "<="
+	JQLOperatorContains       = "~"
+	JQLOperatorNotContains    = "!~"
+	JQLOperatorIn             = "IN"
+	JQLOperatorNotIn          = "NOT IN"
+	JQLOperatorIs             = "IS"
+	JQLOperatorIsNot          = "IS NOT"
+	JQLOperatorWas            = "WAS"
+	JQLOperatorWasNot         = "WAS NOT"
+	JQLOperatorWasIn          = "WAS IN"
+	JQLOperatorWasNotIn       = "WAS NOT IN"
+	JQLOperatorChanged        = "CHANGED"
+)
+
+// The predicates of the WAS and CHANGED history operators.
+const (
+	JQLPredicateAfter  = "AFTER"
+	JQLPredicateBefore = "BEFORE"
+	JQLPredicateOn     = "ON"
+	JQLPredicateDuring = "DURING"
+	JQLPredicateBy     = "BY"
+	JQLPredicateFrom   = "FROM"
+	JQLPredicateTo     = "TO"
+)
+
+// The JQL logical operators and sort directions.
+const (
+	JQLAndOperator     = "AND"
+	JQLOrOperator      = "OR"
+	JQLOrderAscending  = "ASC"
+	JQLOrderDescending = "DESC"
+)
+
+// JQLDateTimeFormat is the format used to print the time.Time values, the time is omitted at midnight.
+const (
+	JQLDateTimeFormat = "2006/01/02 15:04"
+	JQLDateFormat     = "2006/01/02"
+)
+
+// jqlReservedWords contains the words that must be quoted when used as field names or values.
+var jqlReservedWords = jqlWords("a", "an", "abort", "access", "add", "after", "alias", "all", "alter", "and", "any",
+	"are", "as", "asc", "at", "audit", "avg", "before", "begin", "between", "boolean", "break", "by", "byte", "catch",
+	"cf", "char", "character", "check", "checkpoint", "collate", "collation", "column", "commit", "connect",
+	"continue", "count", "create", "current", "date", "decimal", "declare", "decrement", "default", "defaults",
+	"define", "delete", "delimiter", "desc", "difference", "distinct", "divide", "do", "double", "drop", "else",
+	"empty", "encoding", "end", "equals", "escape", "exclusive", "exec", "execute", "exists", "explain", "false",
+	"fetch", "file", "field", "first", "float", "for", "from", "function", "go", "goto", "grant", "greater", "group",
+	"having", "identified", "if", "immediate", "in", "increment", "index", "initial", "inner", "inout", "input",
+	"insert", "int", "integer", "intersect", "intersection", "into", "is", "isempty", "isnull", "join", "last",
+	"left", "less", "like", "limit", "lock", "long", "max", "min", "minus", "mode", "modify", "modulo", "more",
+	"multiply", "next", "noaudit", "not", "notin", "nowait", "null", "number", "object", "of", "on", "option", "or",
+	"order", "outer", "output", "power", "previous", "prior", "privileges", "public", "raise", "raw", "remainder",
+	"rename", "resource", "return", "returns", "revoke", "right", "row", "rowid", "rownum", "rows", "select",
+	"session", "set", "share", "size", "sqrt", "start", "strict", "string", "subtract", "sum", "synonym", "table",
+	"then", "to", "trans", "transaction", "trigger", "true", "uid", "union", "unique", "update", "user", "validate",
+	"values", "view", "was", "changed", "when", "whenever", "where", "while", "with")
+
+var (
+	jqlUnquotedRegexp    = regexp.MustCompile(`^[A-Za-z0-9_.\-]+$`)
+	jqlFieldNameRegexp   = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\[[A-Za-z0-9_.\-]+\])?(\.[A-Za-z_][A-Za-z0-9_]*(\[[A-Za-z0-9_.\-]+\])?)*$`)
+	jqlCustomFieldRegexp = regexp.MustCompile(`^(?i:customfield_|cf\[)([0-9]+)\]?$`)
+	jqlStringEscaper     = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+)
+
+func jqlWords(words ...string) map[string]bool {
+
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+
+	return set
+}
+
+// JQLQuote returns the value as a JQL string literal, escaping the quotes and backslashes.
+func JQLQuote(value string) string {
+	return `"` + jqlStringEscaper.Replace(value) + `"`
+}
+
+// jqlToken returns the value unquoted when it's a plain word, or quoted otherwise.
+func jqlToken(value string) string {
+
+	if jqlUnquotedRegexp.MatchString(value) && !jqlReservedWords[strings.ToLower(value)] {
+		return value
+	}
+
+	return JQLQuote(value)
+}
+
+// JQLQueryScheme is a JQL query: the filter expression and the sort order.
+type JQLQueryScheme struct {
+	Where   JQLExpression
+	OrderBy []*JQLOrderScheme
+}
+
+// NewJQLQuery creates a query, joining the expressions with AND.
+func NewJQLQuery(expressions ...JQLExpression) *JQLQueryScheme {
+	return &JQLQueryScheme{Where: JQLAnd(expressions...)}
+}
+
+// And adds the expressions to the filter of the query with AND.
+func (q *JQLQueryScheme) And(expressions ...JQLExpression) *JQLQueryScheme {
+	q.Where = JQLAnd(append([]JQLExpression{q.Where}, expressions...)...)
+	return q
+}
+
+// Or adds the expressions to the filter of the query with OR.
+func (q *JQLQueryScheme) Or(expressions ...JQLExpression) *JQLQueryScheme {
+	q.Where = JQLOr(append([]JQLExpression{q.Where}, expressions...)...)
+	return q
+}
+
+// Sort adds a field to the ORDER BY clause, the direction is JQLOrderAscending, JQLOrderDescending or empty.
+func (q *JQLQueryScheme) Sort(field *JQLFieldScheme, direction string) *JQLQueryScheme {
+	q.OrderBy = append(q.OrderBy, &JQLOrderScheme{Field: field, Direction: direction})
+	return q
+}
+
+// String returns the canonical JQL of the query.
+func (q *JQLQueryScheme) String() string {
+
+	var parts []string
+
+	if q.Where != nil {
+		if where := q.Where.String(); where != "" {
+			parts = append(parts, where)
+		}
+	}
+
+	if len(q.OrderBy) != 0 {
+
+		var orders []string
+		for _, order := range q.OrderBy {
+			orders = append(orders, order.String())
+		}
+
+		parts = append(parts, "ORDER BY "+strings.Join(orders, ", "))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// JQLOrderScheme is a field of the ORDER BY clause.
+type JQLOrderScheme struct {
+	Field     *JQLFieldScheme
+	Direction string
+}
+
+func (o *JQLOrderScheme) String() string {
+
+	if o.Direction == "" {
+		return o.Field.String()
+	}
+
+	return o.Field.String() + " " + strings.ToUpper(o.Direction)
+}
+
+// JQLFieldScheme is a field reference, e.g. status, "Story Points" or cf[10010].
+type JQLFieldScheme struct {
+	Name string
+}
+
+// JQLField creates a field reference by name or ID, the custom field IDs are converted to the cf[12345] form.
+func JQLField(name string) *JQLFieldScheme {
+
+	if matches := jqlCustomFieldRegexp.FindStringSubmatch(name); matches != nil {
+		return &JQLFieldScheme{Name: "cf[" + matches[1] + "]"}
+	}
+
+	return &JQLFieldScheme{Name: name}
+}
+
+// JQLCustomField creates the cf[12345] reference of a custom field.
+func JQLCustomField(id int) *JQLFieldScheme {
+	return &JQLFieldScheme{Name: "cf[" + strconv.Itoa(id) + "]"}
+}
+
+// String returns the field name, quoted if it contains spaces or special characters, or if it's a reserved word.
+// The custom field and entity property references, e.g. cf[10010] or issue.property[foo].bar, aren't quoted.
+func (f *JQLFieldScheme) String() string {
+
+	if jqlFieldNameRegexp.MatchString(f.Name) && !jqlReservedWords[strings.ToLower(f.Name)] {
+		return f.Name
+	}
+
+	return JQLQuote(f.Name)
+}
+
+func (f *JQLFieldScheme) clause(operator string, operand JQLOperand) *JQLClauseScheme {
+	return &JQLClauseScheme{Field: f, Operator: operator, Operand: operand}
+}
+
+// Eq creates the field = value clause.
+func (f *JQLFieldScheme) Eq(value interface{}) *JQLClauseScheme {
+	return f.clause(JQLOperatorEquals, JQLValue(value))
+}
+
+// NotEq creates the field != value clause.
+func (f *JQLFieldScheme) NotEq(value interface{}) *JQLClauseScheme {
+	return f.clause(JQLOperatorNotEquals, JQLValue(value))
+}
+
+// Greater creates the field > value clause.
+func (f *JQLFieldScheme) Greater(value interface{}) *JQLClauseScheme {
+	return f.clause(JQLOperatorGreater, JQLValue(value))
+}
+
+// GreaterOrEqual creates the field >= value clause.
+func (f *JQLFieldScheme) GreaterOrEqual(value interface{}) *JQLClauseScheme {
+	return f.clause(JQLOperatorGreaterOrEqual, JQLValue(value))
+}
+
+// Less creates the field < value clause.
+func (f *JQLFieldScheme) Less(value interface{}) *JQLClauseScheme {
+	return f.clause(JQLOperatorLess, JQLValue(value))
+}
+
+// LessOrEqual creates the field <= value clause.
+func (f *JQLFieldScheme) LessOrEqual(value interface{}) *JQLClauseScheme {
+	return f.clause(JQLOperatorLessOrEqual, JQLValue(value))
+}
+
+// Contains creates the field ~ text clause, used for the text searches.
+func (f *JQLFieldScheme) Contains(text string) *JQLClauseScheme {
+	return f.clause(JQLOperatorContains, &JQLValueScheme{Value: text})
+}
+
+// NotContains creates the field !~ text clause.
+func (f *JQLFieldScheme) NotContains(text string) *JQLClauseScheme {
+	return f.clause(JQLOperatorNotContains, &JQLValueScheme{Value: text})
+}
+
+// In creates the field IN (values) clause.
+func (f *JQLFieldScheme) In(values ...interface{}) *JQLClauseScheme {
+	return f.clause(JQLOperatorIn, JQLList(values...))
+}
+
+// NotIn creates the field NOT IN (values) clause.
+func (f *JQLFieldScheme) NotIn(values ...interface{}) *JQLClauseScheme {
+	return f.clause(JQLOperatorNotIn, JQLList(values...))
+}
+
+// IsEmpty creates the field IS EMPTY clause.
+func (f *JQLFieldScheme) IsEmpty() *JQLClauseScheme {
+	return f.clause(JQLOperatorIs, JQLEmpty)
+}
+
+// IsNotEmpty creates the field IS NOT EMPTY clause.
+func (f *JQLFieldScheme) IsNotEmpty() *JQLClauseScheme {
+	return f.clause(JQLOperatorIsNot, JQLEmpty)
+}
+
+// Was creates the field WAS value clause, use the predicate methods to limit the history, e.g. During or By.
+func (f *JQLFieldScheme) Was(value interface{}) *JQLClauseScheme {
+	return f.clause(JQLOperatorWas, JQLValue(value))
+}
+
+// WasNot creates the field WAS NOT value clause.
+func (f *JQLFieldScheme) WasNot(value interface{}) *JQLClauseScheme {
+	return f.clause(JQLOperatorWasNot, JQLValue(value))
+}
+
+// WasIn creates the field WAS IN (values) clause.
+func (f *JQLFieldScheme) WasIn(values ...interface{}) *JQLClauseScheme {
+	return f.clause(JQLOperatorWasIn, JQLList(values...))
+}
+
+// WasNotIn creates the field WAS NOT IN (values) clause.
+func (f *JQLFieldScheme) WasNotIn(values ...interface{}) *JQLClauseScheme {
+	return f.clause(JQLOperatorWasNotIn, JQLList(values...))
+}
+
+// Changed creates the field CHANGED clause, use the predicate methods to limit the changes, e.g. From, To or After.
+func (f *JQLFieldScheme) Changed() *JQLClauseScheme {
+	return f.clause(JQLOperatorChanged, nil)
+}
+
+// JQLClauseScheme is a field, an operator and an operand, with the predicates of the history operators.
+type JQLClauseScheme struct {
+	Field      *JQLFieldScheme
+	Operator   string
+	Operand    JQLOperand
+	Predicates []*JQLPredicateScheme
+}
+
+func (c *JQLClauseScheme) isJQLExpression() {}
+
+func (c *JQLClauseScheme) predicate(operator string, operand JQLOperand) *JQLClauseScheme {
+	c.Predicates = append(c.Predicates, &JQLPredicateScheme{Operator: operator, Operand: operand})
+	return c
+}
+
+// After limits the WAS and CHANGED clauses to the changes after the date.
+func (c *JQLClauseScheme) After(date interface{}) *JQLClauseScheme {
+	return c.predicate(JQLPredicateAfter, JQLValue(date))
+}
+
+// Before limits the WAS and CHANGED clauses to the changes before the date.
+func (c *JQLClauseScheme) Before(date interface{}) *JQLClauseScheme {
+	return c.predicate(JQLPredicateBefore, JQLValue(date))
+}
+
+// On limits the WAS and CHANGED clauses to the changes on the date.
+func (c *JQLClauseScheme) On(date interface{}) *JQLClauseScheme {
+	return c.predicate(JQLPredicateOn, JQLValue(date))
+}
+
+// During limits the WAS and CHANGED clauses to the changes between the dates.
+func (c *JQLClauseScheme) During(from, to interface{}) *JQLClauseScheme {
+	return c.predicate(JQLPredicateDuring, JQLList(from, to))
+}
+
+// By limits the WAS and CHANGED clauses to the changes made by the user, e.g. JQLCurrentUser().
+func (c *JQLClauseScheme) By(user interface{}) *JQLClauseScheme {
+	return c.predicate(JQLPredicateBy, JQLValue(user))
+}
+
+// From limits the CHANGED clauses to the changes from the value.
+func (c *JQLClauseScheme) From(value interface{}) *JQLClauseScheme {
+	return c.predicate(JQLPredicateFrom, JQLValue(value))
+}
+
+// To limits the CHANGED clauses to the changes to the value.
+func (c *JQLClauseScheme) To(value interface{}) *JQLClauseScheme {
+	return c.predicate(JQLPredicateTo, JQLValue(value))
+}
+
+func (c *JQLClauseScheme) String() string {
+
+	parts := []string{c.Field.String(), strings.ToUpper(c.Operator)}
+	if c.Operand != nil {
+		parts = append(parts, c.Operand.String())
+	}
+
+	for _, predicate := range c.Predicates {
+		parts = append(parts, predicate.String())
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// JQLPredicateScheme is a predicate of the WAS and CHANGED operators, e.g. DURING ("2021/01/01", "2021/02/01").
+type JQLPredicateScheme struct {
+	Operator string
+	Operand  JQLOperand
+}
+
+func (p *JQLPredicateScheme) String() string {
+	return strings.ToUpper(p.Operator) + " " + p.Operand.String()
+}
+
+// JQLGroupScheme joins the expressions with the AND or OR operator.
+type JQLGroupScheme struct {
+	Operator    string
+	Expressions []JQLExpression
+}
+
+func (g *JQLGroupScheme) isJQLExpression() {}
+
+// String returns the expressions joined by the operator, the nested groups with another operator are parenthesized.
+func (g *JQLGroupScheme) String() string {
+
+	var parts []string
+	for _, expression := range g.Expressions {
+
+		text := expression.String()
+		if group, ok := expression.(*JQLGroupScheme); ok && len(group.Expressions) > 1 &&
+			!strings.EqualFold(group.Operator, g.Operator) {
+			text = "(" + text + ")"
+		}
+
+		parts = append(parts, text)
+	}
+
+	return strings.Join(parts, " "+strings.ToUpper(g.Operator)+" ")
+}
+
+// JQLNotScheme negates an expression.
+type JQLNotScheme struct {
+	Expression JQLExpression
+}
+
+func (n *JQLNotScheme) isJQLExpression() {}
+
+func (n *JQLNotScheme) String() string {
+
+	if group, ok := n.Expression.(*JQLGroupScheme); ok && len(group.Expressions) > 1 {
+		return "NOT (" + group.String() + ")"
+	}
+
+	return "NOT " + n.Expression.String()
+}
+
+// JQLAnd joins the expressions with AND, the nil expressions are ignored and the nested AND groups are flattened.
+// It returns nil without expressions, or the expression itself if there's only one.
+func JQLAnd(expressions ...JQLExpression) JQLExpression {
+	return jqlGroup(JQLAndOperator, expressions)
+}
+
+// JQLOr joins the expressions with OR, the nil expressions are ignored and the nested OR groups are flattened.
+// It returns nil without expressions, or the expression itself if there's only one.
+func JQLOr(expressions ...JQLExpression) JQLExpression {
+	return jqlGroup(JQLOrOperator, expressions)
+}
+
+// JQLNot negates the expression.
+func JQLNot(expression JQLExpression) JQLExpression {
+	return &JQLNotScheme{Expression: expression}
+}
+
+func jqlGroup(operator string, expressions []JQLExpression) JQLExpression {
+
+	group := &JQLGroupScheme{Operator: operator}
+
+	for _, expression := range expressions {
+
+		if isNilJQLExpression(expression) {
+			continue
+		}
+
+		if nested, ok := expression.(*JQLGroupScheme); ok && strings.EqualFold(nested.Operator, operator) {
+			group.Expressions = append(group.Expressions, nested.Expressions...)
+			continue
+		}
+
+		group.Expressions = append(group.Expressions, expression)
+	}
+
+	switch len(group.Expressions) {
+	case 0:
+		return nil
+	case 1:
+		return group.Expressions[0]
+	}
+
+	return group
+}
+
+// isNilJQLExpression checks the nil interfaces and the nil pointers stored on the interface.
+func isNilJQLExpression(expression JQLExpression) bool {
+
+	switch typed := expression.(type) {
+	case nil:
+		return true
+	case *JQLClauseScheme:
+		return typed == nil
+	case *JQLGroupScheme:
+		return typed == nil || len(typed.Expressions) == 0
+	case *JQLNotScheme:
+		return typed == nil
+	}
+
+	return false
+}
+
+// JQLValueScheme is a string or number value, printed without quotes when it's a plain word.
+type JQLValueScheme struct {
+	Value string
+}
+
+func (v *JQLValueScheme) isJQLOperand() {}
+
+func (v *JQLValueScheme) String() string {
+	return jqlToken(v.Value)
+}
+
+// JQLListScheme is a list of operands, e.g. the values of the IN operator.
+type JQLListScheme struct {
+	Values []JQLOperand
+}
+
+func (l *JQLListScheme) isJQLOperand() {}
+
+func (l *JQLListScheme) String() string {
+
+	var values []string
+	for _, value := range l.Values {
+		values = append(values, value.String())
+	}
+
+	return "(" + strings.Join(values, ", ") + ")"
+}
+
+// JQLList creates a list of values, see JQLValue for the accepted types.
+func JQLList(values ...interface{}) *JQLListScheme {
+
+	list := &JQLListScheme{}
+	for _, value := range values {
+		list.Values = append(list.Values, JQLValue(value))
+	}
+
+	return list
+}
+
+// JQLFunctionScheme is a function call, e.g. currentUser() or membersOf("jira-software-users").
+type JQLFunctionScheme struct {
+	Name      string
+	Arguments []string
+}
+
+func (f *JQLFunctionScheme) isJQLOperand() {}
+
+// String returns the function call, the arguments are always quoted.
+func (f *JQLFunctionScheme) String() string {
+
+	var arguments []string
+	for _, argument := range f.Arguments {
+		arguments = append(arguments, JQLQuote(argument))
+	}
+
+	return f.Name + "(" + strings.Join(arguments, ", ") + ")"
+}
+
+// JQLFunction creates a function call with string arguments.
+func JQLFunction(name string, arguments ...string) *JQLFunctionScheme {
+	return &JQLFunctionScheme{Name: name, Arguments: arguments}
+}
+
+// JQLCurrentUser creates the currentUser() function.
+func JQLCurrentUser() *JQLFunctionScheme {
+	return JQLFunction("currentUser")
+}
+
+// JQLOpenSprints creates the openSprints() function.
+func JQLOpenSprints() *JQLFunctionScheme {
+	return JQLFunction("openSprints")
+}
+
+// JQLClosedSprints creates the closedSprints() function.
+func JQLClosedSprints() *JQLFunctionScheme {
+	return JQLFunction("closedSprints")
+}
+
+// JQLFutureSprints creates the futureSprints() function.
+func JQLFutureSprints() *JQLFunctionScheme {
+	return JQLFunction("futureSprints")
+}
+
+// JQLMembersOf creates the membersOf(group) function.
+func JQLMembersOf(group string) *JQLFunctionScheme {
+	return JQLFunction("membersOf", group)
+}
+
+// JQLNow creates the now() function.
+func JQLNow() *JQLFunctionScheme {
+	return JQLFunction("now")
+}
+
+// JQLStartOfDay creates the startOfDay() function, the optional increment is a relative date, e.g. -1d.
+func JQLStartOfDay(increment ...string) *JQLFunctionScheme {
+	return JQLFunction("startOfDay", increment...)
+}
+
+// JQLEndOfDay creates the endOfDay() function, the optional increment is a relative date, e.g. +1d.
+func JQLEndOfDay(increment ...string) *JQLFunctionScheme {
+	return JQLFunction("endOfDay", increment...)
+}
+
+// JQLKeyword is one of the EMPTY or NULL keywords.
+type JQLKeyword string
+
+// The JQL keywords used as operands.
+const (
+	JQLEmpty JQLKeyword = "EMPTY"
+	JQLNull  JQLKeyword = "NULL"
+)
+
+func (k JQLKeyword) isJQLOperand() {}
+
+func (k JQLKeyword) String() string {
+	return strings.ToUpper(string(k))
+}
+
+// JQLValue converts a Go value to an operand: the operands are kept, the strings and fmt.Stringer values are
+// converted to string values, the numbers are printed without quotes and the time.Time values use the JQLDateTimeFormat.
+func JQLValue(value interface{}) JQLOperand {
+
+	switch typed := value.(type) {
+	case JQLOperand:
+		return typed
+	case string:
+		return &JQLValueScheme{Value: typed}
+	case int:
+		return &JQLValueScheme{Value: strconv.Itoa(typed)}
+	case int64:
+		return &JQLValueScheme{Value: strconv.FormatInt(typed, 10)}
+	case float64:
+		return &JQLValueScheme{Value: strconv.FormatFloat(typed, 'f', -1, 64)}
+	case time.Time:
+
+		if typed.Hour() == 0 && typed.Minute() == 0 {
+			return &JQLValueScheme{Value: typed.Format(JQLDateFormat)}
+		}
+
+		return &JQLValueScheme{Value: typed.Format(JQLDateTimeFormat)}
+	case fmt.Stringer:
+		return &JQLValueScheme{Value: typed.String()}
+	}
+
+	return &JQLValueScheme{Value: fmt.Sprint(value)}
+}
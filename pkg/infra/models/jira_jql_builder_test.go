@@ -0,0 +1,180 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestJQLFieldScheme_String(t *testing.T) {
+
+	testCases := []struct {
+		name  string
+		field *JQLFieldScheme
+		want  string
+	}{
+		{
+			name:  "StringWhenTheFieldIsAWord",
+			field: JQLField("status"),
+			want:  "status",
+		},
+
+		{
+			name:  "StringWhenTheFieldIsADottedName",
+			field: JQLField("parent.key"),
+			want:  "parent.key",
+		},
+
+		{
+			name:  "StringWhenTheFieldIsACustomFieldReference",
+			field: JQLField("cf[10010]"),
+			want:  "cf[10010]",
+		},
+
+		{
+			name:  "StringWhenTheFieldIsACustomFieldID",
+			field: JQLField("customfield_10010"),
+			want:  "cf[10010]",
+		},
+
+		{
+			name:  "StringWhenTheFieldIsCreatedFromTheCustomFieldID",
+			field: JQLCustomField(10010),
+			want:  "cf[10010]",
+		},
+
+		{
+			name:  "StringWhenTheFieldIsAnEntityProperty",
+			field: JQLField("issue.property[foo].bar"),
+			want:  "issue.property[foo].bar",
+		},
+
+		{
+			name:  "StringWhenTheEntityPropertyKeyIsDotted",
+			field: JQLField("issue.property[com.example.config].enabled"),
+			want:  "issue.property[com.example.config].enabled",
+		},
+
+		{
+			name:  "StringWhenTheFieldHasSpaces",
+			field: JQLField("Story Points"),
+			want:  `"Story Points"`,
+		},
+
+		{
+			name:  "StringWhenTheFieldIsAReservedWord",
+			field: JQLField("order"),
+			want:  `"order"`,
+		},
+
+		{
+			name:  "StringWhenTheCustomFieldReferenceIsNotClosed",
+			field: &JQLFieldScheme{Name: "cf[10010"},
+			want:  `"cf[10010"`,
+		},
+
+		{
+			name:  "StringWhenTheEntityPropertyKeyIsEmpty",
+			field: JQLField("issue.property[].bar"),
+			want:  `"issue.property[].bar"`,
+		},
+
+		{
+			name:  "StringWhenTheFieldHasQuotes",
+			field: JQLField(`Team "A"`),
+			want:  `"Team \"A\""`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.field.String())
+		})
+	}
+}
+
+func TestJQLQuote(t *testing.T) {
+
+	testCases := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "QuoteWhenTheValueIsAWord", value: "KP", want: `"KP"`},
+		{name: "QuoteWhenTheValueIsEmpty", value: "", want: `""`},
+		{name: "QuoteWhenTheValueHasQuotes", value: `K"P`, want: `"K\"P"`},
+		{name: "QuoteWhenTheValueHasBackslashes", value: `K\P`, want: `"K\\P"`},
+		{name: "QuoteWhenTheValueHasControlCharacters", value: "K\n\r\tP", want: `"K\n\r\tP"`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, JQLQuote(testCase.value))
+		})
+	}
+}
+
+func TestJQLQueryScheme_String(t *testing.T) {
+
+	testCases := []struct {
+		name  string
+		query *JQLQueryScheme
+		want  string
+	}{
+		{
+			name:  "StringWhenTheQueryIsEmpty",
+			query: NewJQLQuery(),
+			want:  "",
+		},
+
+		{
+			name: "StringWhenTheClausesAreJoined",
+			query: NewJQLQuery(
+				JQLField("project").Eq("KP"),
+				JQLField("issue.property[foo].bar").Eq("baz"),
+				JQLField("cf[10010]").Greater(3),
+			),
+			want: "project = KP AND issue.property[foo].bar = baz AND cf[10010] > 3",
+		},
+
+		{
+			name: "StringWhenTheValuesAreQuoted",
+			query: NewJQLQuery(
+				JQLField("summary").Contains("login page"),
+				JQLField("labels").In("backend", "and", `say "hi"`),
+			),
+			want: `summary ~ "login page" AND labels IN (backend, "and", "say \"hi\"")`,
+		},
+
+		{
+			name: "StringWhenTheExpressionsAreNested",
+			query: NewJQLQuery(
+				JQLField("project").Eq("KP"),
+				JQLOr(JQLField("status").Eq("Done"), JQLNot(JQLField("assignee").IsEmpty())),
+			),
+			want: "project = KP AND (status = Done OR NOT assignee IS EMPTY)",
+		},
+
+		{
+			name: "StringWhenTheQueryIsSorted",
+			query: NewJQLQuery(JQLField("assignee").Eq(JQLCurrentUser())).
+				Sort(JQLField("created"), JQLOrderDescending),
+			want: "assignee = currentUser() ORDER BY created DESC",
+		},
+
+		{
+			name: "StringWhenTheHistoryIsSearched",
+			query: NewJQLQuery(
+				JQLField("status").Changed().From("Open").To("Done").
+					During(time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 4, 10, 30, 0, 0, time.UTC)),
+			),
+			want: `status CHANGED FROM Open TO Done DURING ("2022/01/03", "2022/01/04 10:30")`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.query.String())
+		})
+	}
+}
@@ -0,0 +1,678 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// JQLSyntaxError is returned by ParseJQL when the query is not valid, the position is the byte offset of the error.
+type JQLSyntaxError struct {
+	Position int
+	Message  string
+}
+
+func (e *JQLSyntaxError) Error() string {
+	return fmt.Sprintf("jira: invalid JQL at position %v: %v", e.Position, e.Message)
+}
+
+// ParseJQL parses a JQL query locally, without calling Jira, returning the same tree created by the query builder.
+// The query can be inspected and rewritten, e.g. with ScopeToProjects, and printed back as canonical JQL.
+// The field names, values and functions are not validated, use the JQLService.Parse method for that.
+func ParseJQL(jql string) (*JQLQueryScheme, error) {
+
+	lexemes, err := lexJQL(jql)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &jqlParser{lexemes: lexemes}
+
+	query := &JQLQueryScheme{}
+
+	if !parser.peekKeyword("order") && parser.peek().kind != jqlLexemeEnd {
+
+		if query.Where, err = parser.or(); err != nil {
+			return nil, err
+		}
+	}
+
+	if parser.acceptKeyword("order") {
+
+		if !parser.acceptKeyword("by") {
+			return nil, parser.unexpected("BY")
+		}
+
+		for {
+
+			field, err := parser.field()
+			if err != nil {
+				return nil, err
+			}
+
+			order := &JQLOrderScheme{Field: field}
+			switch {
+			case parser.acceptKeyword("asc"):
+				order.Direction = JQLOrderAscending
+			case parser.acceptKeyword("desc"):
+				order.Direction = JQLOrderDescending
+			}
+
+			query.OrderBy = append(query.OrderBy, order)
+
+			if !parser.accept(jqlLexemeComma) {
+				break
+			}
+		}
+	}
+
+	if parser.peek().kind != jqlLexemeEnd {
+		return nil, parser.unexpected("AND, OR or ORDER BY")
+	}
+
+	return query, nil
+}
+
+// Clauses returns the clauses of the query, in the order they're written.
+func (q *JQLQueryScheme) Clauses() (clauses []*JQLClauseScheme) {
+
+	var walk func(expression JQLExpression)
+	walk = func(expression JQLExpression) {
+
+		switch typed := expression.(type) {
+		case *JQLClauseScheme:
+			clauses = append(clauses, typed)
+		case *JQLGroupScheme:
+			for _, nested := range typed.Expressions {
+				walk(nested)
+			}
+		case *JQLNotScheme:
+			walk(typed.Expression)
+		}
+	}
+
+	walk(q.Where)
+
+	return clauses
+}
+
+// Rewrite replaces every expression of the query by the result of the function, starting from the clauses.
+// The function can return the expression unchanged, a new expression, or nil to remove it from the query.
+func (q *JQLQueryScheme) Rewrite(rewrite func(expression JQLExpression) JQLExpression) *JQLQueryScheme {
+
+	var walk func(expression JQLExpression) JQLExpression
+	walk = func(expression JQLExpression) JQLExpression {
+
+		switch typed := expression.(type) {
+		case *JQLGroupScheme:
+
+			var expressions []JQLExpression
+			for _, nested := range typed.Expressions {
+				expressions = append(expressions, walk(nested))
+			}
+
+			expression = jqlGroup(typed.Operator, expressions)
+			if expression == nil {
+				return nil
+			}
+
+		case *JQLNotScheme:
+
+			nested := walk(typed.Expression)
+			if nested == nil {
+				return nil
+			}
+
+			expression = &JQLNotScheme{Expression: nested}
+
+		case nil:
+			return nil
+		}
+
+		return rewrite(expression)
+	}
+
+	q.Where = walk(q.Where)
+
+	return q
+}
+
+// ScopeToProjects restricts the query to the projects, adding the project IN (...) clause with AND.
+// The existing filter is parenthesized when needed, so it can't escape the scope, e.g. a = 1 OR b = 2.
+func (q *JQLQueryScheme) ScopeToProjects(projectKeysOrIDs ...string) *JQLQueryScheme {
+
+	var values []interface{}
+	for _, project := range projectKeysOrIDs {
+		values = append(values, project)
+	}
+
+	q.Where = JQLAnd(JQLField("project").In(values...), q.Where)
+
+	return q
+}
+
+const (
+	jqlLexemeEnd = iota
+	jqlLexemeWord
+	jqlLexemeString
+	jqlLexemeOperator
+	jqlLexemeLeftParenthesis
+	jqlLexemeRightParenthesis
+	jqlLexemeComma
+)
+
+type jqlLexeme struct {
+	kind     int
+	text     string
+	position int
+}
+
+func (l *jqlLexeme) describe() string {
+
+	switch l.kind {
+	case jqlLexemeEnd:
+		return "the end of the query"
+	case jqlLexemeString:
+		return fmt.Sprintf("string %q", l.text)
+	}
+
+	return fmt.Sprintf("%q", l.text)
+}
+
+// jqlSpecialCharacters contains the characters that end an unquoted word.
+const jqlSpecialCharacters = `"'=!<>()~,|&`
+
+func lexJQL(jql string) (lexemes []*jqlLexeme, err error) {
+
+	runes := []rune(jql)
+
+	// The positions are byte offsets of the query
+	offset := func(index int) int {
+		return len(string(runes[:index]))
+	}
+
+	for index := 0; index < len(runes); {
+
+		character := runes[index]
+
+		switch {
+		case unicode.IsSpace(character):
+			index++
+
+		case character == '"' || character == '\'':
+
+			var (
+				builder strings.Builder
+				start   = index
+				closed  bool
+			)
+
+			for index++; index < len(runes); index++ {
+
+				if runes[index] == '\\' && index+1 < len(runes) {
+
+					index++
+					switch runes[index] {
+					case 'n':
+						builder.WriteRune('\n')
+					case 'r':
+						builder.WriteRune('\r')
+					case 't':
+						builder.WriteRune('\t')
+					default:
+						builder.WriteRune(runes[index])
+					}
+
+					continue
+				}
+
+				if runes[index] == character {
+					closed = true
+					index++
+					break
+				}
+
+				builder.WriteRune(runes[index])
+			}
+
+			if !closed {
+				return nil, &JQLSyntaxError{Position: offset(start), Message: "the string is not closed"}
+			}
+
+			lexemes = append(lexemes, &jqlLexeme{kind: jqlLexemeString, text: builder.String(), position: offset(start)})
+
+		case character == '(':
+
+			lexemes = append(lexemes, &jqlLexeme{kind: jqlLexemeLeftParenthesis, text: "(", position: offset(index)})
+			index++
+
+		case character == ')':
+
+			lexemes = append(lexemes, &jqlLexeme{kind: jqlLexemeRightParenthesis, text: ")", position: offset(index)})
+			index++
+
+		case character == ',':
+
+			lexemes = append(lexemes, &jqlLexeme{kind: jqlLexemeComma, text: ",", position: offset(index)})
+			index++
+
+		case strings.ContainsRune(`=!<>~|&`, character):
+
+			operator := string(character)
+			if index+1 < len(runes) {
+
+				switch pair := string(runes[index : index+2]); pair {
+				case "!=", ">=", "<=", "!~", "||", "&&":
+					operator = pair
+				}
+			}
+
+			if operator == "|" || operator == "&" {
+				return nil, &JQLSyntaxError{Position: offset(index), Message: fmt.Sprintf("unexpected character %q", operator)}
+			}
+
+			lexemes = append(lexemes, &jqlLexeme{kind: jqlLexemeOperator, text: operator, position: offset(index)})
+			index += len(operator)
+
+		default:
+
+			start := index
+			for index < len(runes) && !unicode.IsSpace(runes[index]) && !strings.ContainsRune(jqlSpecialCharacters, runes[index]) {
+
+				if runes[index] == '\\' && index+1 < len(runes) {
+					index++
+				}
+
+				index++
+			}
+
+			word := strings.NewReplacer(`\ `, " ", `\\`, `\`).Replace(string(runes[start:index]))
+			lexemes = append(lexemes, &jqlLexeme{kind: jqlLexemeWord, text: word, position: offset(start)})
+		}
+	}
+
+	return append(lexemes, &jqlLexeme{kind: jqlLexemeEnd, position: len(jql)}), nil
+}
+
+type jqlParser struct {
+	lexemes []*jqlLexeme
+	index   int
+}
+
+func (p *jqlParser) peek() *jqlLexeme {
+	return p.lexemes[p.index]
+}
+
+func (p *jqlParser) next() *jqlLexeme {
+
+	lexeme := p.lexemes[p.index]
+	if lexeme.kind != jqlLexemeEnd {
+		p.index++
+	}
+
+	return lexeme
+}
+
+func (p *jqlParser) accept(kind int) bool {
+
+	if p.peek().kind == kind {
+		p.next()
+		return true
+	}
+
+	return false
+}
+
+func (p *jqlParser) peekKeyword(keyword string) bool {
+
+	lexeme := p.peek()
+	return lexeme.kind == jqlLexemeWord && strings.EqualFold(lexeme.text, keyword)
+}
+
+func (p *jqlParser) acceptKeyword(keyword string) bool {
+
+	if p.peekKeyword(keyword) {
+		p.next()
+		return true
+	}
+
+	return false
+}
+
+func (p *jqlParser) acceptOperator(operator string) bool {
+
+	if lexeme := p.peek(); lexeme.kind == jqlLexemeOperator && lexeme.text == operator {
+		p.next()
+		return true
+	}
+
+	return false
+}
+
+func (p *jqlParser) unexpected(expected string) error {
+
+	lexeme := p.peek()
+	return &JQLSyntaxError{Position: lexeme.position, Message: fmt.Sprintf("expected %v, found %v", expected, lexeme.describe())}
+}
+
+func (p *jqlParser) or() (JQLExpression, error) {
+
+	expressions := []JQLExpression{}
+
+	for {
+
+		expression, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+
+		expressions = append(expressions, expression)
+
+		if !p.acceptKeyword("or") && !p.acceptOperator("||") {
+			break
+		}
+	}
+
+	return jqlGroup(JQLOrOperator, expressions), nil
+}
+
+func (p *jqlParser) and() (JQLExpression, error) {
+
+	expressions := []JQLExpression{}
+
+	for {
+
+		expression, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+
+		expressions = append(expressions, expression)
+
+		if !p.acceptKeyword("and") && !p.acceptOperator("&&") {
+			break
+		}
+	}
+
+	return jqlGroup(JQLAndOperator, expressions), nil
+}
+
+func (p *jqlParser) not() (JQLExpression, error) {
+
+	if p.acceptKeyword("not") || p.acceptOperator("!") {
+
+		expression, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+
+		return JQLNot(expression), nil
+	}
+
+	if p.accept(jqlLexemeLeftParenthesis) {
+
+		expression, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+
+		if !p.accept(jqlLexemeRightParenthesis) {
+			return nil, p.unexpected(`")"`)
+		}
+
+		// The parentheses are not kept, the groups with another operator are parenthesized when printed
+		return expression, nil
+	}
+
+	return p.clause()
+}
+
+func (p *jqlParser) field() (*JQLFieldScheme, error) {
+
+	lexeme := p.peek()
+	if lexeme.kind != jqlLexemeWord && lexeme.kind != jqlLexemeString {
+		return nil, p.unexpected("a field name")
+	}
+
+	p.next()
+
+	return JQLField(lexeme.text), nil
+}
+
+func (p *jqlParser) clause() (JQLExpression, error) {
+
+	field, err := p.field()
+	if err != nil {
+		return nil, err
+	}
+
+	clause := &JQLClauseScheme{Field: field}
+
+	if lexeme := p.peek(); lexeme.kind == jqlLexemeOperator {
+
+		switch lexeme.text {
+		case JQLOperatorEquals, JQLOperatorNotEquals, JQLOperatorGreater, JQLOperatorGreaterOrEqual, JQLOperatorLess,
+			JQLOperatorLessOrEqual, JQLOperatorContains, JQLOperatorNotContains:
+
+			p.next()
+			clause.Operator = lexeme.text
+			clause.Operand, err = p.operand()
+
+			return clause, err
+		}
+	}
+
+	switch {
+	case p.acceptKeyword("in"):
+
+		clause.Operator = JQLOperatorIn
+		clause.Operand, err = p.listOperand()
+
+	case p.acceptKeyword("not"):
+
+		if !p.acceptKeyword("in") {
+			return nil, p.unexpected("IN")
+		}
+
+		clause.Operator = JQLOperatorNotIn
+		clause.Operand, err = p.listOperand()
+
+	case p.acceptKeyword("is"):
+
+		clause.Operator = JQLOperatorIs
+		if p.acceptKeyword("not") {
+			clause.Operator = JQLOperatorIsNot
+		}
+
+		switch {
+		case p.acceptKeyword("empty"):
+			clause.Operand = JQLEmpty
+		case p.acceptKeyword("null"):
+			clause.Operand = JQLNull
+		default:
+			return nil, p.unexpected("EMPTY or NULL")
+		}
+
+	case p.acceptKeyword("was"):
+
+		clause.Operator = JQLOperatorWas
+		if p.acceptKeyword("not") {
+			clause.Operator = JQLOperatorWasNot
+		}
+
+		if p.acceptKeyword("in") {
+
+			clause.Operator += " " + JQLOperatorIn
+			clause.Operand, err = p.listOperand()
+		} else {
+			clause.Operand, err = p.operand()
+		}
+
+		if err == nil {
+			err = p.predicates(clause)
+		}
+
+	case p.acceptKeyword("changed"):
+
+		clause.Operator = JQLOperatorChanged
+		err = p.predicates(clause)
+
+	default:
+		return nil, p.unexpected("an operator")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return clause, nil
+}
+
+func (p *jqlParser) predicates(clause *JQLClauseScheme) error {
+
+	for {
+
+		lexeme := p.peek()
+		if lexeme.kind != jqlLexemeWord {
+			return nil
+		}
+
+		operator := strings.ToUpper(lexeme.text)
+
+		switch operator {
+		case JQLPredicateAfter, JQLPredicateBefore, JQLPredicateOn, JQLPredicateBy, JQLPredicateFrom, JQLPredicateTo:
+
+			p.next()
+
+			operand, err := p.operand()
+			if err != nil {
+				return err
+			}
+
+			clause.Predicates = append(clause.Predicates, &JQLPredicateScheme{Operator: operator, Operand: operand})
+
+		case JQLPredicateDuring:
+
+			p.next()
+
+			operand, err := p.list()
+			if err != nil {
+				return err
+			}
+
+			if len(operand.Values) != 2 {
+				return &JQLSyntaxError{Position: lexeme.position, Message: "DURING requires the start and end dates"}
+			}
+
+			clause.Predicates = append(clause.Predicates, &JQLPredicateScheme{Operator: operator, Operand: operand})
+
+		default:
+			return nil
+		}
+	}
+}
+
+// listOperand parses the operand of the IN operators, a list or a function returning a list.
+func (p *jqlParser) listOperand() (JQLOperand, error) {
+
+	if p.peek().kind == jqlLexemeLeftParenthesis {
+		return p.list()
+	}
+
+	operand, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := operand.(*JQLFunctionScheme); !ok {
+		return nil, &JQLSyntaxError{Position: p.lexemes[p.index-1].position, Message: "the IN operator requires a list or a function"}
+	}
+
+	return operand, nil
+}
+
+func (p *jqlParser) list() (*JQLListScheme, error) {
+
+	if !p.accept(jqlLexemeLeftParenthesis) {
+		return nil, p.unexpected(`"("`)
+	}
+
+	list := &JQLListScheme{}
+
+	for {
+
+		operand, err := p.operand()
+		if err != nil {
+			return nil, err
+		}
+
+		list.Values = append(list.Values, operand)
+
+		if p.accept(jqlLexemeRightParenthesis) {
+			return list, nil
+		}
+
+		if !p.accept(jqlLexemeComma) {
+			return nil, p.unexpected(`"," or ")"`)
+		}
+	}
+}
+
+func (p *jqlParser) operand() (JQLOperand, error) {
+
+	lexeme := p.peek()
+
+	switch lexeme.kind {
+	case jqlLexemeString:
+
+		p.next()
+		return &JQLValueScheme{Value: lexeme.text}, nil
+
+	case jqlLexemeWord:
+
+		p.next()
+
+		if p.peek().kind != jqlLexemeLeftParenthesis {
+
+			switch strings.ToLower(lexeme.text) {
+			case "empty":
+				return JQLEmpty, nil
+			case "null":
+				return JQLNull, nil
+			}
+
+			return &JQLValueScheme{Value: lexeme.text}, nil
+		}
+
+		p.next()
+
+		function := JQLFunction(lexeme.text)
+		if p.accept(jqlLexemeRightParenthesis) {
+			return function, nil
+		}
+
+		for {
+
+			argument := p.peek()
+			if argument.kind != jqlLexemeWord && argument.kind != jqlLexemeString {
+				return nil, p.unexpected("a function argument")
+			}
+
+			p.next()
+			function.Arguments = append(function.Arguments, argument.text)
+
+			if p.accept(jqlLexemeRightParenthesis) {
+				return function, nil
+			}
+
+			if !p.accept(jqlLexemeComma) {
+				return nil, p.unexpected(`"," or ")"`)
+			}
+		}
+
+	case jqlLexemeLeftParenthesis:
+		return p.list()
+	}
+
+	return nil, p.unexpected("a value")
+}
@@ -0,0 +1,282 @@
+package models
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseJQL(t *testing.T) {
+
+	testCases := []struct {
+		name         string
+		jql          string
+		want         string
+		wantPosition int
+		wantErr      bool
+	}{
+		{
+			name: "ParseJQLWhenTheQueryIsAClause",
+			jql:  "project = KP",
+			want: "project = KP",
+		},
+
+		{
+			name: "ParseJQLWhenTheQueryIsSorted",
+			jql:  `project = KP and status in (Open, "In Progress") order by created desc, key`,
+			want: `project = KP AND status IN (Open, "In Progress") ORDER BY created DESC, key`,
+		},
+
+		{
+			name: "ParseJQLWhenTheOperatorsHavePrecedence",
+			jql:  "a = 1 OR b = 2 AND NOT c = 3",
+			want: `"a" = 1 OR (b = 2 AND NOT c = 3)`,
+		},
+
+		{
+			name: "ParseJQLWhenTheExpressionIsParenthesized",
+			jql:  "(priority = 1 OR b = 2) AND c != 3",
+			want: "(priority = 1 OR b = 2) AND c != 3",
+		},
+
+		{
+			name: "ParseJQLWhenTheValuesAreFunctions",
+			jql:  `assignee = currentUser() AND reporter in membersOf("jira-users")`,
+			want: `assignee = currentUser() AND reporter IN membersOf("jira-users")`,
+		},
+
+		{
+			name: "ParseJQLWhenTheStringsAreSingleQuoted",
+			jql:  `summary ~ 'login page' and labels is not EMPTY`,
+			want: `summary ~ "login page" AND labels IS NOT EMPTY`,
+		},
+
+		{
+			name: "ParseJQLWhenTheStringHasEscapedQuotes",
+			jql:  `summary ~ "a\"b"`,
+			want: `summary ~ "a\"b"`,
+		},
+
+		{
+			name: "ParseJQLWhenTheHistoryIsSearched",
+			jql:  `status CHANGED FROM Open TO Done DURING ("2022/01/03", "2022/01/04") BY bob`,
+			want: `status CHANGED FROM Open TO Done DURING ("2022/01/03", "2022/01/04") BY bob`,
+		},
+
+		{
+			name: "ParseJQLWhenThePastValueIsSearched",
+			jql:  `status WAS "In Progress" BEFORE "2022/01/03"`,
+			want: `status WAS "In Progress" BEFORE "2022/01/03"`,
+		},
+
+		{
+			name: "ParseJQLWhenTheFieldsAreReferences",
+			jql:  `cf[10010] > 3 AND issue.property[foo].bar = baz AND "Story Points" >= 5`,
+			want: `cf[10010] > 3 AND issue.property[foo].bar = baz AND "Story Points" >= 5`,
+		},
+
+		{
+			name: "ParseJQLWhenTheQueryIsOnlySorted",
+			jql:  "ORDER BY rank",
+			want: "ORDER BY rank",
+		},
+
+		{
+			name: "ParseJQLWhenTheQueryIsEmpty",
+			jql:  "",
+			want: "",
+		},
+
+		{
+			name:         "ParseJQLWhenTheValueIsMissing",
+			jql:          "project = ",
+			wantPosition: 10,
+			wantErr:      true,
+		},
+
+		{
+			name:         "ParseJQLWhenTheClauseIsMissing",
+			jql:          "project = KP AND",
+			wantPosition: 16,
+			wantErr:      true,
+		},
+
+		{
+			name:         "ParseJQLWhenTheParenthesisIsNotClosed",
+			jql:          "(project = KP",
+			wantPosition: 13,
+			wantErr:      true,
+		},
+
+		{
+			name:         "ParseJQLWhenTheParenthesisIsNotOpened",
+			jql:          "project = KP)",
+			wantPosition: 12,
+			wantErr:      true,
+		},
+
+		{
+			name:         "ParseJQLWhenTheStringIsNotClosed",
+			jql:          `summary ~ "unclosed`,
+			wantPosition: 10,
+			wantErr:      true,
+		},
+
+		{
+			name:         "ParseJQLWhenTheOperatorIsUnknown",
+			jql:          "project == KP",
+			wantPosition: 9,
+			wantErr:      true,
+		},
+
+		{
+			name:         "ParseJQLWhenTheOperatorIsMissing",
+			jql:          "project KP",
+			wantPosition: 8,
+			wantErr:      true,
+		},
+
+		{
+			name:         "ParseJQLWhenTheListIsNotClosed",
+			jql:          "status in (Open,",
+			wantPosition: 16,
+			wantErr:      true,
+		},
+
+		{
+			name:         "ParseJQLWhenTheSortIsMissing",
+			jql:          "project = KP ORDER BY",
+			wantPosition: 21,
+			wantErr:      true,
+		},
+
+		{
+			name:         "ParseJQLWhenTheHistoryValueIsMissing",
+			jql:          "status CHANGED FROM",
+			wantPosition: 19,
+			wantErr:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			gotQuery, err := ParseJQL(testCase.jql)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				syntaxError, ok := err.(*JQLSyntaxError)
+				if !ok {
+					t.Fatalf("the error %v is not a *JQLSyntaxError", err)
+				}
+
+				assert.Nil(t, gotQuery)
+				assert.Equal(t, testCase.wantPosition, syntaxError.Position)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, gotQuery.String())
+
+			// The canonical JQL is parsed to the same query
+			gotCanonical, err := ParseJQL(gotQuery.String())
+			assert.NoError(t, err)
+			assert.Equal(t, gotQuery, gotCanonical)
+		})
+	}
+}
+
+func TestJQLQueryScheme_Rewrite(t *testing.T) {
+
+	testCases := []struct {
+		name        string
+		jql         string
+		rewrite     func(query *JQLQueryScheme) *JQLQueryScheme
+		want        string
+		wantClauses []string
+	}{
+		{
+			name: "RewriteWhenTheQueryIsScopedToProjects",
+			jql:  "priority = High OR labels = backend ORDER BY created",
+			rewrite: func(query *JQLQueryScheme) *JQLQueryScheme {
+				return query.ScopeToProjects("KP", "AB")
+			},
+			want:        "project IN (KP, AB) AND (priority = High OR labels = backend) ORDER BY created",
+			wantClauses: []string{"project", "priority", "labels"},
+		},
+
+		{
+			name: "RewriteWhenTheEmptyQueryIsScopedToProjects",
+			jql:  "",
+			rewrite: func(query *JQLQueryScheme) *JQLQueryScheme {
+				return query.ScopeToProjects("KP")
+			},
+			want:        "project IN (KP)",
+			wantClauses: []string{"project"},
+		},
+
+		{
+			name: "RewriteWhenTheClausesAreRemoved",
+			jql:  "project = KP AND NOT sprint in openSprints() AND status = Done",
+			rewrite: func(query *JQLQueryScheme) *JQLQueryScheme {
+				return query.Rewrite(func(expression JQLExpression) JQLExpression {
+
+					if clause, ok := expression.(*JQLClauseScheme); ok && clause.Field.Name == "sprint" {
+						return nil
+					}
+
+					return expression
+				})
+			},
+			want:        "project = KP AND status = Done",
+			wantClauses: []string{"project", "status"},
+		},
+
+		{
+			name: "RewriteWhenTheClausesAreReplaced",
+			jql:  "status = Done OR cf[10010] > 3",
+			rewrite: func(query *JQLQueryScheme) *JQLQueryScheme {
+				return query.Rewrite(func(expression JQLExpression) JQLExpression {
+
+					if clause, ok := expression.(*JQLClauseScheme); ok && clause.Field.Name == "status" {
+						return JQLField("statusCategory").Eq("Done")
+					}
+
+					return expression
+				})
+			},
+			want:        "statusCategory = Done OR cf[10010] > 3",
+			wantClauses: []string{"statusCategory", "cf[10010]"},
+		},
+
+		{
+			name: "RewriteWhenEveryClauseIsRemoved",
+			jql:  "project = KP ORDER BY key",
+			rewrite: func(query *JQLQueryScheme) *JQLQueryScheme {
+				return query.Rewrite(func(expression JQLExpression) JQLExpression { return nil })
+			},
+			want: "ORDER BY key",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			query, err := ParseJQL(testCase.jql)
+			assert.NoError(t, err)
+
+			gotQuery := testCase.rewrite(query)
+			assert.Equal(t, testCase.want, gotQuery.String())
+
+			var gotClauses []string
+			for _, clause := range gotQuery.Clauses() {
+				gotClauses = append(gotClauses, clause.Field.Name)
+			}
+
+			assert.Equal(t, testCase.wantClauses, gotClauses)
+		})
+	}
+}
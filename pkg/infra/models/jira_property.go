@@ -0,0 +1,23 @@
+package models
+
+import "encoding/json"
+
+type PropertyPageScheme struct {
+	Keys []*PropertyScheme `json:"keys,omitempty"`
+}
+
+type PropertyScheme struct {
+	Self string `json:"self,omitempty"`
+	Key  string `json:"key,omitempty"`
+}
+
+// DecodeValue decodes the value of the property into the target, a pointer to the type stored on the property.
+func (e *EntityPropertyScheme) DecodeValue(target interface{}) error {
+
+	data, err := json.Marshal(e.Value)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, target)
+}
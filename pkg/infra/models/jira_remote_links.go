@@ -0,0 +1,41 @@
+package models
+
+// RemoteLinkScheme is a link from an issue to an object of another application, e.g. a build or a pull request.
+// It's the payload of the IssueRemoteLinkService too, the links with the same GlobalID are updated.
+type RemoteLinkScheme struct {
+	ID           int                          `json:"id,omitempty"`
+	Self         string                       `json:"self,omitempty"`
+	GlobalID     string                       `json:"globalId,omitempty"`
+	Application  *RemoteLinkApplicationScheme `json:"application,omitempty"`
+	Relationship string                       `json:"relationship,omitempty"`
+	Object       *RemoteLinkObjectScheme      `json:"object,omitempty"`
+}
+
+type RemoteLinkApplicationScheme struct {
+	Type string `json:"type,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type RemoteLinkObjectScheme struct {
+	URL     string                        `json:"url,omitempty"`
+	Title   string                        `json:"title,omitempty"`
+	Summary string                        `json:"summary,omitempty"`
+	Icon    *RemoteLinkObjectIconScheme   `json:"icon,omitempty"`
+	Status  *RemoteLinkObjectStatusScheme `json:"status,omitempty"`
+}
+
+type RemoteLinkObjectIconScheme struct {
+	URL   string `json:"url16x16,omitempty"`
+	Title string `json:"title,omitempty"`
+	Link  string `json:"link,omitempty"`
+}
+
+type RemoteLinkObjectStatusScheme struct {
+	Resolved bool                        `json:"resolved,omitempty"`
+	Icon     *RemoteLinkObjectIconScheme `json:"icon,omitempty"`
+}
+
+type RemoteLinkIdentify struct {
+	ID   int    `json:"id,omitempty"`
+	Self string `json:"self,omitempty"`
+}
@@ -14,3 +14,25 @@ type TaskScheme struct {
 	Finished       int64  `json:"finished"`
 	LastUpdate     int64  `json:"lastUpdate"`
 }
+
+// The statuses of the asynchronous tasks.
+const (
+	TaskStatusEnqueued        = "ENQUEUED"
+	TaskStatusRunning         = "RUNNING"
+	TaskStatusComplete        = "COMPLETE"
+	TaskStatusFailed          = "FAILED"
+	TaskStatusCancelRequested = "CANCEL_REQUESTED"
+	TaskStatusCancelled       = "CANCELLED"
+	TaskStatusDead            = "DEAD"
+)
+
+// Done reports whether the task is completed, failed, cancelled or dead.
+func (t *TaskScheme) Done() bool {
+
+	switch t.Status {
+	case TaskStatusComplete, TaskStatusFailed, TaskStatusCancelled, TaskStatusDead:
+		return true
+	}
+
+	return false
+}
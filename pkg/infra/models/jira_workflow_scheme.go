@@ -27,6 +27,9 @@ type WorkflowSchemeScheme struct {
 	LastModified        string      `json:"lastModified,omitempty"`
 	Self                string      `json:"self,omitempty"`
 	UpdateDraftIfNeeded bool        `json:"updateDraftIfNeeded,omitempty"`
+
+	// IssueTypeMappings maps the issue type IDs to the workflow names, the other issue types use the DefaultWorkflow.
+	IssueTypeMappings map[string]string `json:"issueTypeMappings,omitempty"`
 }
 
 type WorkflowSchemeAssociationPageScheme struct {
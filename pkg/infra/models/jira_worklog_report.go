@@ -0,0 +1,320 @@
+package models
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// The dimensions of the worklog reports.
+const (
+	WorklogReportByUser      = "user"
+	WorklogReportByIssue     = "issue"
+	WorklogReportByEpic      = "epic"
+	WorklogReportByComponent = "component"
+	WorklogReportByDay       = "day"
+)
+
+// WorklogReportOptionsScheme customizes the IssueWorklogService.Report method.
+type WorklogReportOptionsScheme struct {
+
+	// JQL selects the issues of the report, it's combined with the project when both are set.
+	JQL        string
+	ProjectKey string
+
+	// From and To are the range of the start of the worklogs, From is included and To is excluded.
+	// The range is usually bounded by the midnights of the location, e.g. time.Date(2022, 1, 1, 0, 0, 0, 0, location).
+	From time.Time
+	To   time.Time
+
+	// Location is the timezone of the days of the worklogs, UTC by default, e.g. a worklog started at 23:30 UTC
+	// is logged on the next day in Europe/Madrid.
+	Location *time.Location
+
+	// AccountIDs keeps the worklogs of the users, the worklogs of every user are kept by default.
+	AccountIDs []string
+
+	// EpicLinkField is the ID of the Epic Link custom field of the company-managed projects, e.g. customfield_10014.
+	// The epic of an issue is its parent epic when it isn't set, and the epic of a subtask is the epic of its parent.
+	EpicLinkField string
+
+	// UpdatedSince collects the worklogs updated since the time with the IssueWorklogService.Updated and Gets methods,
+	// instead of the worklogs of every issue. It's faster when the issues are many, but the worklogs updated before
+	// the time aren't reported.
+	UpdatedSince time.Time
+
+	// PageSize is the number of issues requested per search page, 100 by default.
+	PageSize int
+}
+
+// WorklogReportScheme contains the worklogs started in the range of a report, ordered by their start.
+type WorklogReportScheme struct {
+	From     time.Time
+	To       time.Time
+	Timezone string
+	Entries  []*WorklogReportEntryScheme
+}
+
+// WorklogReportEntryScheme is a worklog of a report with its issue, the day is the day of the start on the
+// timezone of the report.
+type WorklogReportEntryScheme struct {
+	WorklogID        string
+	IssueID          string
+	IssueKey         string
+	IssueSummary     string
+	EpicKey          string
+	Components       []string
+	AccountID        string
+	DisplayName      string
+	Started          time.Time
+	Day              string
+	TimeSpentSeconds int
+}
+
+// WorklogReportRowScheme is the time spent on a group of worklogs, the fields of the dimensions not aggregated
+// are empty.
+type WorklogReportRowScheme struct {
+	AccountID        string
+	DisplayName      string
+	IssueKey         string
+	IssueSummary     string
+	EpicKey          string
+	Component        string
+	Day              string
+	Worklogs         int
+	TimeSpentSeconds int
+}
+
+// Hours returns the time spent in hours.
+func (r *WorklogReportRowScheme) Hours() float64 {
+	return float64(r.TimeSpentSeconds) / 3600
+}
+
+// Aggregate returns the time spent grouped by the dimensions, e.g. WorklogReportByUser and WorklogReportByDay for
+// a timesheet, ordered by the dimensions. The worklogs of the issues with several components are counted once per
+// component, and the worklogs without component or epic are grouped with an empty component or epic.
+func (r *WorklogReportScheme) Aggregate(dimensions ...string) ([]*WorklogReportRowScheme, error) {
+
+	for _, dimension := range dimensions {
+
+		if _, ok := worklogReportColumns[dimension]; !ok {
+			return nil, fmt.Errorf("%w: %v", ErrNoWorklogReportDimensionError, dimension)
+		}
+	}
+
+	var (
+		rows   []*WorklogReportRowScheme
+		groups = make(map[WorklogReportRowScheme]*WorklogReportRowScheme)
+	)
+
+	for _, entry := range r.Entries {
+
+		for _, key := range worklogReportGroups(entry, dimensions) {
+
+			row, ok := groups[key]
+			if !ok {
+				row = &WorklogReportRowScheme{}
+				*row = key
+				groups[key] = row
+				rows = append(rows, row)
+			}
+
+			row.Worklogs++
+			row.TimeSpentSeconds += entry.TimeSpentSeconds
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+
+		for _, dimension := range dimensions {
+
+			left, right := worklogReportValues(rows[i], dimension), worklogReportValues(rows[j], dimension)
+			for index := range left {
+
+				if left[index] != right[index] {
+					return left[index] < right[index]
+				}
+			}
+		}
+
+		return false
+	})
+
+	return rows, nil
+}
+
+// WriteCSV writes the time spent grouped by the dimensions as CSV, with a header row.
+func (r *WorklogReportScheme) WriteCSV(writer io.Writer, dimensions ...string) error {
+
+	if writer == nil {
+		return ErrNoWriterError
+	}
+
+	rows, err := r.Aggregate(dimensions...)
+	if err != nil {
+		return err
+	}
+
+	var headers []string
+	for _, dimension := range dimensions {
+		headers = append(headers, worklogReportColumns[dimension]...)
+	}
+
+	csvWriter := csv.NewWriter(writer)
+
+	if err = csvWriter.Write(append(headers, "Worklogs", "Time Spent (s)", "Hours")); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+
+		var values []string
+		for _, dimension := range dimensions {
+			values = append(values, worklogReportValues(row, dimension)...)
+		}
+
+		values = append(values, strconv.Itoa(row.Worklogs), strconv.Itoa(row.TimeSpentSeconds),
+			strconv.FormatFloat(row.Hours(), 'f', 2, 64))
+
+		if err = csvWriter.Write(values); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// WriteJSON writes the time spent grouped by the dimensions as a JSON object, with the range and the timezone of
+// the report. The rows are objects with the fields of the dimensions and the time spent.
+func (r *WorklogReportScheme) WriteJSON(writer io.Writer, dimensions ...string) error {
+
+	if writer == nil {
+		return ErrNoWriterError
+	}
+
+	rows, err := r.Aggregate(dimensions...)
+	if err != nil {
+		return err
+	}
+
+	report := struct {
+		From             string                   `json:"from"`
+		To               string                   `json:"to"`
+		Timezone         string                   `json:"timezone"`
+		Dimensions       []string                 `json:"dimensions"`
+		Rows             []map[string]interface{} `json:"rows"`
+		TimeSpentSeconds int                      `json:"timeSpentSeconds"`
+	}{
+		From:       r.From.Format(time.RFC3339),
+		To:         r.To.Format(time.RFC3339),
+		Timezone:   r.Timezone,
+		Dimensions: append([]string{}, dimensions...),
+		Rows:       []map[string]interface{}{},
+	}
+
+	for _, entry := range r.Entries {
+		report.TimeSpentSeconds += entry.TimeSpentSeconds
+	}
+
+	for _, row := range rows {
+
+		object := map[string]interface{}{
+			"worklogs":         row.Worklogs,
+			"timeSpentSeconds": row.TimeSpentSeconds,
+			"hours":            row.Hours(),
+		}
+
+		for _, dimension := range dimensions {
+
+			values := worklogReportValues(row, dimension)
+			for index, field := range worklogReportFields[dimension] {
+				object[field] = values[index]
+			}
+		}
+
+		report.Rows = append(report.Rows, object)
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(&report)
+}
+
+// worklogReportColumns are the CSV headers of the dimensions, and worklogReportFields are their JSON fields.
+var (
+	worklogReportColumns = map[string][]string{
+		WorklogReportByUser:      {"Account ID", "User"},
+		WorklogReportByIssue:     {"Issue", "Summary"},
+		WorklogReportByEpic:      {"Epic"},
+		WorklogReportByComponent: {"Component"},
+		WorklogReportByDay:       {"Day"},
+	}
+
+	worklogReportFields = map[string][]string{
+		WorklogReportByUser:      {"accountId", "displayName"},
+		WorklogReportByIssue:     {"issueKey", "issueSummary"},
+		WorklogReportByEpic:      {"epicKey"},
+		WorklogReportByComponent: {"component"},
+		WorklogReportByDay:       {"day"},
+	}
+)
+
+// worklogReportValues returns the values of the dimension of a row, in the order of the columns.
+func worklogReportValues(row *WorklogReportRowScheme, dimension string) []string {
+
+	switch dimension {
+	case WorklogReportByUser:
+		return []string{row.AccountID, row.DisplayName}
+	case WorklogReportByIssue:
+		return []string{row.IssueKey, row.IssueSummary}
+	case WorklogReportByEpic:
+		return []string{row.EpicKey}
+	case WorklogReportByComponent:
+		return []string{row.Component}
+	case WorklogReportByDay:
+		return []string{row.Day}
+	}
+
+	return nil
+}
+
+// worklogReportGroups returns the groups of a worklog, a group per component when the worklogs are aggregated by
+// component.
+func worklogReportGroups(entry *WorklogReportEntryScheme, dimensions []string) []WorklogReportRowScheme {
+
+	group := WorklogReportRowScheme{}
+	components := []string{""}
+
+	for _, dimension := range dimensions {
+
+		switch dimension {
+		case WorklogReportByUser:
+			group.AccountID, group.DisplayName = entry.AccountID, entry.DisplayName
+		case WorklogReportByIssue:
+			group.IssueKey, group.IssueSummary = entry.IssueKey, entry.IssueSummary
+		case WorklogReportByEpic:
+			group.EpicKey = entry.EpicKey
+		case WorklogReportByDay:
+			group.Day = entry.Day
+		case WorklogReportByComponent:
+
+			if len(entry.Components) != 0 {
+				components = entry.Components
+			}
+		}
+	}
+
+	groups := make([]WorklogReportRowScheme, len(components))
+	for index, component := range components {
+		groups[index] = group
+		groups[index].Component = component
+	}
+
+	return groups
+}